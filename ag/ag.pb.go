@@ -151,6 +151,31 @@ func (Enrollment_DisplayState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7a984e8f57169aa1, []int{8, 1}
 }
 
+type Assignment_PrerequisitePolicy int32
+
+const (
+	Assignment_BLOCK_TESTS    Assignment_PrerequisitePolicy = 0
+	Assignment_BLOCK_APPROVAL Assignment_PrerequisitePolicy = 1
+)
+
+var Assignment_PrerequisitePolicy_name = map[int32]string{
+	0: "BLOCK_TESTS",
+	1: "BLOCK_APPROVAL",
+}
+
+var Assignment_PrerequisitePolicy_value = map[string]int32{
+	"BLOCK_TESTS":    0,
+	"BLOCK_APPROVAL": 1,
+}
+
+func (x Assignment_PrerequisitePolicy) String() string {
+	return proto.EnumName(Assignment_PrerequisitePolicy_name, int32(x))
+}
+
+func (Assignment_PrerequisitePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{14, 0}
+}
+
 type Submission_Status int32
 
 const (
@@ -179,7 +204,7 @@ func (x Submission_Status) String() string {
 }
 
 func (Submission_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{16, 0}
+	return fileDescriptor_7a984e8f57169aa1, []int{17, 0}
 }
 
 type GradingCriterion_Grade int32
@@ -207,7 +232,35 @@ func (x GradingCriterion_Grade) String() string {
 }
 
 func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{20, 0}
+	return fileDescriptor_7a984e8f57169aa1, []int{23, 0}
+}
+
+type Regrade_State int32
+
+const (
+	Regrade_OPEN     Regrade_State = 0
+	Regrade_ACCEPTED Regrade_State = 1
+	Regrade_REJECTED Regrade_State = 2
+)
+
+var Regrade_State_name = map[int32]string{
+	0: "OPEN",
+	1: "ACCEPTED",
+	2: "REJECTED",
+}
+
+var Regrade_State_value = map[string]int32{
+	"OPEN":     0,
+	"ACCEPTED": 1,
+	"REJECTED": 2,
+}
+
+func (x Regrade_State) String() string {
+	return proto.EnumName(Regrade_State_name, int32(x))
+}
+
+func (Regrade_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{26, 0}
 }
 
 type SubmissionsForCourseRequest_Type int32
@@ -235,7 +288,7 @@ func (x SubmissionsForCourseRequest_Type) String() string {
 }
 
 func (SubmissionsForCourseRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{44, 0}
+	return fileDescriptor_7a984e8f57169aa1, []int{50, 0}
 }
 
 type User struct {
@@ -632,6 +685,10 @@ type Course struct {
 	Enrollments          []*Enrollment         `protobuf:"bytes,12,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 	Assignments          []*Assignment         `protobuf:"bytes,13,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	Groups               []*Group              `protobuf:"bytes,14,rep,name=groups,proto3" json:"groups,omitempty"`
+	TemplateBranch       string                `protobuf:"bytes,15,opt,name=templateBranch,proto3" json:"templateBranch,omitempty"`
+	PushAssignments      bool                  `protobuf:"varint,16,opt,name=pushAssignments,proto3" json:"pushAssignments,omitempty"`
+	DockerImage          string                `protobuf:"bytes,17,opt,name=dockerImage,proto3" json:"dockerImage,omitempty"`
+	DockerImageError     string                `protobuf:"bytes,18,opt,name=dockerImageError,proto3" json:"dockerImageError,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -768,6 +825,34 @@ func (m *Course) GetGroups() []*Group {
 	return nil
 }
 
+func (m *Course) GetTemplateBranch() string {
+	if m != nil {
+		return m.TemplateBranch
+	}
+	return ""
+}
+
+func (m *Course) GetPushAssignments() bool {
+	if m != nil {
+		return m.PushAssignments
+	}
+	return false
+}
+
+func (m *Course) GetDockerImage() string {
+	if m != nil {
+		return m.DockerImage
+	}
+	return ""
+}
+
+func (m *Course) GetDockerImageError() string {
+	if m != nil {
+		return m.DockerImageError
+	}
+	return ""
+}
+
 type Courses struct {
 	Courses              []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -1345,23 +1430,37 @@ func (m *CourseSubmissions) GetLinks() []*EnrollmentLink {
 }
 
 type Assignment struct {
-	ID                   uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID             uint64              `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Name                 string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ScriptFile           string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
-	Deadline             string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	AutoApprove          bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
-	Order                uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
-	IsGroupLab           bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit           uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
-	Reviewers            uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`
-	SkipTests            bool                `protobuf:"varint,11,opt,name=skipTests,proto3" json:"skipTests,omitempty"`
-	Submissions          []*Submission       `protobuf:"bytes,12,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	GradingBenchmarks    []*GradingBenchmark `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`
-	ContainerTimeout     uint32              `protobuf:"varint,14,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ID                   uint64                        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID             uint64                        `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Name                 string                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ScriptFile           string                        `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
+	Deadline             string                        `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AutoApprove          bool                          `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
+	Order                uint32                        `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	IsGroupLab           bool                          `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	ScoreLimit           uint32                        `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
+	Reviewers            uint32                        `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`
+	SkipTests            bool                          `protobuf:"varint,11,opt,name=skipTests,proto3" json:"skipTests,omitempty"`
+	Submissions          []*Submission                 `protobuf:"bytes,12,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	GradingBenchmarks    []*GradingBenchmark           `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`
+	ContainerTimeout     uint32                        `protobuf:"varint,14,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`
+	ReleaseDate          string                        `protobuf:"bytes,15,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	Hidden               bool                          `protobuf:"varint,16,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Prerequisites        string                        `protobuf:"bytes,17,opt,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	PrerequisitePolicy   Assignment_PrerequisitePolicy `protobuf:"varint,18,opt,name=prerequisitePolicy,proto3,enum=Assignment_PrerequisitePolicy" json:"prerequisitePolicy,omitempty"`
+	Archived             bool                          `protobuf:"varint,19,opt,name=archived,proto3" json:"archived,omitempty"`
+	TestsCommit          string                        `protobuf:"bytes,20,opt,name=testsCommit,proto3" json:"testsCommit,omitempty"`
+	ScriptTemplate       string                        `protobuf:"bytes,21,opt,name=scriptTemplate,proto3" json:"scriptTemplate,omitempty"`
+	Analyzers            string                        `protobuf:"bytes,22,opt,name=analyzers,proto3" json:"analyzers,omitempty"`
+	Coverage             string                        `protobuf:"bytes,23,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	ArtifactsDir         string                        `protobuf:"bytes,24,opt,name=artifactsDir,proto3" json:"artifactsDir,omitempty"`
+	Reruns               uint32                        `protobuf:"varint,25,opt,name=reruns,proto3" json:"reruns,omitempty"`
+	RunnerLabels         string                        `protobuf:"bytes,26,opt,name=runnerLabels,proto3" json:"runnerLabels,omitempty"`
+	Benchmarks           string                        `protobuf:"bytes,27,opt,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Leaderboard          bool                          `protobuf:"varint,28,opt,name=leaderboard,proto3" json:"leaderboard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *Assignment) Reset()         { *m = Assignment{} }
@@ -1495,6 +1594,104 @@ func (m *Assignment) GetContainerTimeout() uint32 {
 	return 0
 }
 
+func (m *Assignment) GetReleaseDate() string {
+	if m != nil {
+		return m.ReleaseDate
+	}
+	return ""
+}
+
+func (m *Assignment) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+func (m *Assignment) GetPrerequisites() string {
+	if m != nil {
+		return m.Prerequisites
+	}
+	return ""
+}
+
+func (m *Assignment) GetPrerequisitePolicy() Assignment_PrerequisitePolicy {
+	if m != nil {
+		return m.PrerequisitePolicy
+	}
+	return Assignment_BLOCK_TESTS
+}
+
+func (m *Assignment) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *Assignment) GetTestsCommit() string {
+	if m != nil {
+		return m.TestsCommit
+	}
+	return ""
+}
+
+func (m *Assignment) GetScriptTemplate() string {
+	if m != nil {
+		return m.ScriptTemplate
+	}
+	return ""
+}
+
+func (m *Assignment) GetAnalyzers() string {
+	if m != nil {
+		return m.Analyzers
+	}
+	return ""
+}
+
+func (m *Assignment) GetCoverage() string {
+	if m != nil {
+		return m.Coverage
+	}
+	return ""
+}
+
+func (m *Assignment) GetArtifactsDir() string {
+	if m != nil {
+		return m.ArtifactsDir
+	}
+	return ""
+}
+
+func (m *Assignment) GetReruns() uint32 {
+	if m != nil {
+		return m.Reruns
+	}
+	return 0
+}
+
+func (m *Assignment) GetRunnerLabels() string {
+	if m != nil {
+		return m.RunnerLabels
+	}
+	return ""
+}
+
+func (m *Assignment) GetBenchmarks() string {
+	if m != nil {
+		return m.Benchmarks
+	}
+	return ""
+}
+
+func (m *Assignment) GetLeaderboard() bool {
+	if m != nil {
+		return m.Leaderboard
+	}
+	return false
+}
+
 type Assignments struct {
 	Assignments          []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -1542,6 +1739,95 @@ func (m *Assignments) GetAssignments() []*Assignment {
 	return nil
 }
 
+// AssignmentChanges reports the changes made to the course's assignments
+// when they were updated from the course's tests repository.
+type AssignmentChanges struct {
+	Added                []string          `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Updated              []string          `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	Renamed              map[string]string `protobuf:"bytes,3,rep,name=renamed,proto3" json:"renamed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Archived             []string          `protobuf:"bytes,4,rep,name=archived,proto3" json:"archived,omitempty"`
+	Image                string            `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	ImageError           string            `protobuf:"bytes,6,opt,name=imageError,proto3" json:"imageError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AssignmentChanges) Reset()         { *m = AssignmentChanges{} }
+func (m *AssignmentChanges) String() string { return proto.CompactTextString(m) }
+func (*AssignmentChanges) ProtoMessage()    {}
+func (*AssignmentChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{16}
+}
+func (m *AssignmentChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentChanges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignmentChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentChanges.Merge(m, src)
+}
+func (m *AssignmentChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignmentChanges proto.InternalMessageInfo
+
+func (m *AssignmentChanges) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *AssignmentChanges) GetUpdated() []string {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *AssignmentChanges) GetRenamed() map[string]string {
+	if m != nil {
+		return m.Renamed
+	}
+	return nil
+}
+
+func (m *AssignmentChanges) GetArchived() []string {
+	if m != nil {
+		return m.Archived
+	}
+	return nil
+}
+
+func (m *AssignmentChanges) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *AssignmentChanges) GetImageError() string {
+	if m != nil {
+		return m.ImageError
+	}
+	return ""
+}
+
 type Submission struct {
 	ID                   uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	AssignmentID         uint64            `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
@@ -1555,6 +1841,7 @@ type Submission struct {
 	Status               Submission_Status `protobuf:"varint,10,opt,name=status,proto3,enum=Submission_Status" json:"status,omitempty"`
 	ApprovedDate         string            `protobuf:"bytes,11,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
 	Reviews              []*Review         `protobuf:"bytes,12,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Tests                []*TestResult     `protobuf:"bytes,13,rep,name=tests,proto3" json:"tests,omitempty" sql:"-"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1564,7 +1851,7 @@ func (m *Submission) Reset()         { *m = Submission{} }
 func (m *Submission) String() string { return proto.CompactTextString(m) }
 func (*Submission) ProtoMessage()    {}
 func (*Submission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{16}
+	return fileDescriptor_7a984e8f57169aa1, []int{17}
 }
 func (m *Submission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,76 +1964,40 @@ func (m *Submission) GetReviews() []*Review {
 	return nil
 }
 
-type Submissions struct {
-	Submissions          []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *Submissions) Reset()         { *m = Submissions{} }
-func (m *Submissions) String() string { return proto.CompactTextString(m) }
-func (*Submissions) ProtoMessage()    {}
-func (*Submissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{17}
-}
-func (m *Submissions) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Submissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Submissions.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Submissions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Submissions.Merge(m, src)
-}
-func (m *Submissions) XXX_Size() int {
-	return m.Size()
-}
-func (m *Submissions) XXX_DiscardUnknown() {
-	xxx_messageInfo_Submissions.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Submissions proto.InternalMessageInfo
-
-func (m *Submissions) GetSubmissions() []*Submission {
+func (m *Submission) GetTests() []*TestResult {
 	if m != nil {
-		return m.Submissions
+		return m.Tests
 	}
 	return nil
 }
 
-type GradingBenchmark struct {
-	ID                   uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID         uint64              `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	Heading              string              `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment              string              `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria             []*GradingCriterion `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// TestResult is the score and details of a test, as reported by the test.
+type TestResult struct {
+	TestName             string   `protobuf:"bytes,1,opt,name=testName,proto3" json:"testName,omitempty"`
+	Score                int32    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore             int32    `protobuf:"varint,3,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	Weight               int32    `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Duration             int64    `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Failure              string   `protobuf:"bytes,6,opt,name=failure,proto3" json:"failure,omitempty"`
+	Expected             string   `protobuf:"bytes,7,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual               string   `protobuf:"bytes,8,opt,name=actual,proto3" json:"actual,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GradingBenchmark) Reset()         { *m = GradingBenchmark{} }
-func (m *GradingBenchmark) String() string { return proto.CompactTextString(m) }
-func (*GradingBenchmark) ProtoMessage()    {}
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+func (m *TestResult) Reset()         { *m = TestResult{} }
+func (m *TestResult) String() string { return proto.CompactTextString(m) }
+func (*TestResult) ProtoMessage()    {}
+func (*TestResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7a984e8f57169aa1, []int{18}
 }
-func (m *GradingBenchmark) XXX_Unmarshal(b []byte) error {
+func (m *TestResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GradingBenchmark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GradingBenchmark.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TestResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1756,40 +2007,251 @@ func (m *GradingBenchmark) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GradingBenchmark) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GradingBenchmark.Merge(m, src)
+func (m *TestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestResult.Merge(m, src)
 }
-func (m *GradingBenchmark) XXX_Size() int {
+func (m *TestResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *GradingBenchmark) XXX_DiscardUnknown() {
-	xxx_messageInfo_GradingBenchmark.DiscardUnknown(m)
+func (m *TestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GradingBenchmark proto.InternalMessageInfo
+var xxx_messageInfo_TestResult proto.InternalMessageInfo
 
-func (m *GradingBenchmark) GetID() uint64 {
+func (m *TestResult) GetTestName() string {
 	if m != nil {
-		return m.ID
+		return m.TestName
 	}
-	return 0
+	return ""
 }
 
-func (m *GradingBenchmark) GetAssignmentID() uint64 {
+func (m *TestResult) GetScore() int32 {
 	if m != nil {
-		return m.AssignmentID
+		return m.Score
 	}
 	return 0
 }
 
-func (m *GradingBenchmark) GetHeading() string {
+func (m *TestResult) GetMaxScore() int32 {
 	if m != nil {
-		return m.Heading
+		return m.MaxScore
 	}
-	return ""
+	return 0
 }
 
-func (m *GradingBenchmark) GetComment() string {
+func (m *TestResult) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *TestResult) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *TestResult) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
+func (m *TestResult) GetExpected() string {
+	if m != nil {
+		return m.Expected
+	}
+	return ""
+}
+
+func (m *TestResult) GetActual() string {
+	if m != nil {
+		return m.Actual
+	}
+	return ""
+}
+
+// BuildLog is the complete output of the latest build of a submission.
+type BuildLog struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID         uint64   `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"unique_index:idx_unique_build_log"`
+	Log                  []byte   `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildLog) Reset()         { *m = BuildLog{} }
+func (m *BuildLog) String() string { return proto.CompactTextString(m) }
+func (*BuildLog) ProtoMessage()    {}
+func (*BuildLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{19}
+}
+func (m *BuildLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuildLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildLog.Merge(m, src)
+}
+func (m *BuildLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildLog proto.InternalMessageInfo
+
+func (m *BuildLog) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *BuildLog) GetSubmissionID() uint64 {
+	if m != nil {
+		return m.SubmissionID
+	}
+	return 0
+}
+
+func (m *BuildLog) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+type Submissions struct {
+	Submissions          []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Submissions) Reset()         { *m = Submissions{} }
+func (m *Submissions) String() string { return proto.CompactTextString(m) }
+func (*Submissions) ProtoMessage()    {}
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{20}
+}
+func (m *Submissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Submissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Submissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Submissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Submissions.Merge(m, src)
+}
+func (m *Submissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *Submissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Submissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Submissions proto.InternalMessageInfo
+
+func (m *Submissions) GetSubmissions() []*Submission {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+type GradingBenchmark struct {
+	ID                   uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID         uint64              `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Heading              string              `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment              string              `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria             []*GradingCriterion `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GradingBenchmark) Reset()         { *m = GradingBenchmark{} }
+func (m *GradingBenchmark) String() string { return proto.CompactTextString(m) }
+func (*GradingBenchmark) ProtoMessage()    {}
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{21}
+}
+func (m *GradingBenchmark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GradingBenchmark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GradingBenchmark.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GradingBenchmark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradingBenchmark.Merge(m, src)
+}
+func (m *GradingBenchmark) XXX_Size() int {
+	return m.Size()
+}
+func (m *GradingBenchmark) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradingBenchmark.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradingBenchmark proto.InternalMessageInfo
+
+func (m *GradingBenchmark) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *GradingBenchmark) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
+	}
+	return 0
+}
+
+func (m *GradingBenchmark) GetHeading() string {
+	if m != nil {
+		return m.Heading
+	}
+	return ""
+}
+
+func (m *GradingBenchmark) GetComment() string {
 	if m != nil {
 		return m.Comment
 	}
@@ -1814,7 +2276,7 @@ func (m *Benchmarks) Reset()         { *m = Benchmarks{} }
 func (m *Benchmarks) String() string { return proto.CompactTextString(m) }
 func (*Benchmarks) ProtoMessage()    {}
 func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{19}
+	return fileDescriptor_7a984e8f57169aa1, []int{22}
 }
 func (m *Benchmarks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1866,7 +2328,7 @@ func (m *GradingCriterion) Reset()         { *m = GradingCriterion{} }
 func (m *GradingCriterion) String() string { return proto.CompactTextString(m) }
 func (*GradingCriterion) ProtoMessage()    {}
 func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{20}
+	return fileDescriptor_7a984e8f57169aa1, []int{23}
 }
 func (m *GradingCriterion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,7 +2417,7 @@ func (m *Review) Reset()         { *m = Review{} }
 func (m *Review) String() string { return proto.CompactTextString(m) }
 func (*Review) ProtoMessage()    {}
 func (*Review) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{21}
+	return fileDescriptor_7a984e8f57169aa1, []int{24}
 }
 func (m *Review) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2051,7 +2513,7 @@ func (m *Reviewers) Reset()         { *m = Reviewers{} }
 func (m *Reviewers) String() string { return proto.CompactTextString(m) }
 func (*Reviewers) ProtoMessage()    {}
 func (*Reviewers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{22}
+	return fileDescriptor_7a984e8f57169aa1, []int{25}
 }
 func (m *Reviewers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2087,26 +2549,36 @@ func (m *Reviewers) GetReviewers() []*User {
 	return nil
 }
 
-type ReviewRequest struct {
-	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Review               *Review  `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ReviewRequest) Reset()         { *m = ReviewRequest{} }
-func (m *ReviewRequest) String() string { return proto.CompactTextString(m) }
-func (*ReviewRequest) ProtoMessage()    {}
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{23}
+// Regrade is a student's request to have the score of a submission reconsidered.
+type Regrade struct {
+	ID                   uint64         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID         uint64         `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	UserID               uint64         `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reason               string         `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	TestName             string         `protobuf:"bytes,5,opt,name=testName,proto3" json:"testName,omitempty"`
+	State                Regrade_State  `protobuf:"varint,6,opt,name=state,proto3,enum=Regrade_State" json:"state,omitempty"`
+	CreatedDate          string         `protobuf:"bytes,7,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	ResolverID           uint64         `protobuf:"varint,8,opt,name=resolverID,proto3" json:"resolverID,omitempty"`
+	ResolvedDate         string         `protobuf:"bytes,9,opt,name=resolvedDate,proto3" json:"resolvedDate,omitempty"`
+	Response             string         `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+	ScoreUpdates         []*ScoreUpdate `protobuf:"bytes,11,rep,name=scoreUpdates,proto3" json:"scoreUpdates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Regrade) Reset()         { *m = Regrade{} }
+func (m *Regrade) String() string { return proto.CompactTextString(m) }
+func (*Regrade) ProtoMessage()    {}
+func (*Regrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{26}
 }
-func (m *ReviewRequest) XXX_Unmarshal(b []byte) error {
+func (m *Regrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Regrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReviewRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Regrade.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2116,58 +2588,319 @@ func (m *ReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ReviewRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReviewRequest.Merge(m, src)
+func (m *Regrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Regrade.Merge(m, src)
 }
-func (m *ReviewRequest) XXX_Size() int {
+func (m *Regrade) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReviewRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReviewRequest.DiscardUnknown(m)
+func (m *Regrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Regrade.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReviewRequest proto.InternalMessageInfo
+var xxx_messageInfo_Regrade proto.InternalMessageInfo
 
-func (m *ReviewRequest) GetCourseID() uint64 {
+func (m *Regrade) GetID() uint64 {
 	if m != nil {
-		return m.CourseID
+		return m.ID
 	}
 	return 0
 }
 
-func (m *ReviewRequest) GetReview() *Review {
+func (m *Regrade) GetSubmissionID() uint64 {
 	if m != nil {
-		return m.Review
+		return m.SubmissionID
 	}
-	return nil
+	return 0
 }
 
-type CourseRequest struct {
-	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Regrade) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
 }
 
-func (m *CourseRequest) Reset()         { *m = CourseRequest{} }
-func (m *CourseRequest) String() string { return proto.CompactTextString(m) }
-func (*CourseRequest) ProtoMessage()    {}
-func (*CourseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{24}
+func (m *Regrade) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (m *CourseRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Regrade) GetTestName() string {
+	if m != nil {
+		return m.TestName
+	}
+	return ""
 }
-func (m *CourseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CourseRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Regrade) GetState() Regrade_State {
+	if m != nil {
+		return m.State
+	}
+	return Regrade_OPEN
+}
+
+func (m *Regrade) GetCreatedDate() string {
+	if m != nil {
+		return m.CreatedDate
+	}
+	return ""
+}
+
+func (m *Regrade) GetResolverID() uint64 {
+	if m != nil {
+		return m.ResolverID
+	}
+	return 0
+}
+
+func (m *Regrade) GetResolvedDate() string {
+	if m != nil {
+		return m.ResolvedDate
+	}
+	return ""
+}
+
+func (m *Regrade) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *Regrade) GetScoreUpdates() []*ScoreUpdate {
+	if m != nil {
+		return m.ScoreUpdates
+	}
+	return nil
+}
+
+// ScoreUpdate records a change of a submission's score made by a teacher.
+type ScoreUpdate struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID         uint64   `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	RegradeID            uint64   `protobuf:"varint,3,opt,name=regradeID,proto3" json:"regradeID,omitempty"`
+	UpdatedByID          uint64   `protobuf:"varint,4,opt,name=updatedByID,proto3" json:"updatedByID,omitempty"`
+	OldScore             uint32   `protobuf:"varint,5,opt,name=oldScore,proto3" json:"oldScore,omitempty"`
+	NewScore             uint32   `protobuf:"varint,6,opt,name=newScore,proto3" json:"newScore,omitempty"`
+	UpdatedDate          string   `protobuf:"bytes,7,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScoreUpdate) Reset()         { *m = ScoreUpdate{} }
+func (m *ScoreUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScoreUpdate) ProtoMessage()    {}
+func (*ScoreUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{27}
+}
+func (m *ScoreUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoreUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreUpdate.Merge(m, src)
+}
+func (m *ScoreUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreUpdate proto.InternalMessageInfo
+
+func (m *ScoreUpdate) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ScoreUpdate) GetSubmissionID() uint64 {
+	if m != nil {
+		return m.SubmissionID
+	}
+	return 0
+}
+
+func (m *ScoreUpdate) GetRegradeID() uint64 {
+	if m != nil {
+		return m.RegradeID
+	}
+	return 0
+}
+
+func (m *ScoreUpdate) GetUpdatedByID() uint64 {
+	if m != nil {
+		return m.UpdatedByID
+	}
+	return 0
+}
+
+func (m *ScoreUpdate) GetOldScore() uint32 {
+	if m != nil {
+		return m.OldScore
+	}
+	return 0
+}
+
+func (m *ScoreUpdate) GetNewScore() uint32 {
+	if m != nil {
+		return m.NewScore
+	}
+	return 0
+}
+
+func (m *ScoreUpdate) GetUpdatedDate() string {
+	if m != nil {
+		return m.UpdatedDate
+	}
+	return ""
+}
+
+type Regrades struct {
+	Regrades             []*Regrade `protobuf:"bytes,1,rep,name=regrades,proto3" json:"regrades,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Regrades) Reset()         { *m = Regrades{} }
+func (m *Regrades) String() string { return proto.CompactTextString(m) }
+func (*Regrades) ProtoMessage()    {}
+func (*Regrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{28}
+}
+func (m *Regrades) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Regrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Regrades.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Regrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Regrades.Merge(m, src)
+}
+func (m *Regrades) XXX_Size() int {
+	return m.Size()
+}
+func (m *Regrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_Regrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Regrades proto.InternalMessageInfo
+
+func (m *Regrades) GetRegrades() []*Regrade {
+	if m != nil {
+		return m.Regrades
+	}
+	return nil
+}
+
+type ReviewRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Review               *Review  `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewRequest) Reset()         { *m = ReviewRequest{} }
+func (m *ReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*ReviewRequest) ProtoMessage()    {}
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{29}
+}
+func (m *ReviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewRequest.Merge(m, src)
+}
+func (m *ReviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewRequest proto.InternalMessageInfo
+
+func (m *ReviewRequest) GetCourseID() uint64 {
+	if m != nil {
+		return m.CourseID
+	}
+	return 0
+}
+
+func (m *ReviewRequest) GetReview() *Review {
+	if m != nil {
+		return m.Review
+	}
+	return nil
+}
+
+type CourseRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CourseRequest) Reset()         { *m = CourseRequest{} }
+func (m *CourseRequest) String() string { return proto.CompactTextString(m) }
+func (*CourseRequest) ProtoMessage()    {}
+func (*CourseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{30}
+}
+func (m *CourseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CourseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CourseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
 func (m *CourseRequest) XXX_Merge(src proto.Message) {
@@ -2200,7 +2933,7 @@ func (m *UserRequest) Reset()         { *m = UserRequest{} }
 func (m *UserRequest) String() string { return proto.CompactTextString(m) }
 func (*UserRequest) ProtoMessage()    {}
 func (*UserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{25}
+	return fileDescriptor_7a984e8f57169aa1, []int{31}
 }
 func (m *UserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2247,7 +2980,7 @@ func (m *GetGroupRequest) Reset()         { *m = GetGroupRequest{} }
 func (m *GetGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupRequest) ProtoMessage()    {}
 func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{26}
+	return fileDescriptor_7a984e8f57169aa1, []int{32}
 }
 func (m *GetGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2296,7 +3029,7 @@ func (m *GroupRequest) Reset()         { *m = GroupRequest{} }
 func (m *GroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GroupRequest) ProtoMessage()    {}
 func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{27}
+	return fileDescriptor_7a984e8f57169aa1, []int{33}
 }
 func (m *GroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2357,7 +3090,7 @@ func (m *Provider) Reset()         { *m = Provider{} }
 func (m *Provider) String() string { return proto.CompactTextString(m) }
 func (*Provider) ProtoMessage()    {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{28}
+	return fileDescriptor_7a984e8f57169aa1, []int{34}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2404,7 +3137,7 @@ func (m *OrgRequest) Reset()         { *m = OrgRequest{} }
 func (m *OrgRequest) String() string { return proto.CompactTextString(m) }
 func (*OrgRequest) ProtoMessage()    {}
 func (*OrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{29}
+	return fileDescriptor_7a984e8f57169aa1, []int{35}
 }
 func (m *OrgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2454,7 +3187,7 @@ func (m *Organization) Reset()         { *m = Organization{} }
 func (m *Organization) String() string { return proto.CompactTextString(m) }
 func (*Organization) ProtoMessage()    {}
 func (*Organization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{30}
+	return fileDescriptor_7a984e8f57169aa1, []int{36}
 }
 func (m *Organization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2522,7 +3255,7 @@ func (m *Organizations) Reset()         { *m = Organizations{} }
 func (m *Organizations) String() string { return proto.CompactTextString(m) }
 func (*Organizations) ProtoMessage()    {}
 func (*Organizations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{31}
+	return fileDescriptor_7a984e8f57169aa1, []int{37}
 }
 func (m *Organizations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2575,7 +3308,7 @@ func (m *EnrollmentRequest) Reset()         { *m = EnrollmentRequest{} }
 func (m *EnrollmentRequest) String() string { return proto.CompactTextString(m) }
 func (*EnrollmentRequest) ProtoMessage()    {}
 func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{32}
+	return fileDescriptor_7a984e8f57169aa1, []int{38}
 }
 func (m *EnrollmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2645,7 +3378,7 @@ func (m *EnrollmentStatusRequest) Reset()         { *m = EnrollmentStatusRequest
 func (m *EnrollmentStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*EnrollmentStatusRequest) ProtoMessage()    {}
 func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{33}
+	return fileDescriptor_7a984e8f57169aa1, []int{39}
 }
 func (m *EnrollmentStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2701,7 +3434,7 @@ func (m *SubmissionRequest) Reset()         { *m = SubmissionRequest{} }
 func (m *SubmissionRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmissionRequest) ProtoMessage()    {}
 func (*SubmissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{34}
+	return fileDescriptor_7a984e8f57169aa1, []int{40}
 }
 func (m *SubmissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2766,7 +3499,7 @@ func (m *UpdateSubmissionRequest) Reset()         { *m = UpdateSubmissionRequest
 func (m *UpdateSubmissionRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSubmissionRequest) ProtoMessage()    {}
 func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{35}
+	return fileDescriptor_7a984e8f57169aa1, []int{41}
 }
 func (m *UpdateSubmissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2845,7 +3578,7 @@ func (m *UpdateSubmissionsRequest) Reset()         { *m = UpdateSubmissionsReque
 func (m *UpdateSubmissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSubmissionsRequest) ProtoMessage()    {}
 func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{36}
+	return fileDescriptor_7a984e8f57169aa1, []int{42}
 }
 func (m *UpdateSubmissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2921,7 +3654,7 @@ func (m *SubmissionReviewersRequest) Reset()         { *m = SubmissionReviewersR
 func (m *SubmissionReviewersRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmissionReviewersRequest) ProtoMessage()    {}
 func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{37}
+	return fileDescriptor_7a984e8f57169aa1, []int{43}
 }
 func (m *SubmissionReviewersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2975,7 +3708,7 @@ func (m *Providers) Reset()         { *m = Providers{} }
 func (m *Providers) String() string { return proto.CompactTextString(m) }
 func (*Providers) ProtoMessage()    {}
 func (*Providers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{38}
+	return fileDescriptor_7a984e8f57169aa1, []int{44}
 }
 func (m *Providers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3023,7 +3756,7 @@ func (m *URLRequest) Reset()         { *m = URLRequest{} }
 func (m *URLRequest) String() string { return proto.CompactTextString(m) }
 func (*URLRequest) ProtoMessage()    {}
 func (*URLRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{39}
+	return fileDescriptor_7a984e8f57169aa1, []int{45}
 }
 func (m *URLRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +3813,7 @@ func (m *RepositoryRequest) Reset()         { *m = RepositoryRequest{} }
 func (m *RepositoryRequest) String() string { return proto.CompactTextString(m) }
 func (*RepositoryRequest) ProtoMessage()    {}
 func (*RepositoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{40}
+	return fileDescriptor_7a984e8f57169aa1, []int{46}
 }
 func (m *RepositoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3141,7 +3874,7 @@ func (m *Repositories) Reset()         { *m = Repositories{} }
 func (m *Repositories) String() string { return proto.CompactTextString(m) }
 func (*Repositories) ProtoMessage()    {}
 func (*Repositories) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{41}
+	return fileDescriptor_7a984e8f57169aa1, []int{47}
 }
 func (m *Repositories) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3188,7 +3921,7 @@ func (m *AuthorizationResponse) Reset()         { *m = AuthorizationResponse{} }
 func (m *AuthorizationResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthorizationResponse) ProtoMessage()    {}
 func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{42}
+	return fileDescriptor_7a984e8f57169aa1, []int{48}
 }
 func (m *AuthorizationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3969,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{43}
+	return fileDescriptor_7a984e8f57169aa1, []int{49}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3291,7 +4024,7 @@ func (m *SubmissionsForCourseRequest) Reset()         { *m = SubmissionsForCours
 func (m *SubmissionsForCourseRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmissionsForCourseRequest) ProtoMessage()    {}
 func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{44}
+	return fileDescriptor_7a984e8f57169aa1, []int{50}
 }
 func (m *SubmissionsForCourseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3337,6 +4070,8 @@ func (m *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type
 type RebuildRequest struct {
 	SubmissionID         uint64   `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
 	AssignmentID         uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	LatestTests          bool     `protobuf:"varint,3,opt,name=latestTests,proto3" json:"latestTests,omitempty"`
+	NoCache              bool     `protobuf:"varint,4,opt,name=noCache,proto3" json:"noCache,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3346,7 +4081,7 @@ func (m *RebuildRequest) Reset()         { *m = RebuildRequest{} }
 func (m *RebuildRequest) String() string { return proto.CompactTextString(m) }
 func (*RebuildRequest) ProtoMessage()    {}
 func (*RebuildRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{45}
+	return fileDescriptor_7a984e8f57169aa1, []int{51}
 }
 func (m *RebuildRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3389,27 +4124,40 @@ func (m *RebuildRequest) GetAssignmentID() uint64 {
 	return 0
 }
 
-type CourseUserRequest struct {
-	CourseCode           string   `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
-	CourseYear           uint32   `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
-	UserLogin            string   `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+func (m *RebuildRequest) GetLatestTests() bool {
+	if m != nil {
+		return m.LatestTests
+	}
+	return false
+}
+
+func (m *RebuildRequest) GetNoCache() bool {
+	if m != nil {
+		return m.NoCache
+	}
+	return false
+}
+
+type AssignmentRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID         uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CourseUserRequest) Reset()         { *m = CourseUserRequest{} }
-func (m *CourseUserRequest) String() string { return proto.CompactTextString(m) }
-func (*CourseUserRequest) ProtoMessage()    {}
-func (*CourseUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{46}
+func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
+func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignmentRequest) ProtoMessage()    {}
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{52}
 }
-func (m *CourseUserRequest) XXX_Unmarshal(b []byte) error {
+func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CourseUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CourseUserRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AssignmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3419,59 +4167,56 @@ func (m *CourseUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *CourseUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CourseUserRequest.Merge(m, src)
+func (m *AssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentRequest.Merge(m, src)
 }
-func (m *CourseUserRequest) XXX_Size() int {
+func (m *AssignmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CourseUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CourseUserRequest.DiscardUnknown(m)
+func (m *AssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CourseUserRequest proto.InternalMessageInfo
-
-func (m *CourseUserRequest) GetCourseCode() string {
-	if m != nil {
-		return m.CourseCode
-	}
-	return ""
-}
+var xxx_messageInfo_AssignmentRequest proto.InternalMessageInfo
 
-func (m *CourseUserRequest) GetCourseYear() uint32 {
+func (m *AssignmentRequest) GetCourseID() uint64 {
 	if m != nil {
-		return m.CourseYear
+		return m.CourseID
 	}
 	return 0
 }
 
-func (m *CourseUserRequest) GetUserLogin() string {
+func (m *AssignmentRequest) GetAssignmentID() uint64 {
 	if m != nil {
-		return m.UserLogin
+		return m.AssignmentID
 	}
-	return ""
+	return 0
 }
 
-type LoadCriteriaRequest struct {
-	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID         uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RebuildAssignmentRequest struct {
+	CourseID             uint64              `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID         uint64              `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Statuses             []Submission_Status `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=Submission_Status" json:"statuses,omitempty"`
+	ScoreBelow           uint32              `protobuf:"varint,4,opt,name=scoreBelow,proto3" json:"scoreBelow,omitempty"`
+	LatestTests          bool                `protobuf:"varint,5,opt,name=latestTests,proto3" json:"latestTests,omitempty"`
+	NoCache              bool                `protobuf:"varint,6,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *LoadCriteriaRequest) Reset()         { *m = LoadCriteriaRequest{} }
-func (m *LoadCriteriaRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadCriteriaRequest) ProtoMessage()    {}
-func (*LoadCriteriaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{47}
+func (m *RebuildAssignmentRequest) Reset()         { *m = RebuildAssignmentRequest{} }
+func (m *RebuildAssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildAssignmentRequest) ProtoMessage()    {}
+func (*RebuildAssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{53}
 }
-func (m *LoadCriteriaRequest) XXX_Unmarshal(b []byte) error {
+func (m *RebuildAssignmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LoadCriteriaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RebuildAssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LoadCriteriaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RebuildAssignmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3481,53 +4226,83 @@ func (m *LoadCriteriaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *LoadCriteriaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadCriteriaRequest.Merge(m, src)
+func (m *RebuildAssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildAssignmentRequest.Merge(m, src)
 }
-func (m *LoadCriteriaRequest) XXX_Size() int {
+func (m *RebuildAssignmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LoadCriteriaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadCriteriaRequest.DiscardUnknown(m)
+func (m *RebuildAssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildAssignmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadCriteriaRequest proto.InternalMessageInfo
+var xxx_messageInfo_RebuildAssignmentRequest proto.InternalMessageInfo
 
-func (m *LoadCriteriaRequest) GetCourseID() uint64 {
+func (m *RebuildAssignmentRequest) GetCourseID() uint64 {
 	if m != nil {
 		return m.CourseID
 	}
 	return 0
 }
 
-func (m *LoadCriteriaRequest) GetAssignmentID() uint64 {
+func (m *RebuildAssignmentRequest) GetAssignmentID() uint64 {
 	if m != nil {
 		return m.AssignmentID
 	}
 	return 0
 }
 
-// Void contains no fields. A server response with a Void still contains a gRPC status code,
-// which can be checked for success or failure. Status code 0 indicates that the requested action was successful,
-// whereas any other status code indicates some failure. As such, the status code can be used as a boolean result from the server.
-type Void struct {
+func (m *RebuildAssignmentRequest) GetStatuses() []Submission_Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *RebuildAssignmentRequest) GetScoreBelow() uint32 {
+	if m != nil {
+		return m.ScoreBelow
+	}
+	return 0
+}
+
+func (m *RebuildAssignmentRequest) GetLatestTests() bool {
+	if m != nil {
+		return m.LatestTests
+	}
+	return false
+}
+
+func (m *RebuildAssignmentRequest) GetNoCache() bool {
+	if m != nil {
+		return m.NoCache
+	}
+	return false
+}
+
+type ScoreChange struct {
+	SubmissionID         uint64   `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	UserID               uint64   `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID              uint64   `protobuf:"varint,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	OldScore             uint32   `protobuf:"varint,4,opt,name=oldScore,proto3" json:"oldScore,omitempty"`
+	NewScore             uint32   `protobuf:"varint,5,opt,name=newScore,proto3" json:"newScore,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Void) Reset()         { *m = Void{} }
-func (m *Void) String() string { return proto.CompactTextString(m) }
-func (*Void) ProtoMessage()    {}
-func (*Void) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a984e8f57169aa1, []int{48}
+func (m *ScoreChange) Reset()         { *m = ScoreChange{} }
+func (m *ScoreChange) String() string { return proto.CompactTextString(m) }
+func (*ScoreChange) ProtoMessage()    {}
+func (*ScoreChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{54}
 }
-func (m *Void) XXX_Unmarshal(b []byte) error {
+func (m *ScoreChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScoreChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Void.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScoreChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3537,4001 +4312,3664 @@ func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Void) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Void.Merge(m, src)
+func (m *ScoreChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreChange.Merge(m, src)
 }
-func (m *Void) XXX_Size() int {
+func (m *ScoreChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *Void) XXX_DiscardUnknown() {
-	xxx_messageInfo_Void.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Void proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterEnum("Group_GroupStatus", Group_GroupStatus_name, Group_GroupStatus_value)
-	proto.RegisterEnum("Repository_Type", Repository_Type_name, Repository_Type_value)
-	proto.RegisterEnum("Enrollment_UserStatus", Enrollment_UserStatus_name, Enrollment_UserStatus_value)
-	proto.RegisterEnum("Enrollment_DisplayState", Enrollment_DisplayState_name, Enrollment_DisplayState_value)
-	proto.RegisterEnum("Submission_Status", Submission_Status_name, Submission_Status_value)
-	proto.RegisterEnum("GradingCriterion_Grade", GradingCriterion_Grade_name, GradingCriterion_Grade_value)
-	proto.RegisterEnum("SubmissionsForCourseRequest_Type", SubmissionsForCourseRequest_Type_name, SubmissionsForCourseRequest_Type_value)
-	proto.RegisterType((*User)(nil), "User")
-	proto.RegisterType((*Users)(nil), "Users")
-	proto.RegisterType((*RemoteIdentity)(nil), "RemoteIdentity")
-	proto.RegisterType((*Group)(nil), "Group")
-	proto.RegisterType((*Groups)(nil), "Groups")
-	proto.RegisterType((*Course)(nil), "Course")
-	proto.RegisterType((*Courses)(nil), "Courses")
-	proto.RegisterType((*Repository)(nil), "Repository")
-	proto.RegisterType((*Enrollment)(nil), "Enrollment")
-	proto.RegisterType((*UsedSlipDays)(nil), "UsedSlipDays")
-	proto.RegisterType((*Enrollments)(nil), "Enrollments")
-	proto.RegisterType((*SubmissionLink)(nil), "SubmissionLink")
-	proto.RegisterType((*EnrollmentLink)(nil), "EnrollmentLink")
-	proto.RegisterType((*CourseSubmissions)(nil), "CourseSubmissions")
-	proto.RegisterType((*Assignment)(nil), "Assignment")
-	proto.RegisterType((*Assignments)(nil), "Assignments")
-	proto.RegisterType((*Submission)(nil), "Submission")
-	proto.RegisterType((*Submissions)(nil), "Submissions")
-	proto.RegisterType((*GradingBenchmark)(nil), "GradingBenchmark")
-	proto.RegisterType((*Benchmarks)(nil), "Benchmarks")
-	proto.RegisterType((*GradingCriterion)(nil), "GradingCriterion")
-	proto.RegisterType((*Review)(nil), "Review")
-	proto.RegisterType((*Reviewers)(nil), "Reviewers")
-	proto.RegisterType((*ReviewRequest)(nil), "ReviewRequest")
-	proto.RegisterType((*CourseRequest)(nil), "CourseRequest")
-	proto.RegisterType((*UserRequest)(nil), "UserRequest")
-	proto.RegisterType((*GetGroupRequest)(nil), "GetGroupRequest")
-	proto.RegisterType((*GroupRequest)(nil), "GroupRequest")
-	proto.RegisterType((*Provider)(nil), "Provider")
-	proto.RegisterType((*OrgRequest)(nil), "OrgRequest")
-	proto.RegisterType((*Organization)(nil), "Organization")
-	proto.RegisterType((*Organizations)(nil), "Organizations")
-	proto.RegisterType((*EnrollmentRequest)(nil), "EnrollmentRequest")
-	proto.RegisterType((*EnrollmentStatusRequest)(nil), "EnrollmentStatusRequest")
-	proto.RegisterType((*SubmissionRequest)(nil), "SubmissionRequest")
-	proto.RegisterType((*UpdateSubmissionRequest)(nil), "UpdateSubmissionRequest")
-	proto.RegisterType((*UpdateSubmissionsRequest)(nil), "UpdateSubmissionsRequest")
-	proto.RegisterType((*SubmissionReviewersRequest)(nil), "SubmissionReviewersRequest")
-	proto.RegisterType((*Providers)(nil), "Providers")
-	proto.RegisterType((*URLRequest)(nil), "URLRequest")
-	proto.RegisterType((*RepositoryRequest)(nil), "RepositoryRequest")
-	proto.RegisterType((*Repositories)(nil), "Repositories")
-	proto.RegisterMapType((map[string]string)(nil), "Repositories.URLsEntry")
-	proto.RegisterType((*AuthorizationResponse)(nil), "AuthorizationResponse")
-	proto.RegisterType((*Status)(nil), "Status")
-	proto.RegisterType((*SubmissionsForCourseRequest)(nil), "SubmissionsForCourseRequest")
-	proto.RegisterType((*RebuildRequest)(nil), "RebuildRequest")
-	proto.RegisterType((*CourseUserRequest)(nil), "CourseUserRequest")
-	proto.RegisterType((*LoadCriteriaRequest)(nil), "LoadCriteriaRequest")
-	proto.RegisterType((*Void)(nil), "Void")
-}
-
-func init() { proto.RegisterFile("ag.proto", fileDescriptor_7a984e8f57169aa1) }
-
-var fileDescriptor_7a984e8f57169aa1 = []byte{
-	// 3060 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x72, 0x1b, 0xc7,
-	0xd5, 0xc6, 0xe0, 0x8e, 0x83, 0x0b, 0xc1, 0xb6, 0x7e, 0x09, 0x86, 0x54, 0xa2, 0xdc, 0xb6, 0xf5,
-	0x53, 0x92, 0x39, 0xb6, 0xe9, 0x38, 0xb6, 0x65, 0x27, 0x36, 0x48, 0x40, 0x14, 0x5c, 0x30, 0xc9,
-	0x34, 0x40, 0x96, 0x53, 0x71, 0x15, 0x6b, 0x08, 0xb4, 0xc1, 0x31, 0x81, 0x19, 0x68, 0x66, 0x20,
-	0x1b, 0x79, 0x84, 0x3c, 0x41, 0x16, 0x79, 0x81, 0x6c, 0xb2, 0xf5, 0x2b, 0x64, 0x99, 0x65, 0xaa,
-	0x52, 0x51, 0x52, 0x7e, 0x81, 0x54, 0x71, 0x9d, 0x45, 0xaa, 0x6f, 0x33, 0x3d, 0x18, 0xde, 0xe4,
-	0xb2, 0x37, 0xe2, 0xf4, 0xe9, 0xd3, 0xdd, 0xe7, 0xd6, 0xdf, 0x39, 0x7d, 0x20, 0x28, 0x5a, 0x63,
-	0x73, 0xe6, 0xb9, 0x81, 0xdb, 0xbc, 0x31, 0x76, 0xc7, 0x2e, 0xff, 0x7c, 0x9b, 0x7d, 0x09, 0x2a,
-	0xfe, 0x63, 0x1a, 0xb2, 0x07, 0x3e, 0xf5, 0x50, 0x0d, 0xd2, 0xdd, 0x76, 0xc3, 0xb8, 0x67, 0xac,
-	0x67, 0x49, 0xba, 0xdb, 0x46, 0x0d, 0x28, 0xd8, 0x7e, 0x6b, 0x34, 0xb5, 0x9d, 0x46, 0xfa, 0x9e,
-	0xb1, 0x5e, 0x24, 0x6a, 0x88, 0x10, 0x64, 0x1d, 0x6b, 0x4a, 0x1b, 0x99, 0x7b, 0xc6, 0x7a, 0x89,
-	0xf0, 0x6f, 0x74, 0x07, 0x4a, 0x7e, 0x30, 0x1f, 0x51, 0x27, 0xe8, 0xb6, 0x1b, 0x59, 0x3e, 0x11,
-	0x11, 0xd0, 0x0d, 0xc8, 0xd1, 0xa9, 0x65, 0x4f, 0x1a, 0x39, 0x3e, 0x23, 0x06, 0x6c, 0x8d, 0xf5,
-	0xdc, 0x0a, 0x2c, 0xef, 0x80, 0xf4, 0x1a, 0x79, 0xb1, 0x26, 0x24, 0xb0, 0x35, 0x13, 0x77, 0x6c,
-	0x3b, 0x8d, 0x82, 0x58, 0xc3, 0x07, 0xe8, 0x63, 0xa8, 0x7b, 0x74, 0xea, 0x06, 0xb4, 0xcb, 0xb6,
-	0xb6, 0x03, 0x9b, 0xfa, 0x8d, 0xe2, 0xbd, 0xcc, 0x7a, 0x79, 0x73, 0xc5, 0x24, 0xfa, 0xc4, 0x82,
-	0x24, 0x18, 0xd1, 0x06, 0x94, 0xa9, 0xe3, 0xb9, 0x93, 0xc9, 0x94, 0x3a, 0x81, 0xdf, 0x28, 0xf1,
-	0x75, 0x65, 0xb3, 0x13, 0xd2, 0x88, 0x3e, 0x8f, 0xdf, 0x80, 0x1c, 0xb3, 0x8c, 0x8f, 0x6e, 0x43,
-	0x6e, 0xce, 0x3e, 0x1a, 0x06, 0x5f, 0x91, 0x33, 0x19, 0x99, 0x08, 0x1a, 0x3e, 0x33, 0xa0, 0x16,
-	0x3f, 0x39, 0x61, 0xca, 0xcf, 0xa1, 0x38, 0xf3, 0xdc, 0xe7, 0xf6, 0x88, 0x7a, 0xdc, 0x96, 0xa5,
-	0x2d, 0xf3, 0xec, 0xc5, 0xda, 0xc3, 0xb1, 0xeb, 0x4d, 0x1f, 0xe3, 0xb9, 0x63, 0x3f, 0x9b, 0xd3,
-	0x23, 0xdb, 0x19, 0xd1, 0xef, 0x1e, 0xcf, 0xed, 0xd1, 0x91, 0x62, 0x3d, 0x12, 0xf2, 0x1f, 0xd9,
-	0x23, 0x4c, 0xc2, 0xf5, 0x6c, 0x2f, 0xa9, 0x57, 0x9b, 0x3b, 0x20, 0xfb, 0xf2, 0x7b, 0xa9, 0xf5,
-	0xe8, 0x1e, 0x94, 0xad, 0xe1, 0x90, 0xfa, 0xfe, 0xc0, 0x3d, 0xa5, 0x8e, 0x74, 0x9b, 0x4e, 0x42,
-	0x37, 0x21, 0xcf, 0xb4, 0xec, 0xb6, 0xb9, 0xe7, 0xb2, 0x44, 0x8e, 0xf0, 0xbf, 0xd2, 0x90, 0xdb,
-	0xf1, 0xdc, 0xf9, 0x2c, 0xa1, 0x6b, 0x4b, 0x06, 0x87, 0xd0, 0x73, 0xe3, 0xec, 0xc5, 0xda, 0x83,
-	0x73, 0x64, 0xb3, 0x47, 0xdf, 0x1d, 0x49, 0xc2, 0x98, 0x6d, 0x73, 0xc4, 0xd6, 0x60, 0x19, 0x4b,
-	0x5d, 0x28, 0x0e, 0xdd, 0xb9, 0xe7, 0x47, 0x2a, 0xbe, 0xe4, 0x36, 0xe1, 0x72, 0x26, 0x7f, 0x40,
-	0xad, 0xa9, 0x8c, 0xc9, 0x2c, 0x91, 0x23, 0xf4, 0x10, 0xf2, 0x7e, 0x60, 0x05, 0x73, 0x9f, 0xeb,
-	0x55, 0xdb, 0x44, 0x26, 0xd7, 0x46, 0xfc, 0xdb, 0xe7, 0x33, 0x44, 0x72, 0x44, 0xde, 0xcf, 0x27,
-	0xbd, 0xbf, 0x1c, 0x52, 0x85, 0x2b, 0x42, 0x6a, 0x1d, 0xca, 0xda, 0x11, 0xa8, 0x0c, 0x85, 0xfd,
-	0xce, 0x6e, 0xbb, 0xbb, 0xbb, 0x53, 0x4f, 0xa1, 0x0a, 0x14, 0x5b, 0xfb, 0xfb, 0x64, 0xef, 0xb0,
-	0xd3, 0xae, 0x1b, 0x78, 0x1d, 0xf2, 0x9c, 0xd3, 0x47, 0x77, 0x21, 0xcf, 0x95, 0x53, 0xe1, 0x97,
-	0x17, 0x52, 0x12, 0x49, 0xc5, 0xff, 0xc8, 0x40, 0x7e, 0x9b, 0x2b, 0x9c, 0x70, 0xc6, 0x3a, 0xac,
-	0x08, 0x53, 0x6c, 0x7b, 0xd4, 0x0a, 0x5c, 0xe6, 0xc7, 0x34, 0x9f, 0x5c, 0x26, 0x9f, 0x7b, 0xa7,
-	0x11, 0x64, 0x87, 0xee, 0x88, 0xca, 0xb8, 0xe0, 0xdf, 0x8c, 0xb6, 0xa0, 0x96, 0xc7, 0xcd, 0x56,
-	0x25, 0xfc, 0x1b, 0xd5, 0x21, 0x13, 0x58, 0x63, 0x79, 0x83, 0xd9, 0x27, 0x6a, 0x6a, 0x01, 0x2f,
-	0xae, 0x6f, 0x14, 0xc0, 0xf7, 0xa1, 0xe6, 0x7a, 0x63, 0xcb, 0xb1, 0x7f, 0x6f, 0x05, 0xb6, 0xeb,
-	0x74, 0xdb, 0x8d, 0x22, 0x17, 0x69, 0x89, 0x8a, 0x1e, 0x42, 0x5d, 0xa7, 0xec, 0x5b, 0xc1, 0x49,
-	0xa3, 0xc4, 0xf7, 0x4a, 0xd0, 0xd9, 0x79, 0xfe, 0xc4, 0x9e, 0xb5, 0xad, 0x85, 0xdf, 0x00, 0x2e,
-	0x59, 0x38, 0x46, 0x9f, 0x42, 0x51, 0x78, 0x80, 0x8e, 0x1a, 0x65, 0xee, 0xec, 0x9b, 0x9a, 0x7b,
-	0xb8, 0x33, 0x85, 0x37, 0xb6, 0xca, 0x67, 0x2f, 0xd6, 0x0a, 0xfe, 0xb3, 0xc9, 0x63, 0xbc, 0x81,
-	0x49, 0xb8, 0x68, 0xd9, 0xc5, 0x95, 0xcb, 0x5d, 0xcc, 0xd8, 0x2d, 0xdf, 0xb7, 0xc7, 0x8e, 0x60,
-	0xaf, 0x4a, 0xf6, 0x56, 0x48, 0x23, 0xfa, 0xbc, 0xe6, 0xdd, 0xda, 0xb9, 0xde, 0x7d, 0x0b, 0x0a,
-	0xc2, 0xb9, 0x3e, 0x7a, 0x0d, 0x0a, 0xc2, 0x6d, 0x2a, 0x12, 0x0a, 0xa6, 0x98, 0x22, 0x8a, 0x8e,
-	0xff, 0x99, 0x01, 0x20, 0x74, 0xe6, 0xfa, 0x76, 0xe0, 0x7a, 0x49, 0x20, 0xda, 0x4f, 0xd8, 0x9e,
-	0x87, 0xc3, 0xd6, 0xfa, 0xd9, 0x8b, 0xb5, 0x37, 0x2e, 0x80, 0x90, 0xb1, 0x3d, 0x3a, 0x72, 0xbd,
-	0xf1, 0x51, 0xb0, 0x98, 0x51, 0x9c, 0xf0, 0x12, 0x86, 0x8a, 0x17, 0x9e, 0xa7, 0xee, 0x2b, 0x89,
-	0xd1, 0xd0, 0x67, 0x21, 0x88, 0x64, 0x5f, 0xf2, 0x34, 0xb9, 0x0e, 0x6d, 0x41, 0x81, 0x9b, 0x43,
-	0xe1, 0xd0, 0x4b, 0x6c, 0xa1, 0x16, 0xb2, 0x7c, 0xf6, 0x74, 0xf0, 0x45, 0x2f, 0xca, 0x35, 0x6a,
-	0x88, 0x0e, 0x19, 0xa4, 0xce, 0xdc, 0xc1, 0x62, 0x46, 0x79, 0xb4, 0xd6, 0x36, 0xeb, 0x66, 0x64,
-	0x44, 0x93, 0xd1, 0x5f, 0xe2, 0xc0, 0x70, 0x2f, 0xfc, 0x1b, 0xc8, 0xb2, 0xbf, 0xa8, 0x08, 0xd9,
-	0xdd, 0xbd, 0xdd, 0x4e, 0x3d, 0x85, 0x6a, 0x00, 0xdb, 0x7b, 0x07, 0xa4, 0xdf, 0xe9, 0xee, 0x3e,
-	0xd9, 0xab, 0x1b, 0x68, 0x05, 0xca, 0xad, 0x7e, 0xbf, 0xbb, 0xb3, 0xfb, 0x45, 0x67, 0x77, 0xd0,
-	0xaf, 0xa7, 0x51, 0x09, 0x72, 0x83, 0x4e, 0x7f, 0xd0, 0xaf, 0x67, 0xd8, 0xaa, 0x83, 0x7e, 0x87,
-	0xd4, 0xb3, 0x8c, 0xb8, 0x43, 0xf6, 0x0e, 0xf6, 0xeb, 0x39, 0xfc, 0x9f, 0x1c, 0x40, 0x14, 0x78,
-	0x09, 0xff, 0xea, 0xc8, 0x99, 0xbe, 0x2e, 0x72, 0x46, 0xc1, 0xab, 0x23, 0x67, 0x27, 0x74, 0x5a,
-	0xe6, 0xc7, 0x6c, 0xa4, 0x3c, 0xd7, 0x88, 0x3c, 0x27, 0x10, 0x38, 0xf4, 0xc7, 0x43, 0xa8, 0x9f,
-	0x58, 0xfe, 0x80, 0x5a, 0xc3, 0x13, 0xea, 0xf5, 0x87, 0xee, 0x8c, 0x0a, 0x30, 0x2e, 0x92, 0x04,
-	0x1d, 0xbd, 0x0a, 0x59, 0xb6, 0x1f, 0x77, 0x5c, 0x88, 0xc0, 0x9c, 0x84, 0xd6, 0x20, 0x2f, 0x64,
-	0xe6, 0xae, 0xd3, 0xee, 0x84, 0x24, 0xa3, 0x3b, 0x90, 0xe3, 0x47, 0x72, 0x98, 0x89, 0xee, 0x97,
-	0x20, 0x22, 0x33, 0x4c, 0x04, 0xa5, 0xcb, 0xb0, 0x21, 0x4c, 0x06, 0x26, 0xe4, 0xd8, 0x17, 0xe5,
-	0x30, 0x53, 0xdb, 0x6c, 0xe8, 0xec, 0x6d, 0xdb, 0x9f, 0x4d, 0xac, 0x05, 0x5b, 0x41, 0x89, 0x60,
-	0x43, 0x1f, 0xc1, 0xaa, 0x42, 0x22, 0xc2, 0xaa, 0x1e, 0xc7, 0x76, 0xc6, 0x1c, 0x86, 0xaa, 0x71,
-	0xb8, 0x49, 0x72, 0x31, 0x03, 0x4d, 0x2c, 0x3f, 0x68, 0x0d, 0x03, 0xfb, 0xb9, 0x1d, 0x2c, 0xda,
-	0xec, 0xd4, 0x8a, 0x00, 0xc0, 0x65, 0x3a, 0x7a, 0x03, 0xaa, 0x81, 0x1b, 0x58, 0x93, 0xd6, 0x8c,
-	0xe1, 0x2c, 0x1d, 0x35, 0xaa, 0xdc, 0xd8, 0x71, 0x22, 0x7a, 0x17, 0x2a, 0x73, 0x9f, 0x8e, 0xfa,
-	0x0a, 0x2a, 0x05, 0xe2, 0x54, 0x99, 0x9e, 0x21, 0x91, 0xc4, 0x58, 0xf0, 0xaf, 0x00, 0x22, 0x2b,
-	0x68, 0x91, 0xac, 0x65, 0x2e, 0x83, 0x0d, 0xfa, 0x83, 0x83, 0x76, 0x67, 0x77, 0x50, 0x4f, 0xb3,
-	0xc1, 0xa0, 0xd3, 0xda, 0x7e, 0xda, 0x21, 0xf5, 0x0c, 0xfe, 0x0c, 0x2a, 0xba, 0x55, 0x58, 0x28,
-	0x1f, 0xec, 0xf6, 0x3b, 0x83, 0x7a, 0x0a, 0x01, 0xe4, 0x9f, 0x76, 0xdb, 0xed, 0xce, 0xae, 0xd8,
-	0xe0, 0xb0, 0xdb, 0xef, 0x6e, 0xf5, 0x3a, 0xf5, 0x34, 0xcb, 0x83, 0x4f, 0x5a, 0x87, 0x7b, 0xa4,
-	0x3b, 0xe8, 0xd4, 0x33, 0xf8, 0x0f, 0x06, 0x54, 0x74, 0xf9, 0x12, 0x31, 0x8f, 0xa1, 0x12, 0x05,
-	0x5e, 0x98, 0xe0, 0x62, 0x34, 0xc6, 0x13, 0x61, 0x6e, 0x84, 0x52, 0x3a, 0x8d, 0xf1, 0xc4, 0x8c,
-	0x93, 0xe5, 0x79, 0x24, 0x6e, 0x8d, 0x4f, 0xa0, 0xdc, 0x89, 0x43, 0xbd, 0x9e, 0x19, 0x8c, 0x2b,
-	0x92, 0xff, 0x37, 0x50, 0xeb, 0xcf, 0x8f, 0xa7, 0xb6, 0xef, 0xdb, 0xae, 0xd3, 0xb3, 0x9d, 0x53,
-	0xf4, 0x08, 0x20, 0x92, 0x81, 0xeb, 0xb4, 0x94, 0x2a, 0xb4, 0x69, 0xc6, 0xec, 0x87, 0xcb, 0xb9,
-	0x9a, 0x8c, 0x39, 0xda, 0x91, 0x68, 0xd3, 0x78, 0x06, 0xb5, 0x48, 0x0c, 0x75, 0x56, 0x24, 0x4c,
-	0xb8, 0x5c, 0x93, 0x55, 0x9b, 0x46, 0xef, 0x42, 0x39, 0xda, 0xcc, 0x6f, 0x64, 0x64, 0x85, 0x1d,
-	0x17, 0x9f, 0xe8, 0x3c, 0xf8, 0x77, 0xb0, 0x2a, 0x6e, 0x5e, 0xc4, 0xe4, 0x6b, 0xb7, 0xd3, 0x38,
-	0xff, 0x76, 0xbe, 0x09, 0xb9, 0x89, 0xed, 0x9c, 0xfa, 0x8d, 0xb4, 0x3c, 0x22, 0x2e, 0x35, 0x11,
-	0xb3, 0xf8, 0xef, 0x19, 0x80, 0xc8, 0x2c, 0x89, 0x18, 0x68, 0x2e, 0xe3, 0x9e, 0x06, 0x64, 0xe7,
-	0x55, 0x36, 0x77, 0x01, 0xfc, 0xa1, 0x67, 0xcf, 0x82, 0x27, 0xf6, 0x44, 0xd5, 0x37, 0x1a, 0x85,
-	0xed, 0x37, 0xa2, 0xd6, 0x68, 0x62, 0x3b, 0x54, 0x3e, 0x59, 0xc2, 0x31, 0x2f, 0x9a, 0xe7, 0x81,
-	0x2b, 0x2f, 0x15, 0x87, 0xa4, 0x22, 0xd1, 0x49, 0xec, 0xe5, 0xe2, 0x7a, 0xaa, 0xf4, 0xa9, 0x12,
-	0x31, 0x60, 0x67, 0xda, 0x3e, 0xc7, 0x9e, 0x9e, 0x75, 0xcc, 0xc1, 0xa8, 0x48, 0x34, 0x8a, 0x90,
-	0xc9, 0xf5, 0x68, 0xcf, 0x9e, 0xda, 0x01, 0x47, 0xa3, 0x2a, 0xd1, 0x28, 0xec, 0xb5, 0xe4, 0xd1,
-	0xe7, 0x36, 0xfd, 0x96, 0x95, 0xa2, 0xa2, 0xc8, 0x89, 0x08, 0xfc, 0xfd, 0x75, 0x6a, 0xcf, 0x06,
-	0xd4, 0x0f, 0x7c, 0x8e, 0x2f, 0x45, 0x12, 0x11, 0x58, 0xa0, 0xea, 0xee, 0x54, 0x25, 0x8c, 0x16,
-	0x3b, 0xfa, 0x3c, 0xfa, 0x14, 0x56, 0xc7, 0x9e, 0x35, 0xb2, 0x9d, 0xf1, 0x16, 0x75, 0x86, 0x27,
-	0x53, 0xcb, 0x3b, 0x55, 0x85, 0xcc, 0xaa, 0xb9, 0xb3, 0x34, 0x43, 0x92, 0xbc, 0x0c, 0xba, 0x86,
-	0xae, 0x13, 0x58, 0xb6, 0x43, 0xbd, 0x81, 0x3d, 0xa5, 0xee, 0x3c, 0x68, 0xd4, 0xb8, 0xc8, 0x09,
-	0x3a, 0xbb, 0x53, 0x2d, 0xad, 0x1e, 0x5a, 0x2a, 0x9f, 0x8c, 0xcb, 0xcb, 0x27, 0xfc, 0x7d, 0x06,
-	0x20, 0x52, 0xe3, 0x3c, 0x70, 0x88, 0x5d, 0xfc, 0xf4, 0x39, 0x17, 0xff, 0x66, 0x3c, 0xd3, 0x5d,
-	0x23, 0x75, 0xdd, 0x80, 0x1c, 0x77, 0x8c, 0xac, 0x82, 0xc5, 0x80, 0x9d, 0xc5, 0x3f, 0xf6, 0x8e,
-	0xbf, 0xa1, 0xc3, 0xc0, 0x97, 0x55, 0x46, 0x8c, 0xc6, 0xdc, 0x74, 0x3c, 0xb7, 0x27, 0xa3, 0xae,
-	0xf3, 0xb5, 0x2b, 0x2b, 0xe3, 0x88, 0xc0, 0x42, 0x60, 0xe8, 0x4e, 0xa7, 0x76, 0xf0, 0xd4, 0xf2,
-	0x4f, 0x78, 0x88, 0x94, 0x88, 0x46, 0x61, 0x61, 0xe9, 0xd1, 0x09, 0xb5, 0x7c, 0x3a, 0xe2, 0x01,
-	0x52, 0x24, 0xe1, 0x58, 0x7b, 0xd1, 0x80, 0x7c, 0xd1, 0x44, 0x66, 0x31, 0x97, 0x92, 0x18, 0xb3,
-	0x8a, 0xcc, 0x09, 0x3c, 0xab, 0x94, 0x85, 0xa4, 0x3a, 0x8d, 0x15, 0x9b, 0x22, 0xba, 0x54, 0xb8,
-	0x14, 0x4c, 0xc2, 0xc7, 0x44, 0xd1, 0xf1, 0x27, 0x90, 0x4f, 0xe4, 0x85, 0xd8, 0x23, 0x86, 0x8d,
-	0x48, 0xe7, 0xf3, 0xce, 0xf6, 0xa0, 0xd3, 0x16, 0xc0, 0x4e, 0x3a, 0x0c, 0xe7, 0xf7, 0x76, 0xeb,
-	0x19, 0xe6, 0x77, 0x1d, 0x29, 0x96, 0x42, 0xd4, 0xb8, 0x3c, 0x44, 0xf1, 0x9f, 0x0d, 0xa8, 0x2f,
-	0x47, 0xe2, 0x8f, 0xf2, 0x7e, 0x03, 0x0a, 0x27, 0x94, 0xef, 0x23, 0x11, 0x42, 0x0d, 0xd9, 0x0c,
-	0xb3, 0x3d, 0x43, 0x4b, 0x81, 0x10, 0x6a, 0x88, 0x36, 0xa0, 0x38, 0xf4, 0xec, 0x80, 0x7a, 0xb6,
-	0xd5, 0xc8, 0xc5, 0xaf, 0xc5, 0xb6, 0xa0, 0xbb, 0x0e, 0x09, 0x59, 0xf0, 0xa7, 0x00, 0xda, 0xdd,
-	0x78, 0x17, 0xe0, 0x38, 0xba, 0x55, 0xc6, 0x45, 0xb7, 0x4a, 0x63, 0xc2, 0x67, 0x91, 0xb2, 0xe1,
-	0xfe, 0x09, 0x65, 0x6f, 0x42, 0x7e, 0xe6, 0xda, 0xec, 0xce, 0x08, 0x35, 0xe5, 0x88, 0xe1, 0x55,
-	0xb8, 0x55, 0x18, 0xe3, 0x3a, 0x89, 0x71, 0x8c, 0xa8, 0x40, 0x3f, 0x96, 0x59, 0x64, 0x1b, 0x40,
-	0x23, 0xa1, 0x0d, 0x56, 0x43, 0x59, 0x23, 0x2a, 0x5f, 0xcb, 0xb7, 0x12, 0xda, 0x72, 0x02, 0x25,
-	0x82, 0x4b, 0xb7, 0x5c, 0x3e, 0x66, 0x39, 0xfc, 0x00, 0x72, 0x9c, 0x53, 0x8b, 0x18, 0x80, 0xfc,
-	0x93, 0x56, 0xb7, 0xc7, 0xe3, 0x05, 0x20, 0xbf, 0xdf, 0xea, 0xf7, 0x59, 0xb4, 0xe0, 0xff, 0x1a,
-	0x90, 0x17, 0x11, 0x77, 0x9e, 0x5f, 0xa3, 0x58, 0x88, 0xfc, 0xaa, 0xd3, 0xd8, 0x5d, 0x52, 0xe8,
-	0x18, 0x6a, 0xad, 0x51, 0x98, 0xb9, 0xc4, 0x48, 0xea, 0x2b, 0x47, 0xec, 0x8e, 0x7d, 0x4d, 0xe9,
-	0xe8, 0xd8, 0x1a, 0x9e, 0x2a, 0xe8, 0x57, 0x63, 0x76, 0xef, 0x3d, 0x6a, 0x8d, 0x16, 0x12, 0xf4,
-	0xc5, 0x20, 0x42, 0x83, 0x02, 0x3f, 0x44, 0xa2, 0xc1, 0xaf, 0x63, 0x6e, 0x2e, 0x5e, 0xe0, 0xe6,
-	0x78, 0x11, 0xa8, 0xfb, 0xfc, 0x1d, 0x28, 0x91, 0x10, 0xdd, 0x5f, 0xd7, 0xb1, 0x3f, 0xd6, 0x84,
-	0x8a, 0xe8, 0xb8, 0x07, 0x55, 0x79, 0x43, 0xe9, 0xb3, 0x39, 0xf5, 0x83, 0x58, 0x56, 0x34, 0x96,
-	0xb2, 0xe2, 0x5a, 0xa8, 0x7e, 0x5a, 0x26, 0x66, 0xb9, 0x56, 0x92, 0xf1, 0x23, 0xa8, 0xca, 0x54,
-	0x7d, 0xf5, 0x6e, 0xf8, 0x4d, 0x28, 0x73, 0x69, 0x24, 0x6b, 0x84, 0xa8, 0x46, 0xac, 0x6b, 0xf4,
-	0x08, 0x56, 0x76, 0x68, 0x20, 0xea, 0x6f, 0xc9, 0xaa, 0x81, 0xac, 0x11, 0x03, 0x59, 0xfc, 0x15,
-	0x54, 0x62, 0x9c, 0x17, 0x6c, 0xaa, 0xef, 0x90, 0x8e, 0xc3, 0x74, 0x73, 0xb9, 0x8f, 0xa4, 0x49,
-	0x7c, 0x1f, 0x8a, 0xfb, 0xaa, 0x23, 0xa1, 0x77, 0x2b, 0x8c, 0x78, 0xb7, 0x02, 0xdf, 0x07, 0xd8,
-	0xf3, 0xc6, 0x9a, 0xb4, 0xae, 0x37, 0xde, 0x65, 0xe5, 0x84, 0x60, 0x54, 0x43, 0x3c, 0x81, 0xca,
-	0x9e, 0xf6, 0x32, 0x4e, 0x84, 0x2c, 0x82, 0xec, 0xcc, 0x0a, 0x4e, 0x44, 0x5b, 0x8c, 0xf0, 0x6f,
-	0xa6, 0x91, 0x68, 0x77, 0x4a, 0xe4, 0x91, 0x23, 0x76, 0x1f, 0x67, 0xd6, 0x82, 0xdd, 0x97, 0xfd,
-	0x89, 0x15, 0xde, 0x47, 0x8d, 0x84, 0xdb, 0x50, 0xd5, 0x4f, 0xf3, 0xd1, 0x7b, 0x50, 0xd5, 0x1f,
-	0xe6, 0x2a, 0x48, 0xaa, 0xa6, 0xce, 0x46, 0xe2, 0x3c, 0xf8, 0x7b, 0x03, 0x56, 0xb5, 0xfa, 0xef,
-	0x1a, 0x51, 0x63, 0x02, 0xb2, 0xc7, 0x8e, 0xeb, 0x51, 0xee, 0x99, 0x2f, 0xe8, 0xf4, 0x98, 0x05,
-	0xa4, 0x68, 0x0f, 0x9f, 0x33, 0xc3, 0x2e, 0xea, 0xb7, 0x76, 0x70, 0xa2, 0x9e, 0x2a, 0x5c, 0xcf,
-	0x22, 0x89, 0xd1, 0xd0, 0x26, 0x14, 0x45, 0x5a, 0xa2, 0xac, 0xe6, 0xce, 0x5c, 0xf2, 0x06, 0x0b,
-	0xf9, 0x30, 0x85, 0x5b, 0x11, 0x8b, 0x9c, 0xbd, 0x22, 0x4c, 0xf4, 0x63, 0xd2, 0xd7, 0x3c, 0xc6,
-	0x82, 0x55, 0x2d, 0xff, 0xfc, 0x2c, 0x71, 0xf8, 0xbd, 0x01, 0xb7, 0x0e, 0x66, 0x23, 0x2b, 0xa0,
-	0xc9, 0x93, 0x96, 0x61, 0xce, 0x38, 0x07, 0xe6, 0x2e, 0xab, 0x7c, 0x43, 0x60, 0xca, 0xe8, 0x65,
-	0x8a, 0x5e, 0x44, 0x64, 0x2f, 0x2c, 0x22, 0x72, 0x57, 0x15, 0x11, 0xf8, 0x2f, 0x06, 0x34, 0x96,
-	0x25, 0xf7, 0xaf, 0x13, 0x44, 0xd7, 0xc9, 0xca, 0xf1, 0x62, 0x38, 0x93, 0x28, 0x86, 0x1b, 0xac,
-	0x3a, 0xe1, 0x42, 0x4b, 0x1d, 0xd4, 0x90, 0xcd, 0xc8, 0x3a, 0x46, 0x76, 0x13, 0xd4, 0x10, 0x7f,
-	0x05, 0x4d, 0xdd, 0xc6, 0x12, 0x36, 0x7f, 0x22, 0x63, 0xe3, 0x07, 0x50, 0x52, 0x80, 0xc2, 0xcb,
-	0x3c, 0x85, 0x20, 0xe2, 0x2a, 0x96, 0x48, 0x44, 0xc0, 0x5f, 0x02, 0x1c, 0x90, 0xde, 0xf5, 0xee,
-	0x5b, 0x49, 0x75, 0x93, 0x54, 0xd4, 0x26, 0x5a, 0x53, 0x24, 0x62, 0x61, 0x01, 0x1b, 0xcd, 0xfe,
-	0x3c, 0x01, 0x1b, 0x40, 0x25, 0x3c, 0xc2, 0xa6, 0x3e, 0x7a, 0x04, 0xd9, 0x03, 0xd2, 0x53, 0x80,
-	0x73, 0xcb, 0xd4, 0x27, 0x4d, 0x36, 0xd3, 0x71, 0x02, 0x6f, 0x41, 0x38, 0x53, 0xf3, 0x03, 0x28,
-	0x85, 0x24, 0x54, 0x87, 0xcc, 0x29, 0x5d, 0x48, 0x20, 0x65, 0x9f, 0x2c, 0x60, 0x9f, 0x5b, 0x93,
-	0xb9, 0xfc, 0xf1, 0x80, 0x88, 0xc1, 0xe3, 0xf4, 0x87, 0x06, 0xfe, 0x18, 0xfe, 0xaf, 0x35, 0x0f,
-	0x4e, 0x5c, 0x4f, 0x41, 0x19, 0xf5, 0x67, 0xae, 0xe3, 0xf3, 0xa2, 0xbb, 0xeb, 0xab, 0x29, 0x3a,
-	0xe2, 0xbb, 0x15, 0x49, 0x8c, 0x86, 0x37, 0xc3, 0x3a, 0x15, 0x41, 0x76, 0xdb, 0x1d, 0x51, 0x69,
-	0x08, 0xfe, 0xcd, 0x0e, 0xed, 0x78, 0x9e, 0xeb, 0xa9, 0x43, 0xf9, 0x00, 0xff, 0xc9, 0x80, 0xdb,
-	0x5a, 0x5c, 0x3f, 0x71, 0xbd, 0x6b, 0x67, 0x43, 0xf4, 0x3e, 0x64, 0x83, 0xc5, 0x4c, 0x68, 0x51,
-	0xdb, 0x7c, 0xcd, 0xbc, 0x64, 0x1f, 0xe1, 0x41, 0xce, 0x8e, 0x1f, 0xca, 0x76, 0x61, 0x01, 0x32,
-	0xad, 0x5e, 0x4f, 0x74, 0x0b, 0xbb, 0xbb, 0xed, 0xee, 0x61, 0xb7, 0x7d, 0xd0, 0xea, 0xd5, 0x8d,
-	0xa8, 0x0f, 0x98, 0xc6, 0x5f, 0x42, 0x8d, 0x50, 0xfe, 0x70, 0x78, 0x99, 0xf8, 0xbd, 0xc6, 0xcd,
-	0xc3, 0xcf, 0xd4, 0x33, 0x5e, 0x4f, 0xe8, 0xfc, 0x61, 0xc2, 0x7f, 0x30, 0x50, 0xd6, 0xe3, 0x0f,
-	0x13, 0x45, 0x89, 0xe6, 0x7f, 0x4b, 0x2d, 0x61, 0xc8, 0x2a, 0xd1, 0x28, 0xec, 0x3e, 0xb0, 0xa0,
-	0xeb, 0xf1, 0xdf, 0xf3, 0x44, 0xb2, 0x8b, 0x08, 0xf8, 0x00, 0x5e, 0xe9, 0xb9, 0xd6, 0x48, 0x16,
-	0x93, 0xd6, 0x4f, 0x84, 0x21, 0x38, 0x0f, 0xd9, 0x43, 0xd7, 0x1e, 0x6d, 0x9e, 0xad, 0xc0, 0x6a,
-	0x6b, 0x1e, 0xb8, 0xbc, 0x36, 0xf5, 0xfa, 0xd4, 0x7b, 0x6e, 0x0f, 0x29, 0x7a, 0x15, 0x0a, 0x3b,
-	0x34, 0xe0, 0xbf, 0x7c, 0xe6, 0x4c, 0xc6, 0xd7, 0x14, 0x15, 0x15, 0x4e, 0xa1, 0xdb, 0x50, 0x94,
-	0x53, 0xbe, 0x9a, 0xcb, 0xf3, 0x39, 0x1f, 0xa7, 0x90, 0xc9, 0x6b, 0x18, 0x36, 0xda, 0x5a, 0xc8,
-	0x5f, 0x5d, 0x90, 0x99, 0xb0, 0x58, 0xb4, 0xd9, 0x1d, 0x00, 0x81, 0x92, 0xf2, 0x28, 0xf6, 0xa7,
-	0x29, 0x76, 0xc5, 0x29, 0xf4, 0x4b, 0x78, 0x45, 0x0f, 0x55, 0xd9, 0xf5, 0x54, 0xa7, 0xde, 0x34,
-	0xcf, 0x0d, 0x7a, 0x9c, 0x42, 0xf7, 0xb9, 0x88, 0xe2, 0x17, 0xb8, 0xba, 0xb9, 0x54, 0x54, 0x35,
-	0x65, 0x8f, 0x13, 0xa7, 0xd0, 0x26, 0xdc, 0x52, 0x93, 0x5b, 0x0b, 0x76, 0x74, 0xcb, 0x19, 0x49,
-	0xa9, 0xab, 0xe6, 0x05, 0x6b, 0x4c, 0x58, 0x55, 0x6b, 0xfc, 0x50, 0xc7, 0x9a, 0x19, 0x8b, 0xdb,
-	0x66, 0x41, 0xb0, 0x33, 0x8b, 0xac, 0x41, 0x99, 0xff, 0x8e, 0x24, 0x52, 0x3f, 0x92, 0x1b, 0x69,
-	0x1b, 0xde, 0x85, 0xb2, 0x30, 0x41, 0x9c, 0x21, 0x34, 0xc2, 0x9b, 0x50, 0x6e, 0xd3, 0x09, 0x55,
-	0xf3, 0x4b, 0x82, 0x85, 0x6c, 0xf7, 0xa1, 0xb4, 0x43, 0x83, 0x0b, 0xe5, 0x11, 0x63, 0x2e, 0x0f,
-	0x84, 0x7c, 0xa1, 0x03, 0x8b, 0x72, 0x9e, 0x09, 0xfc, 0x21, 0xd4, 0x23, 0x06, 0x61, 0x16, 0xa4,
-	0x37, 0x72, 0x63, 0x05, 0x45, 0x6c, 0x25, 0x86, 0x8a, 0x50, 0x55, 0x4a, 0xa1, 0x4e, 0xd5, 0x8f,
-	0xbf, 0x07, 0x15, 0xa1, 0xed, 0x32, 0x4f, 0xa8, 0x88, 0x09, 0x37, 0x75, 0x8e, 0x43, 0xdb, 0xb7,
-	0x8f, 0xed, 0x09, 0xab, 0x85, 0xf4, 0x7e, 0x5c, 0xc4, 0xff, 0x0e, 0xd4, 0x76, 0x68, 0xa0, 0x37,
-	0x49, 0x96, 0xb5, 0xaf, 0x68, 0xfd, 0x11, 0x26, 0xe7, 0x5b, 0xb0, 0x2a, 0x4e, 0xb8, 0x6c, 0x51,
-	0xb8, 0xff, 0x67, 0x70, 0x63, 0x87, 0x06, 0x5a, 0x63, 0xf3, 0x4a, 0x9b, 0x54, 0xb4, 0x19, 0x76,
-	0xde, 0x27, 0x70, 0x73, 0x79, 0x87, 0xf0, 0x6e, 0x24, 0x2a, 0xcc, 0xc4, 0xea, 0x75, 0xa8, 0x0b,
-	0xab, 0x6a, 0xbf, 0x6c, 0x9c, 0x6f, 0x89, 0x75, 0xa8, 0x0b, 0xbd, 0xae, 0xe4, 0x0c, 0x2d, 0xa0,
-	0xf7, 0x6b, 0x2f, 0xb4, 0xc0, 0x2f, 0xb8, 0x85, 0xf5, 0x76, 0x84, 0x5e, 0xf9, 0x44, 0x72, 0x6b,
-	0x1c, 0x38, 0x85, 0x7a, 0x5c, 0x6b, 0x8d, 0x16, 0x6a, 0x7d, 0xe7, 0x32, 0xcc, 0x6f, 0x2a, 0xbc,
-	0x88, 0xef, 0xf6, 0xbe, 0xd2, 0x4d, 0x6b, 0x67, 0x35, 0xcc, 0x0b, 0x6a, 0xc3, 0x48, 0xf4, 0x0f,
-	0x94, 0xa2, 0xba, 0xf4, 0xaf, 0x9a, 0x17, 0x55, 0x66, 0xd1, 0xc2, 0xf7, 0x58, 0xad, 0xc0, 0x53,
-	0x88, 0x76, 0xe0, 0x8a, 0x19, 0x4f, 0x2b, 0x4d, 0xbd, 0x03, 0x83, 0x53, 0xe8, 0x23, 0x58, 0x11,
-	0xae, 0x8a, 0x9a, 0x2e, 0xc9, 0x47, 0x6d, 0x33, 0x49, 0xc2, 0x29, 0xb4, 0x01, 0x2b, 0x42, 0xa8,
-	0x4b, 0x97, 0x86, 0xe2, 0x6d, 0xc0, 0x8a, 0x00, 0x85, 0xeb, 0xb1, 0x87, 0x82, 0x45, 0x0d, 0x92,
-	0x64, 0x4f, 0xa6, 0x99, 0x24, 0xe9, 0x82, 0x5d, 0xba, 0x34, 0x29, 0xd8, 0xf5, 0xd8, 0x1f, 0x28,
-	0xc8, 0x50, 0xbd, 0x0c, 0x33, 0xf6, 0x48, 0x6f, 0xaa, 0x87, 0x37, 0x4e, 0xa1, 0xff, 0x57, 0xc8,
-	0x71, 0x01, 0xab, 0xa6, 0x6c, 0x65, 0x87, 0x06, 0x51, 0x7b, 0xe0, 0xb6, 0x79, 0x71, 0x61, 0xdb,
-	0x04, 0x33, 0x24, 0x71, 0xaf, 0x57, 0xf4, 0x5c, 0x8b, 0x6e, 0x98, 0xe7, 0xa4, 0xde, 0x66, 0xd9,
-	0x8c, 0x1a, 0x56, 0x38, 0x85, 0x5e, 0xe7, 0xe7, 0x45, 0xe5, 0xad, 0xc4, 0x54, 0x30, 0x43, 0x12,
-	0x4e, 0xa1, 0xb7, 0x79, 0x62, 0x8c, 0x3d, 0x82, 0xcb, 0x66, 0xf4, 0x76, 0x6e, 0xc6, 0xdf, 0xa2,
-	0xe1, 0x82, 0x58, 0x31, 0x59, 0x36, 0xa3, 0xc2, 0xb8, 0x59, 0x8d, 0xd5, 0x92, 0x38, 0x85, 0x1e,
-	0x42, 0xb9, 0xeb, 0x77, 0xa6, 0xb3, 0x60, 0xc1, 0x26, 0x10, 0x32, 0x13, 0xb5, 0x6e, 0x68, 0xa2,
-	0xad, 0xca, 0x5f, 0x7f, 0xb8, 0x6b, 0xfc, 0xed, 0x87, 0xbb, 0xc6, 0xbf, 0x7f, 0xb8, 0x6b, 0x1c,
-	0xe7, 0xf9, 0xff, 0x75, 0x7a, 0xef, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xc3, 0x86, 0xd5,
-	0x0d, 0x25, 0x00, 0x00,
+func (m *ScoreChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreChange.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ScoreChange proto.InternalMessageInfo
 
-// AutograderServiceClient is the client API for AutograderService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AutograderServiceClient interface {
-	GetUser(ctx context.Context, in *Void, opts ...grpc.CallOption) (*User, error)
-	GetUsers(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Users, error)
-	GetUserByCourse(ctx context.Context, in *CourseUserRequest, opts ...grpc.CallOption) (*User, error)
-	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*Void, error)
-	IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error)
-	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
-	GetGroupByUserAndCourse(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error)
-	GetGroupsByCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Groups, error)
-	CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Group, error)
-	UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Void, error)
-	DeleteGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Void, error)
-	GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error)
-	GetCourses(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Courses, error)
-	GetCoursesByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Courses, error)
-	CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error)
-	UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error)
-	UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
-	GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error)
-	UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
-	GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error)
-	GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error)
-	CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
-	UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
-	UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
-	// Get latest submissions for all course assignments for a user or a group.
-	GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error)
-	// Get lab submissions for every course user or every course group
-	GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error)
-	UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error)
-	UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error)
-	RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error)
-	// manual grading //
-	CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error)
-	UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
-	DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
-	CreateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*GradingCriterion, error)
-	UpdateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error)
-	DeleteCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error)
-	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
-	UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Void, error)
-	GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error)
-	LoadCriteria(ctx context.Context, in *LoadCriteriaRequest, opts ...grpc.CallOption) (*Benchmarks, error)
-	GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error)
-	GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error)
-	GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error)
-	IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error)
+func (m *ScoreChange) GetSubmissionID() uint64 {
+	if m != nil {
+		return m.SubmissionID
+	}
+	return 0
 }
 
-type autograderServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ScoreChange) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
 }
 
-func NewAutograderServiceClient(cc *grpc.ClientConn) AutograderServiceClient {
-	return &autograderServiceClient{cc}
+func (m *ScoreChange) GetGroupID() uint64 {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
 }
 
-func (c *autograderServiceClient) GetUser(ctx context.Context, in *Void, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScoreChange) GetOldScore() uint32 {
+	if m != nil {
+		return m.OldScore
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetUsers(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Users, error) {
-	out := new(Users)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScoreChange) GetNewScore() uint32 {
+	if m != nil {
+		return m.NewScore
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetUserByCourse(ctx context.Context, in *CourseUserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetUserByCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AssignmentRebuild struct {
+	AssignmentID         uint64         `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Total                uint32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Rebuilt              uint32         `protobuf:"varint,3,opt,name=rebuilt,proto3" json:"rebuilt,omitempty"`
+	Failed               uint32         `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Done                 bool           `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	Increased            uint32         `protobuf:"varint,6,opt,name=increased,proto3" json:"increased,omitempty"`
+	Decreased            uint32         `protobuf:"varint,7,opt,name=decreased,proto3" json:"decreased,omitempty"`
+	ScoreChanges         []*ScoreChange `protobuf:"bytes,8,rep,name=scoreChanges,proto3" json:"scoreChanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (c *autograderServiceClient) UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AssignmentRebuild) Reset()         { *m = AssignmentRebuild{} }
+func (m *AssignmentRebuild) String() string { return proto.CompactTextString(m) }
+func (*AssignmentRebuild) ProtoMessage()    {}
+func (*AssignmentRebuild) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{55}
 }
-
-func (c *autograderServiceClient) IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
-	out := new(AuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/AutograderService/IsAuthorizedTeacher", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AssignmentRebuild) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *autograderServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
-	out := new(Group)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentRebuild.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AssignmentRebuild) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentRebuild.Merge(m, src)
+}
+func (m *AssignmentRebuild) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentRebuild) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentRebuild.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) GetGroupByUserAndCourse(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error) {
-	out := new(Group)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetGroupByUserAndCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssignmentRebuild proto.InternalMessageInfo
+
+func (m *AssignmentRebuild) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetGroupsByCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Groups, error) {
-	out := new(Groups)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetGroupsByCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Group, error) {
-	out := new(Group)
-	err := c.cc.Invoke(ctx, "/AutograderService/CreateGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetRebuilt() uint32 {
+	if m != nil {
+		return m.Rebuilt
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) DeleteGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/DeleteGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetDone() bool {
+	if m != nil {
+		return m.Done
 	}
-	return out, nil
+	return false
 }
 
-func (c *autograderServiceClient) GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error) {
-	out := new(Course)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetIncreased() uint32 {
+	if m != nil {
+		return m.Increased
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetCourses(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Courses, error) {
-	out := new(Courses)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetCourses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetDecreased() uint32 {
+	if m != nil {
+		return m.Decreased
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetCoursesByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Courses, error) {
-	out := new(Courses)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetCoursesByUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentRebuild) GetScoreChanges() []*ScoreChange {
+	if m != nil {
+		return m.ScoreChanges
 	}
-	return out, nil
+	return nil
 }
 
-func (c *autograderServiceClient) CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error) {
-	out := new(Course)
-	err := c.cc.Invoke(ctx, "/AutograderService/CreateCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// TestOutcome counts the runs of a test of an assignment on a commit, and how many of them passed.
+type TestOutcome struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID         uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"unique_index:idx_unique_test_outcome"`
+	CommitHash           string   `protobuf:"bytes,3,opt,name=commitHash,proto3" json:"commitHash,omitempty" gorm:"unique_index:idx_unique_test_outcome"`
+	TestName             string   `protobuf:"bytes,4,opt,name=testName,proto3" json:"testName,omitempty" gorm:"unique_index:idx_unique_test_outcome"`
+	Runs                 uint32   `protobuf:"varint,5,opt,name=runs,proto3" json:"runs,omitempty"`
+	Passes               uint32   `protobuf:"varint,6,opt,name=passes,proto3" json:"passes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *autograderServiceClient) UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestOutcome) Reset()         { *m = TestOutcome{} }
+func (m *TestOutcome) String() string { return proto.CompactTextString(m) }
+func (*TestOutcome) ProtoMessage()    {}
+func (*TestOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{56}
+}
+func (m *TestOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TestOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestOutcome.Merge(m, src)
+}
+func (m *TestOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestOutcome.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateCourseVisibility", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TestOutcome proto.InternalMessageInfo
+
+func (m *TestOutcome) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error) {
-	out := new(Assignments)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetAssignments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestOutcome) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateAssignments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestOutcome) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *autograderServiceClient) GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error) {
-	out := new(Enrollments)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetEnrollmentsByUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestOutcome) GetTestName() string {
+	if m != nil {
+		return m.TestName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *autograderServiceClient) GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error) {
-	out := new(Enrollments)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetEnrollmentsByCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestOutcome) GetRuns() uint32 {
+	if m != nil {
+		return m.Runs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/CreateEnrollment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestOutcome) GetPasses() uint32 {
+	if m != nil {
+		return m.Passes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateEnrollment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TestStat struct {
+	TestName             string   `protobuf:"bytes,1,opt,name=testName,proto3" json:"testName,omitempty"`
+	Runs                 uint32   `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"`
+	Passes               uint32   `protobuf:"varint,3,opt,name=passes,proto3" json:"passes,omitempty"`
+	Commits              uint32   `protobuf:"varint,4,opt,name=commits,proto3" json:"commits,omitempty"`
+	FlakyCommits         uint32   `protobuf:"varint,5,opt,name=flakyCommits,proto3" json:"flakyCommits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *autograderServiceClient) UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateEnrollments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStat) Reset()         { *m = TestStat{} }
+func (m *TestStat) String() string { return proto.CompactTextString(m) }
+func (*TestStat) ProtoMessage()    {}
+func (*TestStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{57}
+}
+func (m *TestStat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestStat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TestStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestStat.Merge(m, src)
+}
+func (m *TestStat) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestStat.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error) {
-	out := new(Submissions)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetSubmissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TestStat proto.InternalMessageInfo
+
+func (m *TestStat) GetTestName() string {
+	if m != nil {
+		return m.TestName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *autograderServiceClient) GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error) {
-	out := new(CourseSubmissions)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetSubmissionsByCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStat) GetRuns() uint32 {
+	if m != nil {
+		return m.Runs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateSubmission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStat) GetPasses() uint32 {
+	if m != nil {
+		return m.Passes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateSubmissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStat) GetCommits() uint32 {
+	if m != nil {
+		return m.Commits
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error) {
-	out := new(Submission)
-	err := c.cc.Invoke(ctx, "/AutograderService/RebuildSubmission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStat) GetFlakyCommits() uint32 {
+	if m != nil {
+		return m.FlakyCommits
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
-	out := new(GradingBenchmark)
-	err := c.cc.Invoke(ctx, "/AutograderService/CreateBenchmark", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TestStats struct {
+	AssignmentID         uint64      `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Stats                []*TestStat `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (c *autograderServiceClient) UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateBenchmark", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStats) Reset()         { *m = TestStats{} }
+func (m *TestStats) String() string { return proto.CompactTextString(m) }
+func (*TestStats) ProtoMessage()    {}
+func (*TestStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{58}
+}
+func (m *TestStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TestStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestStats.Merge(m, src)
+}
+func (m *TestStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestStats.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/DeleteBenchmark", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TestStats proto.InternalMessageInfo
+
+func (m *TestStats) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) CreateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*GradingCriterion, error) {
-	out := new(GradingCriterion)
-	err := c.cc.Invoke(ctx, "/AutograderService/CreateCriterion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestStats) GetStats() []*TestStat {
+	if m != nil {
+		return m.Stats
 	}
-	return out, nil
+	return nil
 }
 
-func (c *autograderServiceClient) UpdateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateCriterion", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ArtifactsRequest struct {
+	SubmissionID         uint64   `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArtifactsRequest) Reset()         { *m = ArtifactsRequest{} }
+func (m *ArtifactsRequest) String() string { return proto.CompactTextString(m) }
+func (*ArtifactsRequest) ProtoMessage()    {}
+func (*ArtifactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{59}
+}
+func (m *ArtifactsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArtifactsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ArtifactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactsRequest.Merge(m, src)
+}
+func (m *ArtifactsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactsRequest.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) DeleteCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/DeleteCriterion", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArtifactsRequest proto.InternalMessageInfo
+
+func (m *ArtifactsRequest) GetSubmissionID() uint64 {
+	if m != nil {
+		return m.SubmissionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
-	out := new(Review)
-	err := c.cc.Invoke(ctx, "/AutograderService/CreateReview", in, out, opts...)
-	if err != nil {
-		return nil, err
+// Leaderboard ranks the latest submissions of an assignment by the results of each benchmark.
+type Leaderboard struct {
+	AssignmentID         uint64              `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Entries              []*LeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Leaderboard) Reset()         { *m = Leaderboard{} }
+func (m *Leaderboard) String() string { return proto.CompactTextString(m) }
+func (*Leaderboard) ProtoMessage()    {}
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{60}
+}
+func (m *Leaderboard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Leaderboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Leaderboard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Leaderboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Leaderboard.Merge(m, src)
+}
+func (m *Leaderboard) XXX_Size() int {
+	return m.Size()
+}
+func (m *Leaderboard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Leaderboard.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/UpdateReview", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Leaderboard proto.InternalMessageInfo
+
+func (m *Leaderboard) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error) {
-	out := new(Reviewers)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetReviewers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Leaderboard) GetEntries() []*LeaderboardEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *autograderServiceClient) LoadCriteria(ctx context.Context, in *LoadCriteriaRequest, opts ...grpc.CallOption) (*Benchmarks, error) {
-	out := new(Benchmarks)
-	err := c.cc.Invoke(ctx, "/AutograderService/LoadCriteria", in, out, opts...)
-	if err != nil {
-		return nil, err
+// LeaderboardEntry is the anonymized benchmark result of a submission.
+type LeaderboardEntry struct {
+	Benchmark            string   `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Rank                 uint32   `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	NsPerOp              int64    `protobuf:"varint,3,opt,name=nsPerOp,proto3" json:"nsPerOp,omitempty"`
+	AllocsPerOp          int64    `protobuf:"varint,4,opt,name=allocsPerOp,proto3" json:"allocsPerOp,omitempty"`
+	BytesPerOp           int64    `protobuf:"varint,5,opt,name=bytesPerOp,proto3" json:"bytesPerOp,omitempty"`
+	Own                  bool     `protobuf:"varint,6,opt,name=own,proto3" json:"own,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderboardEntry) Reset()         { *m = LeaderboardEntry{} }
+func (m *LeaderboardEntry) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardEntry) ProtoMessage()    {}
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{61}
+}
+func (m *LeaderboardEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaderboardEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaderboardEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LeaderboardEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardEntry.Merge(m, src)
+}
+func (m *LeaderboardEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaderboardEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardEntry.DiscardUnknown(m)
 }
 
-func (c *autograderServiceClient) GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error) {
-	out := new(Providers)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LeaderboardEntry proto.InternalMessageInfo
+
+func (m *LeaderboardEntry) GetBenchmark() string {
+	if m != nil {
+		return m.Benchmark
 	}
-	return out, nil
+	return ""
 }
 
-func (c *autograderServiceClient) GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error) {
-	out := new(Organization)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetOrganization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaderboardEntry) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error) {
-	out := new(Repositories)
-	err := c.cc.Invoke(ctx, "/AutograderService/GetRepositories", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaderboardEntry) GetNsPerOp() int64 {
+	if m != nil {
+		return m.NsPerOp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *autograderServiceClient) IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/AutograderService/IsEmptyRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaderboardEntry) GetAllocsPerOp() int64 {
+	if m != nil {
+		return m.AllocsPerOp
 	}
-	return out, nil
+	return 0
 }
 
-// AutograderServiceServer is the server API for AutograderService service.
-type AutograderServiceServer interface {
-	GetUser(context.Context, *Void) (*User, error)
-	GetUsers(context.Context, *Void) (*Users, error)
-	GetUserByCourse(context.Context, *CourseUserRequest) (*User, error)
-	UpdateUser(context.Context, *User) (*Void, error)
-	IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error)
-	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
-	GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error)
-	GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error)
-	CreateGroup(context.Context, *Group) (*Group, error)
-	UpdateGroup(context.Context, *Group) (*Void, error)
-	DeleteGroup(context.Context, *GroupRequest) (*Void, error)
-	GetCourse(context.Context, *CourseRequest) (*Course, error)
-	GetCourses(context.Context, *Void) (*Courses, error)
-	GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error)
-	CreateCourse(context.Context, *Course) (*Course, error)
-	UpdateCourse(context.Context, *Course) (*Void, error)
-	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
-	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
-	UpdateAssignments(context.Context, *CourseRequest) (*Void, error)
-	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
-	GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error)
-	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
-	UpdateEnrollment(context.Context, *Enrollment) (*Void, error)
-	UpdateEnrollments(context.Context, *CourseRequest) (*Void, error)
-	// Get latest submissions for all course assignments for a user or a group.
-	GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error)
-	// Get lab submissions for every course user or every course group
-	GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error)
-	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error)
-	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
-	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
-	// manual grading //
-	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
-	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
-	DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error)
-	CreateCriterion(context.Context, *GradingCriterion) (*GradingCriterion, error)
-	UpdateCriterion(context.Context, *GradingCriterion) (*Void, error)
-	DeleteCriterion(context.Context, *GradingCriterion) (*Void, error)
-	CreateReview(context.Context, *ReviewRequest) (*Review, error)
-	UpdateReview(context.Context, *ReviewRequest) (*Void, error)
-	GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error)
-	LoadCriteria(context.Context, *LoadCriteriaRequest) (*Benchmarks, error)
-	GetProviders(context.Context, *Void) (*Providers, error)
-	GetOrganization(context.Context, *OrgRequest) (*Organization, error)
-	GetRepositories(context.Context, *URLRequest) (*Repositories, error)
-	IsEmptyRepo(context.Context, *RepositoryRequest) (*Void, error)
+func (m *LeaderboardEntry) GetBytesPerOp() int64 {
+	if m != nil {
+		return m.BytesPerOp
+	}
+	return 0
 }
 
-// UnimplementedAutograderServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAutograderServiceServer struct {
+func (m *LeaderboardEntry) GetOwn() bool {
+	if m != nil {
+		return m.Own
+	}
+	return false
 }
 
-func (*UnimplementedAutograderServiceServer) GetUser(ctx context.Context, req *Void) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+type RegradesRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID         uint64   `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAutograderServiceServer) GetUsers(ctx context.Context, req *Void) (*Users, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+
+func (m *RegradesRequest) Reset()         { *m = RegradesRequest{} }
+func (m *RegradesRequest) String() string { return proto.CompactTextString(m) }
+func (*RegradesRequest) ProtoMessage()    {}
+func (*RegradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{62}
 }
-func (*UnimplementedAutograderServiceServer) GetUserByCourse(ctx context.Context, req *CourseUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByCourse not implemented")
+func (m *RegradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAutograderServiceServer) UpdateUser(ctx context.Context, req *User) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
-}
-func (*UnimplementedAutograderServiceServer) IsAuthorizedTeacher(ctx context.Context, req *Void) (*AuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorizedTeacher not implemented")
-}
-func (*UnimplementedAutograderServiceServer) GetGroup(ctx context.Context, req *GetGroupRequest) (*Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
-}
-func (*UnimplementedAutograderServiceServer) GetGroupByUserAndCourse(ctx context.Context, req *GroupRequest) (*Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByUserAndCourse not implemented")
+func (m *RegradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAutograderServiceServer) GetGroupsByCourse(ctx context.Context, req *CourseRequest) (*Groups, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByCourse not implemented")
+func (m *RegradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegradesRequest.Merge(m, src)
 }
-func (*UnimplementedAutograderServiceServer) CreateGroup(ctx context.Context, req *Group) (*Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+func (m *RegradesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAutograderServiceServer) UpdateGroup(ctx context.Context, req *Group) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+func (m *RegradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegradesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAutograderServiceServer) DeleteGroup(ctx context.Context, req *GroupRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+var xxx_messageInfo_RegradesRequest proto.InternalMessageInfo
+
+func (m *RegradesRequest) GetCourseID() uint64 {
+	if m != nil {
+		return m.CourseID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) GetCourse(ctx context.Context, req *CourseRequest) (*Course, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+
+func (m *RegradesRequest) GetSubmissionID() uint64 {
+	if m != nil {
+		return m.SubmissionID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) GetCourses(ctx context.Context, req *Void) (*Courses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+
+type UpdateRegradeRequest struct {
+	CourseID             uint64        `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RegradeID            uint64        `protobuf:"varint,2,opt,name=regradeID,proto3" json:"regradeID,omitempty"`
+	State                Regrade_State `protobuf:"varint,3,opt,name=state,proto3,enum=Regrade_State" json:"state,omitempty"`
+	Response             string        `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	Score                uint32        `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (*UnimplementedAutograderServiceServer) GetCoursesByUser(ctx context.Context, req *EnrollmentStatusRequest) (*Courses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByUser not implemented")
+
+func (m *UpdateRegradeRequest) Reset()         { *m = UpdateRegradeRequest{} }
+func (m *UpdateRegradeRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRegradeRequest) ProtoMessage()    {}
+func (*UpdateRegradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{63}
 }
-func (*UnimplementedAutograderServiceServer) CreateCourse(ctx context.Context, req *Course) (*Course, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+func (m *UpdateRegradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAutograderServiceServer) UpdateCourse(ctx context.Context, req *Course) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+func (m *UpdateRegradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRegradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAutograderServiceServer) UpdateCourseVisibility(ctx context.Context, req *Enrollment) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseVisibility not implemented")
+func (m *UpdateRegradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRegradeRequest.Merge(m, src)
 }
-func (*UnimplementedAutograderServiceServer) GetAssignments(ctx context.Context, req *CourseRequest) (*Assignments, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
+func (m *UpdateRegradeRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAutograderServiceServer) UpdateAssignments(ctx context.Context, req *CourseRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignments not implemented")
+func (m *UpdateRegradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRegradeRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAutograderServiceServer) GetEnrollmentsByUser(ctx context.Context, req *EnrollmentStatusRequest) (*Enrollments, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByUser not implemented")
+
+var xxx_messageInfo_UpdateRegradeRequest proto.InternalMessageInfo
+
+func (m *UpdateRegradeRequest) GetCourseID() uint64 {
+	if m != nil {
+		return m.CourseID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) GetEnrollmentsByCourse(ctx context.Context, req *EnrollmentRequest) (*Enrollments, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByCourse not implemented")
+
+func (m *UpdateRegradeRequest) GetRegradeID() uint64 {
+	if m != nil {
+		return m.RegradeID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) CreateEnrollment(ctx context.Context, req *Enrollment) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollment not implemented")
+
+func (m *UpdateRegradeRequest) GetState() Regrade_State {
+	if m != nil {
+		return m.State
+	}
+	return Regrade_OPEN
 }
-func (*UnimplementedAutograderServiceServer) UpdateEnrollment(ctx context.Context, req *Enrollment) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollment not implemented")
+
+func (m *UpdateRegradeRequest) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
 }
-func (*UnimplementedAutograderServiceServer) UpdateEnrollments(ctx context.Context, req *CourseRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollments not implemented")
+
+func (m *UpdateRegradeRequest) GetScore() uint32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) GetSubmissions(ctx context.Context, req *SubmissionRequest) (*Submissions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissions not implemented")
+
+type BuildLogRequest struct {
+	SubmissionID         uint64   `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAutograderServiceServer) GetSubmissionsByCourse(ctx context.Context, req *SubmissionsForCourseRequest) (*CourseSubmissions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionsByCourse not implemented")
+
+func (m *BuildLogRequest) Reset()         { *m = BuildLogRequest{} }
+func (m *BuildLogRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildLogRequest) ProtoMessage()    {}
+func (*BuildLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{64}
 }
-func (*UnimplementedAutograderServiceServer) UpdateSubmission(ctx context.Context, req *UpdateSubmissionRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmission not implemented")
+func (m *BuildLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAutograderServiceServer) UpdateSubmissions(ctx context.Context, req *UpdateSubmissionsRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmissions not implemented")
+func (m *BuildLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAutograderServiceServer) RebuildSubmission(ctx context.Context, req *RebuildRequest) (*Submission, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmission not implemented")
+func (m *BuildLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildLogRequest.Merge(m, src)
 }
-func (*UnimplementedAutograderServiceServer) CreateBenchmark(ctx context.Context, req *GradingBenchmark) (*GradingBenchmark, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
+func (m *BuildLogRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAutograderServiceServer) UpdateBenchmark(ctx context.Context, req *GradingBenchmark) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBenchmark not implemented")
+func (m *BuildLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildLogRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAutograderServiceServer) DeleteBenchmark(ctx context.Context, req *GradingBenchmark) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBenchmark not implemented")
+
+var xxx_messageInfo_BuildLogRequest proto.InternalMessageInfo
+
+func (m *BuildLogRequest) GetSubmissionID() uint64 {
+	if m != nil {
+		return m.SubmissionID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) CreateCriterion(ctx context.Context, req *GradingCriterion) (*GradingCriterion, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCriterion not implemented")
+
+type CancelBuildRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID         uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UserID               uint64   `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID              uint64   `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAutograderServiceServer) UpdateCriterion(ctx context.Context, req *GradingCriterion) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCriterion not implemented")
+
+func (m *CancelBuildRequest) Reset()         { *m = CancelBuildRequest{} }
+func (m *CancelBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelBuildRequest) ProtoMessage()    {}
+func (*CancelBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{65}
 }
-func (*UnimplementedAutograderServiceServer) DeleteCriterion(ctx context.Context, req *GradingCriterion) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCriterion not implemented")
+func (m *CancelBuildRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAutograderServiceServer) CreateReview(ctx context.Context, req *ReviewRequest) (*Review, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+func (m *CancelBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelBuildRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAutograderServiceServer) UpdateReview(ctx context.Context, req *ReviewRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+func (m *CancelBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelBuildRequest.Merge(m, src)
 }
-func (*UnimplementedAutograderServiceServer) GetReviewers(ctx context.Context, req *SubmissionReviewersRequest) (*Reviewers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReviewers not implemented")
+func (m *CancelBuildRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAutograderServiceServer) LoadCriteria(ctx context.Context, req *LoadCriteriaRequest) (*Benchmarks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoadCriteria not implemented")
+func (m *CancelBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelBuildRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAutograderServiceServer) GetProviders(ctx context.Context, req *Void) (*Providers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProviders not implemented")
+
+var xxx_messageInfo_CancelBuildRequest proto.InternalMessageInfo
+
+func (m *CancelBuildRequest) GetCourseID() uint64 {
+	if m != nil {
+		return m.CourseID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) GetOrganization(ctx context.Context, req *OrgRequest) (*Organization, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+
+func (m *CancelBuildRequest) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) GetRepositories(ctx context.Context, req *URLRequest) (*Repositories, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRepositories not implemented")
+
+func (m *CancelBuildRequest) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
 }
-func (*UnimplementedAutograderServiceServer) IsEmptyRepo(ctx context.Context, req *RepositoryRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsEmptyRepo not implemented")
+
+func (m *CancelBuildRequest) GetGroupID() uint64 {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
 }
 
-func RegisterAutograderServiceServer(s *grpc.Server, srv AutograderServiceServer) {
-	s.RegisterService(&_AutograderService_serviceDesc, srv)
+type Artifact struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FileSize             int64    `protobuf:"varint,2,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AutograderService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetUser(ctx, req.(*Void))
+func (m *Artifact) Reset()         { *m = Artifact{} }
+func (m *Artifact) String() string { return proto.CompactTextString(m) }
+func (*Artifact) ProtoMessage()    {}
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{66}
+}
+func (m *Artifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Artifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Artifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Artifact.Merge(m, src)
+}
+func (m *Artifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *Artifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Artifact.DiscardUnknown(m)
 }
 
-func _AutograderService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetUsers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetUsers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetUsers(ctx, req.(*Void))
+var xxx_messageInfo_Artifact proto.InternalMessageInfo
+
+func (m *Artifact) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AutograderService_GetUserByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetUserByCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetUserByCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetUserByCourse(ctx, req.(*CourseUserRequest))
+func (m *Artifact) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateUser(ctx, req.(*User))
-	}
-	return interceptor(ctx, in, info, handler)
+type Artifacts struct {
+	Artifacts            []*Artifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func _AutograderService_IsAuthorizedTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/IsAuthorizedTeacher",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, req.(*Void))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Artifacts) Reset()         { *m = Artifacts{} }
+func (m *Artifacts) String() string { return proto.CompactTextString(m) }
+func (*Artifacts) ProtoMessage()    {}
+func (*Artifacts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{67}
 }
-
-func _AutograderService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Artifacts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _AutograderService_GetGroupByUserAndCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetGroupByUserAndCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, req.(*GroupRequest))
+func (m *Artifacts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Artifacts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _AutograderService_GetGroupsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetGroupsByCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, req.(*CourseRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Artifacts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Artifacts.Merge(m, src)
+}
+func (m *Artifacts) XXX_Size() int {
+	return m.Size()
+}
+func (m *Artifacts) XXX_DiscardUnknown() {
+	xxx_messageInfo_Artifacts.DiscardUnknown(m)
 }
 
-func _AutograderService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/CreateGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateGroup(ctx, req.(*Group))
+var xxx_messageInfo_Artifacts proto.InternalMessageInfo
+
+func (m *Artifacts) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AutograderService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateGroup(ctx, req.(*Group))
-	}
-	return interceptor(ctx, in, info, handler)
+type CourseUserRequest struct {
+	CourseCode           string   `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
+	CourseYear           uint32   `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
+	UserLogin            string   `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AutograderService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/DeleteGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteGroup(ctx, req.(*GroupRequest))
+func (m *CourseUserRequest) Reset()         { *m = CourseUserRequest{} }
+func (m *CourseUserRequest) String() string { return proto.CompactTextString(m) }
+func (*CourseUserRequest) ProtoMessage()    {}
+func (*CourseUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{68}
+}
+func (m *CourseUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CourseUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CourseUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CourseUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CourseUserRequest.Merge(m, src)
+}
+func (m *CourseUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CourseUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CourseUserRequest.DiscardUnknown(m)
 }
 
-func _AutograderService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetCourse(ctx, req.(*CourseRequest))
+var xxx_messageInfo_CourseUserRequest proto.InternalMessageInfo
+
+func (m *CourseUserRequest) GetCourseCode() string {
+	if m != nil {
+		return m.CourseCode
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AutograderService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetCourses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetCourses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetCourses(ctx, req.(*Void))
+func (m *CourseUserRequest) GetCourseYear() uint32 {
+	if m != nil {
+		return m.CourseYear
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_GetCoursesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetCoursesByUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, req.(*EnrollmentStatusRequest))
+func (m *CourseUserRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AutograderService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Course)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/CreateCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateCourse(ctx, req.(*Course))
-	}
-	return interceptor(ctx, in, info, handler)
+type LoadCriteriaRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID         uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AutograderService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Course)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCourse(ctx, req.(*Course))
+func (m *LoadCriteriaRequest) Reset()         { *m = LoadCriteriaRequest{} }
+func (m *LoadCriteriaRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadCriteriaRequest) ProtoMessage()    {}
+func (*LoadCriteriaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{69}
+}
+func (m *LoadCriteriaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoadCriteriaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoadCriteriaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *LoadCriteriaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadCriteriaRequest.Merge(m, src)
+}
+func (m *LoadCriteriaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoadCriteriaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadCriteriaRequest.DiscardUnknown(m)
 }
 
-func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateCourseVisibility",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, req.(*Enrollment))
+var xxx_messageInfo_LoadCriteriaRequest proto.InternalMessageInfo
+
+func (m *LoadCriteriaRequest) GetCourseID() uint64 {
+	if m != nil {
+		return m.CourseID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetAssignments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetAssignments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetAssignments(ctx, req.(*CourseRequest))
+func (m *LoadCriteriaRequest) GetAssignmentID() uint64 {
+	if m != nil {
+		return m.AssignmentID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateAssignments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateAssignments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateAssignments(ctx, req.(*CourseRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// TestsValidationRequest is a request to validate the course's tests repository.
+// If solutionsRepo is set, the tests of each assignment are also run against
+// the repository with that name in the course organization.
+type TestsValidationRequest struct {
+	CourseID             uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SolutionsRepo        string   `protobuf:"bytes,2,opt,name=solutionsRepo,proto3" json:"solutionsRepo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetEnrollmentsByUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, req.(*EnrollmentStatusRequest))
+func (m *TestsValidationRequest) Reset()         { *m = TestsValidationRequest{} }
+func (m *TestsValidationRequest) String() string { return proto.CompactTextString(m) }
+func (*TestsValidationRequest) ProtoMessage()    {}
+func (*TestsValidationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{70}
+}
+func (m *TestsValidationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestsValidationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestsValidationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TestsValidationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestsValidationRequest.Merge(m, src)
+}
+func (m *TestsValidationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestsValidationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestsValidationRequest.DiscardUnknown(m)
 }
 
-func _AutograderService_GetEnrollmentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetEnrollmentsByCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, req.(*EnrollmentRequest))
+var xxx_messageInfo_TestsValidationRequest proto.InternalMessageInfo
+
+func (m *TestsValidationRequest) GetCourseID() uint64 {
+	if m != nil {
+		return m.CourseID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateEnrollment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/CreateEnrollment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateEnrollment(ctx, req.(*Enrollment))
+func (m *TestsValidationRequest) GetSolutionsRepo() string {
+	if m != nil {
+		return m.SolutionsRepo
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AutograderService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateEnrollment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, req.(*Enrollment))
-	}
-	return interceptor(ctx, in, info, handler)
+// AssignmentValidation reports the problems found for a single assignment,
+// and the score obtained by the solutions repository if tests were run.
+type AssignmentValidation struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Errors               []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Tested               bool     `protobuf:"varint,3,opt,name=tested,proto3" json:"tested,omitempty"`
+	Score                uint32   `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreLimit           uint32   `protobuf:"varint,5,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AutograderService_UpdateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AssignmentValidation) Reset()         { *m = AssignmentValidation{} }
+func (m *AssignmentValidation) String() string { return proto.CompactTextString(m) }
+func (*AssignmentValidation) ProtoMessage()    {}
+func (*AssignmentValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{71}
+}
+func (m *AssignmentValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, in)
+}
+func (m *AssignmentValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentValidation.Merge(m, src)
+}
+func (m *AssignmentValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignmentValidation proto.InternalMessageInfo
+
+func (m *AssignmentValidation) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateEnrollments",
+	return ""
+}
+
+func (m *AssignmentValidation) GetErrors() []string {
+	if m != nil {
+		return m.Errors
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, req.(*CourseRequest))
+	return nil
+}
+
+func (m *AssignmentValidation) GetTested() bool {
+	if m != nil {
+		return m.Tested
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AutograderService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AssignmentValidation) GetScore() uint32 {
+	if m != nil {
+		return m.Score
 	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetSubmissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetSubmissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetSubmissions(ctx, req.(*SubmissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_GetSubmissionsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionsForCourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetSubmissionsByCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, req.(*SubmissionsForCourseRequest))
+func (m *AssignmentValidation) GetScoreLimit() uint32 {
+	if m != nil {
+		return m.ScoreLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AutograderService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubmissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateSubmission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateSubmission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type TestsValidation struct {
+	Errors               []string                `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	Assignments          []*AssignmentValidation `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func _AutograderService_UpdateSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubmissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateSubmissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, req.(*UpdateSubmissionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TestsValidation) Reset()         { *m = TestsValidation{} }
+func (m *TestsValidation) String() string { return proto.CompactTextString(m) }
+func (*TestsValidation) ProtoMessage()    {}
+func (*TestsValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{72}
 }
-
-func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RebuildRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).RebuildSubmission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/RebuildSubmission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).RebuildSubmission(ctx, req.(*RebuildRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TestsValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateBenchmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/CreateBenchmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateBenchmark(ctx, req.(*GradingBenchmark))
+func (m *TestsValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestsValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _AutograderService_UpdateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateBenchmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, req.(*GradingBenchmark))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TestsValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestsValidation.Merge(m, src)
 }
-
-func _AutograderService_DeleteBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/DeleteBenchmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, req.(*GradingBenchmark))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TestsValidation) XXX_Size() int {
+	return m.Size()
 }
-
-func _AutograderService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateCriterion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/CreateCriterion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateCriterion(ctx, req.(*GradingCriterion))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TestsValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestsValidation.DiscardUnknown(m)
 }
 
-func _AutograderService_UpdateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCriterion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateCriterion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCriterion(ctx, req.(*GradingCriterion))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_TestsValidation proto.InternalMessageInfo
 
-func _AutograderService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteCriterion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/DeleteCriterion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteCriterion(ctx, req.(*GradingCriterion))
+func (m *TestsValidation) GetErrors() []string {
+	if m != nil {
+		return m.Errors
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AutograderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/CreateReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateReview(ctx, req.(*ReviewRequest))
+func (m *TestsValidation) GetAssignments() []*AssignmentValidation {
+	if m != nil {
+		return m.Assignments
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AutograderService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/UpdateReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateReview(ctx, req.(*ReviewRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Void contains no fields. A server response with a Void still contains a gRPC status code,
+// which can be checked for success or failure. Status code 0 indicates that the requested action was successful,
+// whereas any other status code indicates some failure. As such, the status code can be used as a boolean result from the server.
+type Void struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AutograderService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionReviewersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetReviewers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetReviewers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetReviewers(ctx, req.(*SubmissionReviewersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Void) Reset()         { *m = Void{} }
+func (m *Void) String() string { return proto.CompactTextString(m) }
+func (*Void) ProtoMessage()    {}
+func (*Void) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a984e8f57169aa1, []int{73}
 }
-
-func _AutograderService_LoadCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadCriteriaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).LoadCriteria(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/LoadCriteria",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).LoadCriteria(ctx, req.(*LoadCriteriaRequest))
+func (m *Void) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Void.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Void) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Void.Merge(m, src)
+}
+func (m *Void) XXX_Size() int {
+	return m.Size()
+}
+func (m *Void) XXX_DiscardUnknown() {
+	xxx_messageInfo_Void.DiscardUnknown(m)
 }
 
-func _AutograderService_GetProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_Void proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("Group_GroupStatus", Group_GroupStatus_name, Group_GroupStatus_value)
+	proto.RegisterEnum("Repository_Type", Repository_Type_name, Repository_Type_value)
+	proto.RegisterEnum("Enrollment_UserStatus", Enrollment_UserStatus_name, Enrollment_UserStatus_value)
+	proto.RegisterEnum("Enrollment_DisplayState", Enrollment_DisplayState_name, Enrollment_DisplayState_value)
+	proto.RegisterEnum("Assignment_PrerequisitePolicy", Assignment_PrerequisitePolicy_name, Assignment_PrerequisitePolicy_value)
+	proto.RegisterEnum("Submission_Status", Submission_Status_name, Submission_Status_value)
+	proto.RegisterEnum("GradingCriterion_Grade", GradingCriterion_Grade_name, GradingCriterion_Grade_value)
+	proto.RegisterEnum("Regrade_State", Regrade_State_name, Regrade_State_value)
+	proto.RegisterEnum("SubmissionsForCourseRequest_Type", SubmissionsForCourseRequest_Type_name, SubmissionsForCourseRequest_Type_value)
+	proto.RegisterType((*User)(nil), "User")
+	proto.RegisterType((*Users)(nil), "Users")
+	proto.RegisterType((*RemoteIdentity)(nil), "RemoteIdentity")
+	proto.RegisterType((*Group)(nil), "Group")
+	proto.RegisterType((*Groups)(nil), "Groups")
+	proto.RegisterType((*Course)(nil), "Course")
+	proto.RegisterType((*Courses)(nil), "Courses")
+	proto.RegisterType((*Repository)(nil), "Repository")
+	proto.RegisterType((*Enrollment)(nil), "Enrollment")
+	proto.RegisterType((*UsedSlipDays)(nil), "UsedSlipDays")
+	proto.RegisterType((*Enrollments)(nil), "Enrollments")
+	proto.RegisterType((*SubmissionLink)(nil), "SubmissionLink")
+	proto.RegisterType((*EnrollmentLink)(nil), "EnrollmentLink")
+	proto.RegisterType((*CourseSubmissions)(nil), "CourseSubmissions")
+	proto.RegisterType((*Assignment)(nil), "Assignment")
+	proto.RegisterType((*Assignments)(nil), "Assignments")
+	proto.RegisterType((*AssignmentChanges)(nil), "AssignmentChanges")
+	proto.RegisterMapType((map[string]string)(nil), "AssignmentChanges.RenamedEntry")
+	proto.RegisterType((*Submission)(nil), "Submission")
+	proto.RegisterType((*TestResult)(nil), "TestResult")
+	proto.RegisterType((*BuildLog)(nil), "BuildLog")
+	proto.RegisterType((*Submissions)(nil), "Submissions")
+	proto.RegisterType((*GradingBenchmark)(nil), "GradingBenchmark")
+	proto.RegisterType((*Benchmarks)(nil), "Benchmarks")
+	proto.RegisterType((*GradingCriterion)(nil), "GradingCriterion")
+	proto.RegisterType((*Review)(nil), "Review")
+	proto.RegisterType((*Reviewers)(nil), "Reviewers")
+	proto.RegisterType((*Regrade)(nil), "Regrade")
+	proto.RegisterType((*ScoreUpdate)(nil), "ScoreUpdate")
+	proto.RegisterType((*Regrades)(nil), "Regrades")
+	proto.RegisterType((*ReviewRequest)(nil), "ReviewRequest")
+	proto.RegisterType((*CourseRequest)(nil), "CourseRequest")
+	proto.RegisterType((*UserRequest)(nil), "UserRequest")
+	proto.RegisterType((*GetGroupRequest)(nil), "GetGroupRequest")
+	proto.RegisterType((*GroupRequest)(nil), "GroupRequest")
+	proto.RegisterType((*Provider)(nil), "Provider")
+	proto.RegisterType((*OrgRequest)(nil), "OrgRequest")
+	proto.RegisterType((*Organization)(nil), "Organization")
+	proto.RegisterType((*Organizations)(nil), "Organizations")
+	proto.RegisterType((*EnrollmentRequest)(nil), "EnrollmentRequest")
+	proto.RegisterType((*EnrollmentStatusRequest)(nil), "EnrollmentStatusRequest")
+	proto.RegisterType((*SubmissionRequest)(nil), "SubmissionRequest")
+	proto.RegisterType((*UpdateSubmissionRequest)(nil), "UpdateSubmissionRequest")
+	proto.RegisterType((*UpdateSubmissionsRequest)(nil), "UpdateSubmissionsRequest")
+	proto.RegisterType((*SubmissionReviewersRequest)(nil), "SubmissionReviewersRequest")
+	proto.RegisterType((*Providers)(nil), "Providers")
+	proto.RegisterType((*URLRequest)(nil), "URLRequest")
+	proto.RegisterType((*RepositoryRequest)(nil), "RepositoryRequest")
+	proto.RegisterType((*Repositories)(nil), "Repositories")
+	proto.RegisterMapType((map[string]string)(nil), "Repositories.URLsEntry")
+	proto.RegisterType((*AuthorizationResponse)(nil), "AuthorizationResponse")
+	proto.RegisterType((*Status)(nil), "Status")
+	proto.RegisterType((*SubmissionsForCourseRequest)(nil), "SubmissionsForCourseRequest")
+	proto.RegisterType((*RebuildRequest)(nil), "RebuildRequest")
+	proto.RegisterType((*AssignmentRequest)(nil), "AssignmentRequest")
+	proto.RegisterType((*RebuildAssignmentRequest)(nil), "RebuildAssignmentRequest")
+	proto.RegisterType((*ScoreChange)(nil), "ScoreChange")
+	proto.RegisterType((*AssignmentRebuild)(nil), "AssignmentRebuild")
+	proto.RegisterType((*TestOutcome)(nil), "TestOutcome")
+	proto.RegisterType((*TestStat)(nil), "TestStat")
+	proto.RegisterType((*TestStats)(nil), "TestStats")
+	proto.RegisterType((*ArtifactsRequest)(nil), "ArtifactsRequest")
+	proto.RegisterType((*Leaderboard)(nil), "Leaderboard")
+	proto.RegisterType((*LeaderboardEntry)(nil), "LeaderboardEntry")
+	proto.RegisterType((*RegradesRequest)(nil), "RegradesRequest")
+	proto.RegisterType((*UpdateRegradeRequest)(nil), "UpdateRegradeRequest")
+	proto.RegisterType((*BuildLogRequest)(nil), "BuildLogRequest")
+	proto.RegisterType((*CancelBuildRequest)(nil), "CancelBuildRequest")
+	proto.RegisterType((*Artifact)(nil), "Artifact")
+	proto.RegisterType((*Artifacts)(nil), "Artifacts")
+	proto.RegisterType((*CourseUserRequest)(nil), "CourseUserRequest")
+	proto.RegisterType((*LoadCriteriaRequest)(nil), "LoadCriteriaRequest")
+	proto.RegisterType((*TestsValidationRequest)(nil), "TestsValidationRequest")
+	proto.RegisterType((*AssignmentValidation)(nil), "AssignmentValidation")
+	proto.RegisterType((*TestsValidation)(nil), "TestsValidation")
+	proto.RegisterType((*Void)(nil), "Void")
+}
+
+func init() { proto.RegisterFile("ag.proto", fileDescriptor_7a984e8f57169aa1) }
+
+var fileDescriptor_7a984e8f57169aa1 = []byte{
+	// 4593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0x1c, 0x7c, 0x11, 0x78, 0x00, 0x48, 0xb0, 0x4d, 0x53, 0x30, 0xec, 0x12, 0xb5, 0xbd, 0xb2,
+	0x97, 0xb6, 0xac, 0xb1, 0x4d, 0xaf, 0xd7, 0x6b, 0xad, 0x13, 0x9b, 0x24, 0x60, 0x1a, 0xbb, 0x30,
+	0xc9, 0x1d, 0x90, 0xaa, 0x7c, 0x6c, 0x85, 0x35, 0x04, 0x5a, 0xe0, 0x2c, 0xc1, 0x19, 0x68, 0x66,
+	0x20, 0x99, 0x3e, 0xa4, 0x2a, 0x87, 0xe4, 0x90, 0xca, 0x21, 0x95, 0xca, 0x21, 0x55, 0xc9, 0x0f,
+	0x48, 0x2e, 0x39, 0xe4, 0xb2, 0x97, 0x54, 0xe5, 0x9a, 0x63, 0xee, 0x49, 0x94, 0x94, 0x0f, 0xc9,
+	0x31, 0x55, 0xba, 0x26, 0x87, 0xd4, 0xeb, 0xaf, 0xe9, 0xc1, 0x80, 0x14, 0xa9, 0xf5, 0x5e, 0xc4,
+	0x79, 0xaf, 0x5f, 0xbf, 0xee, 0x7e, 0x5f, 0xfd, 0xde, 0x6b, 0x08, 0xca, 0xee, 0xc8, 0x9e, 0x84,
+	0x41, 0x1c, 0xb4, 0x56, 0x47, 0xc1, 0x28, 0xe0, 0x9f, 0xef, 0xe1, 0x97, 0xc0, 0xd2, 0xbf, 0xca,
+	0x41, 0xe1, 0x28, 0x62, 0x21, 0x59, 0x82, 0x5c, 0xb7, 0xdd, 0xb4, 0xee, 0x58, 0x1b, 0x05, 0x27,
+	0xd7, 0x6d, 0x93, 0x26, 0x2c, 0x7a, 0xd1, 0xd6, 0xf0, 0xdc, 0xf3, 0x9b, 0xb9, 0x3b, 0xd6, 0x46,
+	0xd9, 0x51, 0x20, 0x21, 0x50, 0xf0, 0xdd, 0x73, 0xd6, 0xcc, 0xdf, 0xb1, 0x36, 0x2a, 0x0e, 0xff,
+	0x26, 0x6f, 0x40, 0x25, 0x8a, 0xa7, 0x43, 0xe6, 0xc7, 0xdd, 0x76, 0xb3, 0xc0, 0x07, 0x12, 0x04,
+	0x59, 0x85, 0x22, 0x3b, 0x77, 0xbd, 0x71, 0xb3, 0xc8, 0x47, 0x04, 0x80, 0x73, 0xdc, 0x27, 0x6e,
+	0xec, 0x86, 0x47, 0x4e, 0xaf, 0x59, 0x12, 0x73, 0x34, 0x02, 0xe7, 0x8c, 0x83, 0x91, 0xe7, 0x37,
+	0x17, 0xc5, 0x1c, 0x0e, 0x90, 0x9f, 0x40, 0x23, 0x64, 0xe7, 0x41, 0xcc, 0xba, 0xc8, 0xda, 0x8b,
+	0x3d, 0x16, 0x35, 0xcb, 0x77, 0xf2, 0x1b, 0xd5, 0xcd, 0x65, 0xdb, 0x31, 0x07, 0x2e, 0x9c, 0x0c,
+	0x21, 0xb9, 0x0f, 0x55, 0xe6, 0x87, 0xc1, 0x78, 0x7c, 0xce, 0xfc, 0x38, 0x6a, 0x56, 0xf8, 0xbc,
+	0xaa, 0xdd, 0xd1, 0x38, 0xc7, 0x1c, 0xa7, 0x77, 0xa1, 0x88, 0x92, 0x89, 0xc8, 0xeb, 0x50, 0x9c,
+	0xe2, 0x47, 0xd3, 0xe2, 0x33, 0x8a, 0x36, 0xa2, 0x1d, 0x81, 0xa3, 0xcf, 0x2d, 0x58, 0x4a, 0xaf,
+	0x9c, 0x11, 0xe5, 0x4f, 0xa1, 0x3c, 0x09, 0x83, 0x27, 0xde, 0x90, 0x85, 0x5c, 0x96, 0x95, 0x6d,
+	0xfb, 0xf9, 0xb3, 0xf5, 0x77, 0x46, 0x41, 0x78, 0xfe, 0x80, 0x4e, 0x7d, 0xef, 0xf1, 0x94, 0x1d,
+	0x7b, 0xfe, 0x90, 0x7d, 0xfd, 0x60, 0xea, 0x0d, 0x8f, 0x15, 0xe9, 0xb1, 0xd8, 0xff, 0xb1, 0x37,
+	0xa4, 0x8e, 0x9e, 0x8f, 0xbc, 0xe4, 0xb9, 0xda, 0x5c, 0x01, 0x85, 0x9b, 0xf3, 0x52, 0xf3, 0xc9,
+	0x1d, 0xa8, 0xba, 0x83, 0x01, 0x8b, 0xa2, 0xc3, 0xe0, 0x8c, 0xf9, 0x52, 0x6d, 0x26, 0x8a, 0xac,
+	0x41, 0x09, 0x4f, 0xd9, 0x6d, 0x73, 0xcd, 0x15, 0x1c, 0x09, 0xd1, 0xff, 0xc8, 0x41, 0x71, 0x37,
+	0x0c, 0xa6, 0x93, 0xcc, 0x59, 0xb7, 0xa4, 0x71, 0x88, 0x73, 0xde, 0x7f, 0xfe, 0x6c, 0xfd, 0xed,
+	0x39, 0x7b, 0xf3, 0x86, 0x5f, 0x1f, 0x4b, 0xc4, 0x08, 0xd9, 0x1c, 0xe3, 0x1c, 0x2a, 0x6d, 0xa9,
+	0x0b, 0xe5, 0x41, 0x30, 0x0d, 0xa3, 0xe4, 0x88, 0x37, 0x64, 0xa3, 0xa7, 0xe3, 0xfe, 0x63, 0xe6,
+	0x9e, 0x4b, 0x9b, 0x2c, 0x38, 0x12, 0x22, 0xef, 0x40, 0x29, 0x8a, 0xdd, 0x78, 0x1a, 0xf1, 0x73,
+	0x2d, 0x6d, 0x12, 0x9b, 0x9f, 0x46, 0xfc, 0xdb, 0xe7, 0x23, 0x8e, 0xa4, 0x48, 0xb4, 0x5f, 0xca,
+	0x6a, 0x7f, 0xd6, 0xa4, 0x16, 0x5f, 0x60, 0x52, 0x1b, 0x50, 0x35, 0x96, 0x20, 0x55, 0x58, 0x3c,
+	0xe8, 0xec, 0xb5, 0xbb, 0x7b, 0xbb, 0x8d, 0x05, 0x52, 0x83, 0xf2, 0xd6, 0xc1, 0x81, 0xb3, 0xff,
+	0xb0, 0xd3, 0x6e, 0x58, 0x74, 0x03, 0x4a, 0x9c, 0x32, 0x22, 0xb7, 0xa1, 0xc4, 0x0f, 0xa7, 0xcc,
+	0xaf, 0x24, 0x76, 0xe9, 0x48, 0x2c, 0xfd, 0xdf, 0x02, 0x94, 0x76, 0xf8, 0x81, 0x33, 0xca, 0xd8,
+	0x80, 0x65, 0x21, 0x8a, 0x9d, 0x90, 0xb9, 0x71, 0x80, 0x7a, 0xcc, 0xf1, 0xc1, 0x59, 0xf4, 0x5c,
+	0x9f, 0x26, 0x50, 0x18, 0x04, 0x43, 0x26, 0xed, 0x82, 0x7f, 0x23, 0xee, 0x82, 0xb9, 0x21, 0x17,
+	0x5b, 0xdd, 0xe1, 0xdf, 0xa4, 0x01, 0xf9, 0xd8, 0x1d, 0x49, 0x0f, 0xc6, 0x4f, 0xd2, 0x32, 0x0c,
+	0x5e, 0xb8, 0xaf, 0x86, 0xc9, 0x5b, 0xb0, 0x14, 0x84, 0x23, 0xd7, 0xf7, 0xbe, 0x71, 0x63, 0x2f,
+	0xf0, 0xbb, 0xed, 0x66, 0x99, 0x6f, 0x69, 0x06, 0x4b, 0xde, 0x81, 0x86, 0x89, 0x39, 0x70, 0xe3,
+	0xd3, 0x66, 0x85, 0xf3, 0xca, 0xe0, 0x71, 0xbd, 0x68, 0xec, 0x4d, 0xda, 0xee, 0x45, 0xd4, 0x04,
+	0xbe, 0x33, 0x0d, 0x93, 0xcf, 0xa0, 0x2c, 0x34, 0xc0, 0x86, 0xcd, 0x2a, 0x57, 0xf6, 0x9a, 0xa1,
+	0x1e, 0xae, 0x4c, 0xa1, 0x8d, 0xed, 0xea, 0xf3, 0x67, 0xeb, 0x8b, 0xd1, 0xe3, 0xf1, 0x03, 0x7a,
+	0x9f, 0x3a, 0x7a, 0xd2, 0xac, 0x8a, 0x6b, 0x57, 0xab, 0x18, 0xc9, 0xdd, 0x28, 0xf2, 0x46, 0xbe,
+	0x20, 0xaf, 0x4b, 0xf2, 0x2d, 0x8d, 0x73, 0xcc, 0x71, 0x43, 0xbb, 0x4b, 0xf3, 0xb4, 0x8b, 0xe2,
+	0x8a, 0xd9, 0xf9, 0x64, 0xec, 0xc6, 0x6c, 0x3b, 0x74, 0xfd, 0xc1, 0x69, 0x73, 0x99, 0x0b, 0x61,
+	0x06, 0x8b, 0xaa, 0x9e, 0x4c, 0xa3, 0xd3, 0x2d, 0x63, 0xe9, 0x06, 0x0f, 0xdb, 0xb3, 0x68, 0xf4,
+	0xfa, 0x61, 0x30, 0x38, 0x63, 0x61, 0xf7, 0xdc, 0x1d, 0xb1, 0xe6, 0x8a, 0xf0, 0x7a, 0x03, 0x85,
+	0xa2, 0x37, 0xc0, 0x4e, 0x18, 0x06, 0x61, 0x93, 0x08, 0xd1, 0xcf, 0xe2, 0xe9, 0xbb, 0xb0, 0x28,
+	0x8c, 0x2f, 0x22, 0xdf, 0x83, 0x45, 0x61, 0x56, 0xca, 0x52, 0x17, 0x6d, 0x31, 0xe4, 0x28, 0x3c,
+	0xfd, 0xf7, 0x3c, 0x80, 0xc3, 0x26, 0x41, 0xe4, 0xc5, 0x41, 0x98, 0x0d, 0x94, 0x07, 0x19, 0xdb,
+	0xe0, 0xe6, 0xba, 0xbd, 0xf1, 0xfc, 0xd9, 0xfa, 0xdd, 0x4b, 0x42, 0xdc, 0xc8, 0x1b, 0x1e, 0x07,
+	0xe1, 0xe8, 0x38, 0xbe, 0x98, 0x30, 0x9a, 0xb1, 0x22, 0x0a, 0xb5, 0x50, 0xaf, 0xa7, 0xe2, 0x89,
+	0x93, 0xc2, 0x91, 0xcf, 0x75, 0x90, 0x2b, 0xdc, 0x70, 0x35, 0x39, 0x8f, 0x6c, 0xc3, 0x22, 0x57,
+	0x97, 0x8a, 0x93, 0x37, 0x60, 0xa1, 0x26, 0xe2, 0x7d, 0xfb, 0xe5, 0xe1, 0x57, 0xbd, 0xe4, 0x2e,
+	0x54, 0x20, 0x79, 0x88, 0x21, 0x7f, 0x12, 0x1c, 0x5e, 0x4c, 0x18, 0xf7, 0xa6, 0xa5, 0xcd, 0x86,
+	0x9d, 0x08, 0xd1, 0x46, 0xfc, 0x0d, 0x16, 0xd4, 0xbc, 0xe8, 0xcf, 0xa1, 0x80, 0x7f, 0x49, 0x19,
+	0x0a, 0x7b, 0xfb, 0x7b, 0x9d, 0xc6, 0x02, 0x59, 0x02, 0xd8, 0xd9, 0x3f, 0x72, 0xfa, 0x9d, 0xee,
+	0xde, 0x17, 0xfb, 0x0d, 0x8b, 0x2c, 0x43, 0x75, 0xab, 0xdf, 0xef, 0xee, 0xee, 0x7d, 0xd5, 0xd9,
+	0x3b, 0xec, 0x37, 0x72, 0xa4, 0x02, 0xc5, 0xc3, 0x4e, 0xff, 0xb0, 0xdf, 0xc8, 0xe3, 0xac, 0xa3,
+	0x7e, 0xc7, 0x69, 0x14, 0x10, 0xb9, 0xeb, 0xec, 0x1f, 0x1d, 0x34, 0x8a, 0xf4, 0x7f, 0x8a, 0x00,
+	0x89, 0x63, 0x64, 0xf4, 0x6b, 0x46, 0xf6, 0xdc, 0x75, 0x23, 0x7b, 0xe2, 0x5c, 0x66, 0x64, 0xef,
+	0x68, 0xa5, 0xe5, 0x5f, 0x86, 0x91, 0xd2, 0x5c, 0x33, 0xd1, 0x9c, 0xb8, 0x21, 0x14, 0x88, 0x4e,
+	0x70, 0xea, 0x46, 0x87, 0xcc, 0x1d, 0x9c, 0xb2, 0xb0, 0x3f, 0x08, 0x26, 0x4c, 0x5c, 0x16, 0x65,
+	0x27, 0x83, 0x27, 0xaf, 0x41, 0x01, 0xf9, 0x71, 0xc5, 0xe9, 0x1b, 0x82, 0xa3, 0xc8, 0x3a, 0x94,
+	0xc4, 0x9e, 0xb9, 0xea, 0x0c, 0x9f, 0x90, 0x68, 0xf2, 0x06, 0x14, 0xf9, 0x92, 0x3c, 0x0c, 0x26,
+	0xfe, 0x2f, 0x90, 0xc4, 0xd6, 0x17, 0x55, 0xe5, 0xaa, 0xd8, 0xa5, 0x2f, 0x2b, 0x1b, 0x8a, 0xf8,
+	0xc5, 0x78, 0x18, 0x5c, 0xda, 0x6c, 0x9a, 0xe4, 0x6d, 0x2f, 0x9a, 0x8c, 0xdd, 0x0b, 0x9c, 0xc1,
+	0x1c, 0x41, 0x46, 0x3e, 0x81, 0x15, 0x15, 0x29, 0x1d, 0xcc, 0xca, 0x7c, 0xcf, 0x1f, 0xf1, 0x30,
+	0x59, 0x4f, 0x87, 0xc3, 0x2c, 0x15, 0x0a, 0x68, 0xec, 0x46, 0xf1, 0xd6, 0x20, 0xf6, 0x9e, 0x78,
+	0xf1, 0x45, 0x1b, 0x57, 0xad, 0x89, 0x28, 0x31, 0x8b, 0x27, 0x77, 0xa1, 0x1e, 0x07, 0xb1, 0x3b,
+	0xde, 0x9a, 0xe0, 0x3d, 0xc0, 0x86, 0xcd, 0x3a, 0x17, 0x76, 0x1a, 0x49, 0x3e, 0x80, 0xda, 0x34,
+	0x62, 0xc3, 0xbe, 0x0a, 0xe5, 0x22, 0x22, 0xd6, 0xed, 0x23, 0x03, 0xe9, 0xa4, 0x48, 0xe8, 0x6f,
+	0x01, 0x24, 0x52, 0x30, 0x2c, 0xd9, 0xb8, 0x59, 0x2d, 0x04, 0xfa, 0x87, 0x47, 0xed, 0xce, 0xde,
+	0x61, 0x23, 0x87, 0xc0, 0x61, 0x67, 0x6b, 0xe7, 0xcb, 0x8e, 0xd3, 0xc8, 0xd3, 0xcf, 0xa1, 0x66,
+	0x4a, 0x05, 0x4d, 0xf9, 0x68, 0xaf, 0xdf, 0x39, 0x6c, 0x2c, 0x10, 0x80, 0xd2, 0x97, 0xdd, 0x76,
+	0xbb, 0xb3, 0x27, 0x18, 0x3c, 0xec, 0xf6, 0xbb, 0xdb, 0xbd, 0x4e, 0x23, 0x87, 0xf7, 0xf4, 0x17,
+	0x5b, 0x0f, 0xf7, 0x9d, 0xee, 0x61, 0xa7, 0x91, 0xa7, 0x7f, 0x6a, 0x41, 0xcd, 0xdc, 0x5f, 0xc6,
+	0xe6, 0x29, 0xd4, 0x12, 0xc3, 0xd3, 0x17, 0x70, 0x0a, 0x87, 0x34, 0xc9, 0x9d, 0x90, 0x44, 0x29,
+	0x13, 0x87, 0x34, 0x29, 0xe1, 0x14, 0xf8, 0x3d, 0x97, 0x96, 0xc6, 0xa7, 0x50, 0xed, 0xa4, 0xaf,
+	0x22, 0xf3, 0xe6, 0xb2, 0x5e, 0x90, 0x9c, 0xfc, 0x12, 0x96, 0xfa, 0xd3, 0x93, 0x73, 0x2f, 0x8a,
+	0xbc, 0xc0, 0xef, 0x79, 0xfe, 0x19, 0xb9, 0x07, 0x90, 0xec, 0x81, 0x9f, 0x69, 0xe6, 0x2a, 0x33,
+	0x86, 0x91, 0x38, 0xd2, 0xd3, 0x9b, 0x39, 0x49, 0x9c, 0x70, 0x74, 0x8c, 0x61, 0x3a, 0x81, 0xa5,
+	0x64, 0x1b, 0x6a, 0xad, 0x64, 0x33, 0x7a, 0xba, 0xb1, 0x57, 0x63, 0x98, 0x7c, 0x00, 0xd5, 0x84,
+	0x59, 0xd4, 0xcc, 0xcb, 0x0a, 0x20, 0xbd, 0x7d, 0xc7, 0xa4, 0xa1, 0xbf, 0x0f, 0x2b, 0xc2, 0xf3,
+	0x12, 0xa2, 0xc8, 0xf0, 0x4e, 0x6b, 0xbe, 0x77, 0xbe, 0x09, 0xc5, 0xb1, 0xe7, 0x9f, 0x45, 0xcd,
+	0x9c, 0x5c, 0x22, 0xbd, 0x6b, 0x47, 0x8c, 0xd2, 0x3f, 0x2e, 0x03, 0x24, 0x62, 0xc9, 0xd8, 0x40,
+	0x6b, 0x36, 0xee, 0x19, 0x81, 0x6c, 0x5e, 0xe6, 0x75, 0x1b, 0x20, 0x1a, 0x84, 0xde, 0x24, 0xfe,
+	0xc2, 0x1b, 0xab, 0xfc, 0xcb, 0xc0, 0x20, 0xbf, 0x21, 0x73, 0x87, 0x63, 0xcf, 0x67, 0xb2, 0xa4,
+	0xd2, 0x30, 0x4f, 0xea, 0xa7, 0x71, 0x20, 0x9d, 0x8a, 0x87, 0xa4, 0xb2, 0x63, 0xa2, 0xb0, 0xb2,
+	0x0a, 0x42, 0x95, 0x9a, 0xd5, 0x1d, 0x01, 0xe0, 0x9a, 0x5e, 0xc4, 0x63, 0x4f, 0xcf, 0x3d, 0xe1,
+	0xc1, 0xa8, 0xec, 0x18, 0x18, 0xb1, 0xa7, 0x20, 0x64, 0x3d, 0xef, 0xdc, 0x8b, 0x79, 0x34, 0xaa,
+	0x3b, 0x06, 0x06, 0xab, 0xb9, 0x90, 0x3d, 0xf1, 0xd8, 0x53, 0x16, 0xaa, 0x24, 0x2c, 0x41, 0xe0,
+	0x68, 0x74, 0xe6, 0x4d, 0x0e, 0x59, 0x14, 0x47, 0x3c, 0xbe, 0x94, 0x9d, 0x04, 0x81, 0x86, 0x6a,
+	0xaa, 0x53, 0xa5, 0x58, 0x86, 0xed, 0x98, 0xe3, 0xe4, 0x33, 0x58, 0x19, 0x85, 0xee, 0xd0, 0xf3,
+	0x47, 0xdb, 0xcc, 0x1f, 0x9c, 0x9e, 0xbb, 0xe1, 0x99, 0x4a, 0xb4, 0x56, 0xec, 0xdd, 0x99, 0x11,
+	0x27, 0x4b, 0x8b, 0xa1, 0x6b, 0x10, 0xf8, 0xb1, 0xeb, 0xf9, 0x2c, 0x3c, 0xf4, 0xce, 0x59, 0x30,
+	0x8d, 0x9b, 0x4b, 0x7c, 0xcb, 0x19, 0x3c, 0xca, 0x33, 0x64, 0x63, 0xe6, 0x46, 0x8c, 0x47, 0x38,
+	0x91, 0x7d, 0x99, 0x28, 0x2c, 0x32, 0x4e, 0xbd, 0xe1, 0x90, 0xf9, 0x32, 0xe3, 0x92, 0x10, 0x06,
+	0xbd, 0x49, 0xc8, 0x42, 0xf6, 0x78, 0xea, 0x45, 0x5e, 0xcc, 0x22, 0x99, 0x6a, 0xa5, 0x91, 0x64,
+	0x0f, 0x88, 0x89, 0x38, 0x08, 0xc6, 0xde, 0xe0, 0x82, 0xa7, 0x5b, 0x4b, 0x9b, 0xb7, 0x0d, 0x5f,
+	0xb3, 0x0f, 0x32, 0x54, 0xce, 0x9c, 0x99, 0x68, 0x1b, 0x6e, 0x38, 0x38, 0xf5, 0x30, 0xca, 0xbe,
+	0xc2, 0xf7, 0xa3, 0x61, 0x3c, 0x4b, 0x8c, 0x02, 0xdf, 0x09, 0xce, 0x51, 0x89, 0xab, 0xe2, 0x2c,
+	0x06, 0x0a, 0xd3, 0x4d, 0x61, 0x67, 0x87, 0x32, 0xbd, 0x6c, 0xbe, 0x2a, 0xd2, 0xcd, 0x34, 0x96,
+	0xd7, 0xee, 0xbe, 0x3b, 0xbe, 0xf8, 0x06, 0xb5, 0xbd, 0x26, 0x6b, 0x77, 0x85, 0x10, 0xf6, 0xfe,
+	0x84, 0x85, 0x98, 0x5f, 0xde, 0x12, 0xf6, 0xa9, 0x60, 0x1e, 0xeb, 0xc2, 0xd8, 0x7b, 0xe4, 0x0e,
+	0xe2, 0xa8, 0xed, 0x85, 0xcd, 0x26, 0x1f, 0x4f, 0xe1, 0x50, 0xa2, 0x21, 0x0b, 0xa7, 0x7e, 0xd4,
+	0x7c, 0x8d, 0x6b, 0x45, 0x42, 0x38, 0x37, 0x9c, 0xfa, 0x3e, 0x0b, 0x7b, 0xee, 0x09, 0x1b, 0x47,
+	0xcd, 0x96, 0x98, 0x6b, 0xe2, 0xd0, 0x4e, 0x4f, 0x12, 0xab, 0x78, 0x5d, 0xf8, 0x4e, 0x82, 0x41,
+	0x19, 0x8c, 0x99, 0x3b, 0x64, 0xe1, 0x49, 0xe0, 0x86, 0xc3, 0xe6, 0x1b, 0xc2, 0x3f, 0x0c, 0x14,
+	0xfd, 0x04, 0x48, 0x56, 0xd6, 0x98, 0x0b, 0x6d, 0xf7, 0xf6, 0x77, 0x7e, 0x76, 0x2c, 0x12, 0xa0,
+	0x05, 0x42, 0x60, 0x49, 0x20, 0x44, 0xd5, 0xb6, 0xd5, 0x6b, 0x58, 0x18, 0x80, 0xcd, 0x54, 0x7b,
+	0xa6, 0x16, 0xb0, 0xae, 0xae, 0x05, 0xe8, 0x9f, 0xe5, 0x60, 0x25, 0x19, 0xdb, 0x39, 0x75, 0xfd,
+	0x11, 0x8b, 0xd0, 0x5d, 0xdd, 0xe1, 0x90, 0x0d, 0xf9, 0xf4, 0x8a, 0x23, 0x00, 0x4c, 0x5c, 0xa6,
+	0x93, 0xa1, 0x1b, 0xb3, 0x21, 0x0f, 0x4d, 0x15, 0x47, 0x81, 0xe4, 0x13, 0x58, 0x0c, 0x19, 0x86,
+	0x91, 0xa1, 0x8c, 0x8b, 0xeb, 0x76, 0x86, 0xa9, 0xed, 0x08, 0x8a, 0x8e, 0x1f, 0x87, 0x17, 0x8e,
+	0xa2, 0x4f, 0xd9, 0x4e, 0x81, 0x73, 0xd5, 0x30, 0x6e, 0xc3, 0xe3, 0x05, 0x83, 0xec, 0xe1, 0x70,
+	0x80, 0x47, 0x8d, 0xa4, 0x48, 0x10, 0x89, 0xab, 0x81, 0x69, 0x3d, 0x80, 0x9a, 0xb9, 0x14, 0xd6,
+	0x8a, 0x67, 0xec, 0x82, 0x87, 0xc6, 0x8a, 0x83, 0x9f, 0xc8, 0xf7, 0x89, 0x3b, 0x9e, 0xca, 0x8e,
+	0x81, 0x23, 0x80, 0x07, 0xb9, 0x1f, 0x5b, 0xf4, 0xbf, 0xf3, 0x00, 0x49, 0x08, 0x98, 0x77, 0xb1,
+	0xa6, 0x2e, 0xcd, 0xdc, 0x9c, 0x4b, 0x73, 0x2d, 0x9d, 0x25, 0x5e, 0x23, 0xed, 0x5b, 0x85, 0x22,
+	0x0f, 0x6a, 0xb2, 0xc2, 0x15, 0x00, 0xae, 0xc5, 0x3f, 0xf6, 0x4f, 0x7e, 0xc9, 0x06, 0x71, 0x24,
+	0x0f, 0x9a, 0xc2, 0xa1, 0x4b, 0x9c, 0x4c, 0xbd, 0xf1, 0xb0, 0xeb, 0x3f, 0x0a, 0x64, 0xd5, 0x9b,
+	0x20, 0x50, 0x50, 0x03, 0xee, 0x62, 0x5f, 0xba, 0xd1, 0x29, 0x0f, 0xaf, 0x15, 0xc7, 0xc0, 0xa0,
+	0xe8, 0x65, 0x4c, 0x19, 0xf2, 0xe0, 0x5a, 0x76, 0x34, 0x6c, 0x74, 0x2b, 0x40, 0x76, 0x2b, 0x12,
+	0xb1, 0xd8, 0x33, 0x09, 0x20, 0x4a, 0x45, 0xe6, 0x53, 0x3c, 0x5e, 0x55, 0xa5, 0x7b, 0x19, 0x38,
+	0x2c, 0xd4, 0x44, 0x64, 0x56, 0xa1, 0x76, 0xd1, 0x76, 0x38, 0xec, 0x28, 0x3c, 0xe6, 0x91, 0x3c,
+	0x2c, 0xe8, 0xfa, 0x15, 0x03, 0xb5, 0xc3, 0xa2, 0xe9, 0x38, 0x4e, 0x27, 0x86, 0x82, 0x8c, 0x7e,
+	0x0a, 0xa5, 0x4c, 0x0e, 0x96, 0x6a, 0x68, 0x20, 0xe4, 0x74, 0x7e, 0xda, 0xd9, 0x39, 0xec, 0xb4,
+	0x45, 0x12, 0xe5, 0x74, 0x30, 0xa7, 0xda, 0xdf, 0x6b, 0xe4, 0xe9, 0xbf, 0x59, 0x00, 0xc9, 0x02,
+	0x28, 0x0b, 0xe4, 0xba, 0x87, 0xd7, 0xa2, 0xb0, 0x14, 0x0d, 0x27, 0xfa, 0x41, 0x75, 0x17, 0x95,
+	0x7e, 0x5a, 0x50, 0x3e, 0x77, 0xbf, 0xee, 0xf3, 0x81, 0x3c, 0x1f, 0xd0, 0x30, 0xda, 0xc0, 0x53,
+	0xe6, 0x8d, 0x4e, 0x63, 0xae, 0xea, 0xa2, 0x23, 0x21, 0x7e, 0x89, 0x4e, 0x43, 0x5e, 0x28, 0x72,
+	0x65, 0xe7, 0x1d, 0x0d, 0xa3, 0x7d, 0x3c, 0x72, 0xbd, 0xf1, 0x34, 0x64, 0xaa, 0x18, 0x93, 0x20,
+	0xce, 0x62, 0x5f, 0x4f, 0xd8, 0x00, 0x1d, 0x4f, 0xb6, 0x36, 0x14, 0x8c, 0x2b, 0xb9, 0x83, 0x78,
+	0xea, 0x8e, 0xa5, 0x7e, 0x25, 0x44, 0xff, 0x10, 0xca, 0xdb, 0x68, 0x08, 0xbd, 0x60, 0x34, 0xa7,
+	0xe4, 0xad, 0x25, 0x57, 0x9b, 0x2e, 0x8b, 0xde, 0x7d, 0xfe, 0x6c, 0x7d, 0xe3, 0xea, 0x6a, 0x86,
+	0x9b, 0xd6, 0xf1, 0x38, 0x18, 0x51, 0x27, 0xc5, 0x01, 0x5d, 0x6c, 0x1c, 0x8c, 0xb8, 0x18, 0x6a,
+	0x0e, 0x7e, 0x62, 0x54, 0x32, 0x93, 0x9e, 0x99, 0xdb, 0xd6, 0xba, 0xfa, 0xb6, 0xa5, 0x7f, 0x67,
+	0x41, 0x63, 0xf6, 0x52, 0x7d, 0x29, 0x67, 0x6c, 0xc2, 0xe2, 0x29, 0xe3, 0x7c, 0x64, 0xb2, 0xa3,
+	0x40, 0x1c, 0x41, 0x57, 0x60, 0xbe, 0xd0, 0x51, 0xc5, 0x51, 0x20, 0xb9, 0x0f, 0xe5, 0x41, 0xe8,
+	0xc5, 0x2c, 0xf4, 0xdc, 0x66, 0x31, 0x7d, 0xc3, 0xef, 0x08, 0x7c, 0xe0, 0x3b, 0x9a, 0x84, 0x7e,
+	0x06, 0x60, 0x5c, 0xf3, 0x1f, 0xa4, 0xae, 0x02, 0xeb, 0xb2, 0x04, 0xc1, 0x20, 0xa2, 0xcf, 0x93,
+	0xc3, 0x6a, 0xfe, 0x99, 0xc3, 0xae, 0x41, 0x69, 0x12, 0x78, 0x18, 0xd1, 0xc5, 0x31, 0x25, 0x84,
+	0x57, 0x8b, 0x66, 0xa5, 0x43, 0x8e, 0x89, 0x42, 0x8a, 0x21, 0x13, 0x57, 0x29, 0x9a, 0x9d, 0xec,
+	0xb8, 0x1a, 0x28, 0x72, 0x1f, 0xcb, 0x41, 0x77, 0xc8, 0x64, 0x63, 0xf2, 0x56, 0xe6, 0xb4, 0x1c,
+	0xc1, 0x1c, 0x41, 0x65, 0x4a, 0xae, 0x94, 0x92, 0x1c, 0x7d, 0x1b, 0x3b, 0xb4, 0x48, 0x92, 0x38,
+	0x24, 0x40, 0xe9, 0x8b, 0xad, 0x6e, 0x8f, 0xbb, 0x23, 0x40, 0xe9, 0x60, 0xab, 0xdf, 0x47, 0x67,
+	0xa4, 0xff, 0x67, 0x41, 0x49, 0x04, 0x80, 0x79, 0x7a, 0xcd, 0x9a, 0xe7, 0x8c, 0xc1, 0xdd, 0x06,
+	0x50, 0x89, 0x9e, 0x3e, 0xb5, 0x81, 0x11, 0xb7, 0x39, 0x42, 0xf2, 0xbc, 0x12, 0x42, 0x57, 0x7a,
+	0xc4, 0xd8, 0xf0, 0xc4, 0x1d, 0x9c, 0xa9, 0x2c, 0x56, 0xc1, 0xe8, 0xe6, 0x21, 0x73, 0x87, 0x17,
+	0x32, 0x7f, 0x15, 0x40, 0xe2, 0xfc, 0x8b, 0x7c, 0x11, 0x01, 0x90, 0xdf, 0x4e, 0xa9, 0xb9, 0x7c,
+	0x89, 0x9a, 0xd3, 0x61, 0xcb, 0xd4, 0xf9, 0xfb, 0x50, 0x71, 0x74, 0xa2, 0xfa, 0x7d, 0x33, 0x8d,
+	0x4d, 0xf5, 0xfb, 0x13, 0x3c, 0xfd, 0x8b, 0x3c, 0x2c, 0x3a, 0x4c, 0x68, 0xe0, 0x65, 0x24, 0x76,
+	0xd9, 0xb5, 0xc4, 0x25, 0xe5, 0x46, 0xda, 0x32, 0x24, 0x94, 0x0a, 0x88, 0xc5, 0x99, 0x80, 0x78,
+	0x57, 0x55, 0xfc, 0x25, 0x6e, 0x30, 0x4b, 0xb6, 0xdc, 0x98, 0x9d, 0xaa, 0xf3, 0xef, 0x40, 0x75,
+	0x10, 0x32, 0x37, 0x16, 0x37, 0x80, 0x8c, 0x5c, 0x26, 0x4a, 0x68, 0x31, 0x0a, 0xc6, 0x4f, 0xf8,
+	0xbe, 0xca, 0x4a, 0x8b, 0x0a, 0x23, 0x3a, 0x69, 0x1c, 0x12, 0x2c, 0x2a, 0x32, 0xf7, 0x32, 0x70,
+	0xe2, 0x12, 0x8b, 0x26, 0x81, 0x1f, 0x89, 0x06, 0x44, 0xc5, 0xd1, 0x30, 0x79, 0x5f, 0x5e, 0xa1,
+	0x47, 0x3c, 0x4d, 0xc1, 0x22, 0x00, 0x65, 0x5b, 0xb3, 0xfb, 0x09, 0xd2, 0x49, 0x51, 0xd0, 0xfb,
+	0x50, 0x14, 0x55, 0x79, 0x19, 0x0a, 0xfb, 0x07, 0x9d, 0x3d, 0x79, 0xa5, 0xec, 0xec, 0x74, 0x0e,
+	0x0e, 0xb3, 0x57, 0x0a, 0xfd, 0x57, 0x0b, 0xaa, 0x06, 0xb3, 0x97, 0x52, 0x0c, 0x2f, 0x62, 0xb8,
+	0xf8, 0xb4, 0x6e, 0x12, 0x04, 0x0a, 0x51, 0x26, 0x59, 0xdb, 0x17, 0x3a, 0x73, 0x30, 0x51, 0x28,
+	0x80, 0x60, 0x3c, 0xec, 0x1b, 0x09, 0x84, 0x86, 0x71, 0xcc, 0x67, 0x4f, 0xc5, 0x58, 0x49, 0x8c,
+	0x29, 0xd8, 0xe0, 0x6c, 0xaa, 0xc7, 0x40, 0xd1, 0xf7, 0xa1, 0x2c, 0x15, 0x1b, 0x91, 0xbb, 0x28,
+	0x66, 0xf1, 0x2d, 0x4d, 0xb4, 0xac, 0xb4, 0xee, 0xe8, 0x11, 0xda, 0x83, 0xba, 0xbc, 0xd5, 0xd9,
+	0xe3, 0x29, 0x8b, 0xe2, 0x54, 0x15, 0x6a, 0xcd, 0x54, 0xa1, 0xeb, 0xda, 0x47, 0x73, 0xb2, 0x10,
+	0x96, 0x73, 0x25, 0x9a, 0xde, 0x83, 0xba, 0x2c, 0x8d, 0x5f, 0xcc, 0x8d, 0xbe, 0x09, 0x55, 0xee,
+	0x32, 0x92, 0x34, 0x31, 0x77, 0x2b, 0xf5, 0x8a, 0x74, 0x0f, 0x96, 0x77, 0x59, 0x2c, 0xfa, 0x5d,
+	0x92, 0xd4, 0x48, 0xcc, 0xac, 0x54, 0x62, 0x46, 0x7f, 0x01, 0xb5, 0x14, 0xe5, 0x25, 0x4c, 0x4d,
+	0x0e, 0xb9, 0x14, 0x87, 0xd4, 0x8e, 0xf3, 0x33, 0x3b, 0x7e, 0x0b, 0xca, 0x07, 0xea, 0x85, 0xc2,
+	0x7c, 0xbd, 0xb0, 0xd2, 0xaf, 0x17, 0xf4, 0x2d, 0x80, 0xfd, 0x70, 0x64, 0xec, 0x36, 0x08, 0x47,
+	0x46, 0x9e, 0xa2, 0x40, 0x3a, 0x86, 0xda, 0xbe, 0xd1, 0x89, 0xce, 0x18, 0x23, 0x81, 0xc2, 0x04,
+	0x5f, 0x34, 0x44, 0xd2, 0xcb, 0xbf, 0x79, 0xfa, 0xc0, 0x9f, 0x3f, 0xe5, 0xf5, 0x28, 0x21, 0x34,
+	0x8e, 0x89, 0x7b, 0x81, 0x41, 0xfd, 0x60, 0xec, 0xea, 0x4b, 0xc3, 0x40, 0xd1, 0x36, 0xd4, 0xcd,
+	0xd5, 0x22, 0xf2, 0x21, 0xd4, 0xcd, 0x46, 0xb8, 0x32, 0x93, 0xba, 0x6d, 0x92, 0x39, 0x69, 0x1a,
+	0xfa, 0x2b, 0x0b, 0x56, 0x8c, 0x7e, 0xcb, 0x35, 0xac, 0xc6, 0x06, 0xe2, 0x8d, 0xfc, 0x20, 0x64,
+	0x5c, 0x33, 0x5f, 0xb1, 0xf3, 0x13, 0x8c, 0x9a, 0xe2, 0xb9, 0x78, 0xce, 0x08, 0xba, 0xe0, 0x53,
+	0x2f, 0x3e, 0x55, 0xad, 0x41, 0x7e, 0xce, 0xb2, 0x93, 0xc2, 0x91, 0x4d, 0x28, 0x8b, 0x54, 0x96,
+	0x45, 0xbc, 0x06, 0xb9, 0xbc, 0xe7, 0xa9, 0xe9, 0x28, 0x83, 0x5b, 0x09, 0x89, 0x1c, 0x7d, 0x81,
+	0x99, 0x98, 0xcb, 0xe4, 0xae, 0xb9, 0x8c, 0x0b, 0x2b, 0x46, 0x92, 0xf4, 0x1b, 0xb1, 0xc3, 0x5f,
+	0x59, 0x70, 0x4b, 0xc4, 0xaf, 0xec, 0x4a, 0xb3, 0x01, 0xcc, 0x9a, 0x13, 0xc0, 0xae, 0xea, 0x34,
+	0xe9, 0xdb, 0x33, 0x6f, 0x96, 0x36, 0x66, 0xe1, 0x51, 0xb8, 0xb4, 0xf0, 0x28, 0xbe, 0xa8, 0xf0,
+	0xa0, 0x7f, 0x6f, 0x41, 0x73, 0x76, 0xe7, 0xd1, 0x75, 0x8c, 0xe8, 0x3a, 0xa9, 0x63, 0xba, 0xf9,
+	0x94, 0xcf, 0x34, 0x9f, 0x9a, 0xb0, 0x28, 0x37, 0x2d, 0xcf, 0xa0, 0x40, 0x1c, 0x91, 0xb5, 0x8f,
+	0xec, 0xde, 0x2b, 0x90, 0xfe, 0x02, 0x5a, 0xa6, 0x8c, 0xe5, 0xdd, 0xfe, 0x1d, 0x09, 0x9b, 0xbe,
+	0x0d, 0x15, 0x15, 0x50, 0x78, 0x69, 0xa8, 0x22, 0x48, 0x24, 0xcb, 0xf8, 0x04, 0x41, 0x7f, 0x07,
+	0xe0, 0xc8, 0xe9, 0x5d, 0xcf, 0xdf, 0x2a, 0xea, 0xf5, 0x46, 0x59, 0x6d, 0xe6, 0x29, 0xc8, 0x49,
+	0x48, 0xd0, 0x60, 0x93, 0xd1, 0xdf, 0x8c, 0xc1, 0xc6, 0x50, 0xd3, 0x4b, 0x78, 0x2c, 0x22, 0xf7,
+	0xa0, 0x70, 0xe4, 0xf4, 0x54, 0xc0, 0xb9, 0x65, 0x9b, 0x83, 0x36, 0x8e, 0x88, 0x96, 0x03, 0x27,
+	0x6a, 0x7d, 0x0c, 0x15, 0x8d, 0xba, 0x51, 0x6b, 0xe0, 0x27, 0xf0, 0xea, 0xd6, 0x34, 0x3e, 0x0d,
+	0x42, 0x15, 0xca, 0x54, 0x96, 0x41, 0xa1, 0xd6, 0x8d, 0xd4, 0x10, 0xef, 0x99, 0xf0, 0x08, 0x63,
+	0xe2, 0xe8, 0xa6, 0xae, 0x55, 0x09, 0x14, 0x76, 0xf0, 0x85, 0x5b, 0x08, 0x82, 0x7f, 0xe3, 0xa2,
+	0xa2, 0x99, 0x21, 0x17, 0xe5, 0x00, 0xfd, 0x1b, 0x0b, 0x5e, 0x37, 0xec, 0xfa, 0x8b, 0x20, 0xbc,
+	0xf6, 0x6d, 0x48, 0x3e, 0x82, 0x02, 0x3e, 0xbd, 0x71, 0x86, 0x4b, 0x9b, 0xdf, 0xb3, 0xaf, 0xe0,
+	0x23, 0x34, 0xc8, 0xc9, 0xe9, 0x3b, 0xf2, 0x79, 0x6e, 0x11, 0xf2, 0x5b, 0xbd, 0x9e, 0x78, 0x9d,
+	0xeb, 0xee, 0xb5, 0xbb, 0x0f, 0xbb, 0xed, 0x23, 0x6c, 0x36, 0x25, 0xef, 0x6e, 0x39, 0xfa, 0x97,
+	0xfc, 0x47, 0x28, 0xbc, 0x24, 0xbc, 0x89, 0x01, 0x5f, 0xc7, 0xf5, 0xb0, 0x5f, 0x86, 0xe9, 0x58,
+	0x2c, 0x7a, 0xb7, 0x79, 0xd9, 0x2f, 0x4b, 0x50, 0x68, 0x38, 0x7e, 0xb0, 0x83, 0xef, 0x61, 0xca,
+	0xf9, 0x24, 0x48, 0xfb, 0x66, 0x3f, 0xeb, 0x3b, 0x8a, 0x05, 0xf4, 0xbf, 0x2c, 0x68, 0xca, 0xb3,
+	0x7e, 0xe7, 0xcc, 0x89, 0x6d, 0x5c, 0x0b, 0xf9, 0x3b, 0xf9, 0x4b, 0x62, 0x9e, 0xa6, 0xd1, 0x81,
+	0x69, 0x9b, 0x8d, 0x83, 0xa7, 0xf2, 0x4d, 0xc6, 0xc0, 0xcc, 0x4a, 0xaf, 0x78, 0xa5, 0xf4, 0x4a,
+	0x69, 0xe9, 0xfd, 0xb5, 0x4a, 0x68, 0x45, 0xd3, 0xee, 0x5a, 0x1a, 0x4d, 0x9c, 0x3b, 0x77, 0x99,
+	0x73, 0xe7, 0x33, 0xce, 0xad, 0x53, 0xd6, 0xc2, 0x15, 0x29, 0x6b, 0x31, 0x9d, 0xb2, 0xd2, 0x3f,
+	0xca, 0xa5, 0x95, 0xcb, 0x15, 0x92, 0x91, 0xb1, 0x35, 0x47, 0xc6, 0xab, 0x50, 0xe4, 0xef, 0x7e,
+	0x7c, 0x8b, 0x75, 0x47, 0x00, 0x22, 0x84, 0x23, 0x13, 0x15, 0xdf, 0x15, 0x88, 0x67, 0xc2, 0xee,
+	0x8b, 0xbc, 0x9f, 0xea, 0x8e, 0x84, 0xd0, 0x7b, 0x87, 0x81, 0xaf, 0xe2, 0x3a, 0xff, 0xc6, 0x48,
+	0xeb, 0xf9, 0x83, 0x50, 0x5c, 0x67, 0x22, 0xcb, 0x4e, 0x10, 0x38, 0x3a, 0x64, 0x6a, 0x54, 0xbc,
+	0x7e, 0x24, 0x08, 0x5d, 0xa1, 0x08, 0x71, 0xab, 0x4a, 0x52, 0x56, 0x28, 0x02, 0xe9, 0xa4, 0x28,
+	0xe8, 0x3f, 0xe6, 0xa0, 0x8a, 0x5a, 0xdc, 0x9f, 0xc6, 0x83, 0xe0, 0x3c, 0x5b, 0x72, 0xf4, 0xe7,
+	0x59, 0xdc, 0xf6, 0x7b, 0xcf, 0x9f, 0xad, 0xdf, 0xbb, 0xba, 0xb9, 0x83, 0xe6, 0x71, 0x1c, 0x08,
+	0xae, 0x74, 0x46, 0x7c, 0xfb, 0xa9, 0x4e, 0x22, 0x4f, 0x15, 0x6f, 0xce, 0xd2, 0x60, 0x41, 0x7e,
+	0x66, 0x54, 0x97, 0x85, 0x97, 0x63, 0xa7, 0x19, 0xa0, 0x52, 0x78, 0xe3, 0x5e, 0xfe, 0x40, 0x08,
+	0xbf, 0x79, 0xbf, 0xc4, 0x8d, 0xd0, 0xa5, 0x84, 0x46, 0x24, 0x44, 0xff, 0xdc, 0x82, 0x32, 0x8a,
+	0x0f, 0xbd, 0xea, 0xca, 0xa6, 0x9f, 0x62, 0x9a, 0x9b, 0xcb, 0x34, 0x6f, 0x32, 0x55, 0x1d, 0x11,
+	0x2f, 0x56, 0x4f, 0xa4, 0x0a, 0x44, 0xdb, 0x7c, 0x34, 0x76, 0xcf, 0x2e, 0x76, 0xe4, 0xb0, 0xd8,
+	0x62, 0x0a, 0x47, 0x0f, 0xa0, 0xa2, 0x76, 0x14, 0x5d, 0xcb, 0x98, 0xd7, 0x45, 0xf9, 0xad, 0x1e,
+	0x08, 0x2b, 0xb6, 0x9a, 0x2e, 0x2a, 0xef, 0x88, 0xfe, 0x08, 0x1a, 0x5b, 0xea, 0x6d, 0xe3, 0x06,
+	0xb1, 0x99, 0xfe, 0x01, 0x54, 0x7b, 0xc9, 0xa3, 0xc4, 0xb5, 0xf6, 0x72, 0x0f, 0x16, 0x99, 0x1f,
+	0xe3, 0x4d, 0x2b, 0x77, 0xb3, 0x62, 0x1b, 0x2c, 0x64, 0xaf, 0x5f, 0x52, 0xd0, 0x7f, 0xb0, 0xa0,
+	0x31, 0x3b, 0xca, 0x7b, 0xd8, 0xaa, 0x31, 0x22, 0xb5, 0x90, 0x20, 0xb8, 0x1a, 0x5c, 0xff, 0x4c,
+	0xab, 0xc1, 0xf5, 0xcf, 0x78, 0xf8, 0x8a, 0x0e, 0x58, 0xb8, 0x3f, 0xe1, 0x7a, 0xc8, 0x3b, 0x0a,
+	0xe4, 0x0f, 0x91, 0xe3, 0x71, 0x30, 0x90, 0xa3, 0x05, 0x3e, 0x6a, 0xa2, 0xf8, 0x53, 0xcd, 0x45,
+	0xcc, 0x24, 0x81, 0xe8, 0xc1, 0x1a, 0x18, 0xcc, 0x08, 0x82, 0xa7, 0xbe, 0x0c, 0x8b, 0xf8, 0x49,
+	0x7f, 0x0e, 0xcb, 0xaa, 0x0a, 0xbe, 0x66, 0xc4, 0x7f, 0x51, 0xc9, 0x4f, 0xff, 0xd6, 0x82, 0x55,
+	0xd9, 0x7e, 0x10, 0x9c, 0xaf, 0xc3, 0x38, 0xd5, 0x27, 0xc8, 0xcd, 0xf6, 0x09, 0x74, 0x4b, 0x26,
+	0x7f, 0x55, 0x4b, 0xc6, 0x6c, 0x96, 0x14, 0x66, 0x9a, 0x25, 0x73, 0x5f, 0x21, 0xe8, 0x47, 0xb0,
+	0xac, 0xfa, 0xc8, 0x37, 0xb1, 0xa4, 0x3f, 0xb1, 0x80, 0xec, 0xb8, 0xfe, 0x80, 0x8d, 0xb7, 0xcd,
+	0x04, 0xe1, 0xd7, 0xbd, 0x2a, 0x6f, 0xfc, 0xb6, 0x42, 0x1f, 0x40, 0x59, 0xb9, 0x82, 0x7e, 0xf6,
+	0xb6, 0x8c, 0x67, 0x6f, 0x6c, 0x08, 0x7a, 0x63, 0xd6, 0xf7, 0xbe, 0x11, 0xc9, 0x52, 0xde, 0xd1,
+	0x30, 0xfd, 0x21, 0x54, 0xd4, 0xdc, 0x88, 0xfc, 0x00, 0x2a, 0xfa, 0xbd, 0x50, 0x66, 0x9a, 0x15,
+	0x5b, 0x0d, 0x3b, 0xc9, 0x18, 0x7d, 0xac, 0x1e, 0xfd, 0xcd, 0x76, 0x04, 0x7f, 0x8a, 0x41, 0xa4,
+	0xce, 0xfd, 0x2a, 0x8e, 0x81, 0x49, 0xc6, 0x7f, 0x97, 0xb9, 0xa1, 0x34, 0x76, 0x03, 0x83, 0xca,
+	0xc7, 0xa3, 0xf6, 0xf8, 0xaf, 0x93, 0x45, 0xa9, 0x9e, 0x20, 0xe8, 0x11, 0xbc, 0xd2, 0x0b, 0xdc,
+	0xa1, 0xec, 0xd7, 0xba, 0xdf, 0x55, 0xd6, 0xf3, 0x7b, 0xb0, 0xc6, 0xf3, 0x85, 0x87, 0xee, 0xd8,
+	0x1b, 0xca, 0x9c, 0xf7, 0xc5, 0x9c, 0xef, 0x42, 0x3d, 0x0a, 0xc6, 0xd3, 0x58, 0xd4, 0x62, 0x93,
+	0x40, 0x26, 0xb5, 0x69, 0x24, 0xc6, 0xe1, 0xd5, 0xe4, 0x2a, 0x4f, 0x56, 0x98, 0xab, 0xa4, 0x35,
+	0x28, 0xb1, 0x30, 0x0c, 0xc2, 0x48, 0xbe, 0x3b, 0x4a, 0x88, 0xf0, 0x9f, 0xda, 0x46, 0x31, 0x7f,
+	0x75, 0xe4, 0xaf, 0xe0, 0x02, 0x4a, 0x4c, 0xb9, 0x60, 0x56, 0x9d, 0xe9, 0x82, 0xae, 0x38, 0x5b,
+	0xd0, 0xd1, 0x13, 0x58, 0x9e, 0x39, 0xae, 0xb1, 0xb0, 0x95, 0x5a, 0xf8, 0xe3, 0xf4, 0x23, 0xab,
+	0x88, 0x7c, 0xaf, 0xda, 0xf3, 0x0e, 0x94, 0x7e, 0x6e, 0x2d, 0x41, 0xe1, 0x61, 0xe0, 0x0d, 0x37,
+	0xff, 0x69, 0x15, 0x56, 0xb6, 0xa6, 0x71, 0xc0, 0x7d, 0x34, 0xec, 0xb3, 0xf0, 0x89, 0x37, 0x60,
+	0xe4, 0x35, 0x58, 0xdc, 0x65, 0x31, 0xff, 0x69, 0x7c, 0xd1, 0x46, 0xba, 0x96, 0xe8, 0x03, 0xd3,
+	0x05, 0xf2, 0x3a, 0x94, 0xe5, 0x50, 0xa4, 0xc6, 0x4a, 0x7c, 0x2c, 0xa2, 0x0b, 0xc4, 0xe6, 0x4d,
+	0x2d, 0x84, 0xb6, 0x2f, 0xe4, 0xcf, 0x72, 0x89, 0x9d, 0x31, 0xc2, 0x84, 0xd9, 0x1b, 0x00, 0x22,
+	0xfc, 0xc8, 0xa5, 0xf0, 0x4f, 0x4b, 0x70, 0xa5, 0x0b, 0xe4, 0x47, 0xf0, 0x8a, 0x59, 0xbb, 0xc8,
+	0x9f, 0x9d, 0xa9, 0x55, 0xd7, 0xec, 0xb9, 0x55, 0x10, 0x5d, 0x20, 0x6f, 0xf1, 0x2d, 0x8a, 0x9f,
+	0x68, 0x37, 0xec, 0x99, 0x2e, 0x5b, 0x4b, 0xfe, 0xc8, 0x8c, 0x2e, 0x90, 0x4d, 0xb8, 0xa5, 0x06,
+	0xb7, 0x2f, 0x70, 0xe9, 0x2d, 0x7f, 0x28, 0x77, 0x5d, 0xb7, 0x2f, 0x99, 0x63, 0xc3, 0x8a, 0x9a,
+	0x13, 0xe9, 0x33, 0x2e, 0xd9, 0xa9, 0x42, 0xa6, 0xb5, 0x28, 0xc8, 0x51, 0x22, 0xeb, 0x50, 0xe5,
+	0x3f, 0x34, 0x16, 0xbd, 0x20, 0x22, 0x19, 0x19, 0x0c, 0x6f, 0x43, 0x55, 0x88, 0x20, 0x4d, 0xa0,
+	0x85, 0xf0, 0x26, 0x54, 0xdb, 0x6c, 0xcc, 0xd4, 0xf8, 0xcc, 0xc6, 0x34, 0xd9, 0x5b, 0x50, 0xd9,
+	0x65, 0xf1, 0xa5, 0xfb, 0x11, 0x30, 0xdf, 0x0f, 0x68, 0x3a, 0xad, 0xc0, 0xb2, 0x1c, 0xc7, 0x0d,
+	0xff, 0x18, 0x1a, 0x09, 0x81, 0x10, 0x0b, 0x31, 0x7f, 0x49, 0x97, 0xea, 0x30, 0xa5, 0x66, 0x52,
+	0xa8, 0x89, 0xa3, 0xca, 0x5d, 0xa8, 0x55, 0xcd, 0xe5, 0xef, 0x40, 0x4d, 0x9c, 0x76, 0x96, 0x46,
+	0x1f, 0xc4, 0x86, 0x35, 0x93, 0xe2, 0xa1, 0x17, 0x79, 0x27, 0xde, 0x18, 0x9b, 0x63, 0xe6, 0x0f,
+	0xa2, 0x12, 0xfa, 0xf7, 0x61, 0x69, 0x97, 0xc5, 0xe6, 0x0f, 0x0f, 0x66, 0x4f, 0x5f, 0x33, 0xdc,
+	0x01, 0xf7, 0xf9, 0x09, 0xac, 0x88, 0x15, 0xae, 0x9a, 0x44, 0xb2, 0xbf, 0x1b, 0xa0, 0x0b, 0xe4,
+	0x53, 0xa8, 0x4b, 0x87, 0x62, 0xa2, 0x80, 0xb9, 0x65, 0xcf, 0x0f, 0x4c, 0xad, 0xc6, 0xec, 0x00,
+	0x5d, 0x20, 0x9f, 0xc3, 0xea, 0x2e, 0x8b, 0x93, 0x43, 0xbc, 0x58, 0xbc, 0x35, 0x63, 0x44, 0xac,
+	0xbf, 0x36, 0xcb, 0x41, 0xbb, 0x59, 0xa6, 0x7b, 0x99, 0x99, 0xbd, 0x01, 0x0d, 0xa1, 0xa0, 0x04,
+	0x7d, 0x89, 0x50, 0x37, 0xa0, 0x21, 0x44, 0xf4, 0x42, 0xca, 0x77, 0x95, 0x30, 0x8d, 0xa5, 0x32,
+	0xc2, 0xd4, 0xd4, 0x3f, 0xe4, 0xca, 0x32, 0xdf, 0x63, 0xcd, 0x0a, 0x33, 0xd9, 0xb7, 0x41, 0x41,
+	0x17, 0x48, 0x8f, 0x9f, 0xda, 0xc0, 0xe9, 0x53, 0xbf, 0x71, 0x55, 0x3f, 0xa1, 0xa5, 0x42, 0x4f,
+	0x9a, 0xdb, 0x47, 0xea, 0x6c, 0x09, 0x9a, 0x34, 0xed, 0x4b, 0xfa, 0x8e, 0xc9, 0xd6, 0x3f, 0x86,
+	0x95, 0x59, 0x9a, 0x88, 0xbc, 0x66, 0x5f, 0xd6, 0xf5, 0x4b, 0x26, 0x7e, 0x88, 0x7d, 0x28, 0x5e,
+	0x20, 0x1a, 0x0b, 0x2e, 0xdb, 0x12, 0xa7, 0xc8, 0xcd, 0x27, 0x68, 0xba, 0x40, 0xda, 0x7a, 0x52,
+	0x62, 0x86, 0xe4, 0x35, 0xfb, 0xb2, 0xd2, 0x3f, 0x65, 0xae, 0x92, 0x48, 0x1b, 0x5c, 0x66, 0x84,
+	0x10, 0xfb, 0xba, 0x1c, 0xde, 0x83, 0x1a, 0x72, 0xd0, 0xc9, 0xc7, 0x8a, 0x3d, 0x9b, 0xcf, 0xb7,
+	0x20, 0x41, 0x71, 0x77, 0xc4, 0x09, 0x49, 0x19, 0x31, 0x6f, 0x29, 0xd0, 0x75, 0x02, 0xce, 0xb8,
+	0x07, 0x55, 0x23, 0x41, 0x23, 0xaf, 0xd8, 0xd9, 0x74, 0xcd, 0x34, 0xb7, 0xea, 0x2e, 0x8b, 0xf5,
+	0x0f, 0x0a, 0x1a, 0xf6, 0x4c, 0x4e, 0xd8, 0xaa, 0x68, 0x8c, 0x36, 0x37, 0xb3, 0x92, 0x98, 0xb7,
+	0x9d, 0x9a, 0x59, 0x28, 0xf0, 0xf8, 0xb0, 0x2c, 0xdc, 0x24, 0x79, 0xf1, 0xcf, 0xbe, 0xa8, 0xb6,
+	0xb2, 0x28, 0xba, 0x40, 0xee, 0xc3, 0xb2, 0x30, 0x88, 0x2b, 0xa7, 0xea, 0xd3, 0xdc, 0x87, 0x65,
+	0x11, 0xdb, 0xaf, 0x47, 0xae, 0x37, 0x96, 0xbc, 0xce, 0x67, 0x7f, 0x10, 0xd0, 0xca, 0xa2, 0xcc,
+	0x8d, 0x5d, 0x39, 0x35, 0xbb, 0xb1, 0xeb, 0x91, 0xbf, 0xad, 0x22, 0xbf, 0x7a, 0x48, 0xb7, 0x53,
+	0x8f, 0x6f, 0x2d, 0xf5, 0xa0, 0x46, 0x17, 0xc8, 0x0f, 0xd4, 0x05, 0x70, 0x09, 0xa9, 0x71, 0x58,
+	0x34, 0xa4, 0xe4, 0x6d, 0xfa, 0x75, 0xfb, 0xf2, 0x86, 0x75, 0x0b, 0x6c, 0x8d, 0xe2, 0x57, 0x66,
+	0x5d, 0x6d, 0x47, 0x3c, 0x53, 0xeb, 0x17, 0xc2, 0x96, 0xfe, 0xd2, 0xb6, 0xa4, 0x1f, 0x16, 0x1b,
+	0xf6, 0x4c, 0x75, 0xd5, 0xaa, 0x68, 0x0c, 0xf7, 0x84, 0x7a, 0xaa, 0x52, 0x22, 0xaf, 0xda, 0xf3,
+	0x2a, 0x27, 0xd3, 0xef, 0x6b, 0x66, 0x2e, 0x4c, 0x56, 0xed, 0x39, 0xa9, 0x71, 0xab, 0x6a, 0x6f,
+	0x27, 0x8f, 0xf1, 0x0b, 0xe4, 0xfb, 0xfc, 0xd4, 0x49, 0xf3, 0x5c, 0x5e, 0xd0, 0x60, 0x6b, 0x14,
+	0xdf, 0x0a, 0x66, 0x59, 0xa9, 0x27, 0xb6, 0xaa, 0x9d, 0xbc, 0xcc, 0xb5, 0xd2, 0x2f, 0x5d, 0x7a,
+	0x42, 0xaa, 0x55, 0x5d, 0xb5, 0x93, 0xb6, 0x7b, 0xab, 0x9e, 0xea, 0x54, 0xd3, 0x05, 0xf2, 0x0e,
+	0x54, 0xbb, 0x51, 0xe7, 0x7c, 0x12, 0x5f, 0xe0, 0x00, 0x21, 0x76, 0xa6, 0x93, 0xae, 0xcf, 0xb9,
+	0x5d, 0xfb, 0xe7, 0x6f, 0x6f, 0x5b, 0xff, 0xf2, 0xed, 0x6d, 0xeb, 0x3f, 0xbf, 0xbd, 0x6d, 0x9d,
+	0x94, 0xf8, 0xff, 0xac, 0xfc, 0xf0, 0xff, 0x07, 0x00, 0x9d, 0xe3, 0x51, 0x83, 0x7b, 0x39, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AutograderServiceClient is the client API for AutograderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AutograderServiceClient interface {
+	GetUser(ctx context.Context, in *Void, opts ...grpc.CallOption) (*User, error)
+	GetUsers(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Users, error)
+	GetUserByCourse(ctx context.Context, in *CourseUserRequest, opts ...grpc.CallOption) (*User, error)
+	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*Void, error)
+	IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	GetGroupByUserAndCourse(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error)
+	GetGroupsByCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Groups, error)
+	CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Group, error)
+	UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Void, error)
+	DeleteGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Void, error)
+	GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error)
+	GetCourses(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Courses, error)
+	GetCoursesByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Courses, error)
+	CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error)
+	UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error)
+	UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
+	GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error)
+	UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*AssignmentChanges, error)
+	ValidateTests(ctx context.Context, in *TestsValidationRequest, opts ...grpc.CallOption) (*TestsValidation, error)
+	GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error)
+	GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error)
+	CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
+	UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
+	UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
+	// Get latest submissions for all course assignments for a user or a group.
+	GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error)
+	// Get lab submissions for every course user or every course group
+	GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error)
+	UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error)
+	UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error)
+	RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Rebuild the latest submissions for an assignment in the background.
+	RebuildAssignment(ctx context.Context, in *RebuildAssignmentRequest, opts ...grpc.CallOption) (*AssignmentRebuild, error)
+	// Get the progress of the latest rebuild of an assignment.
+	GetAssignmentRebuild(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentRebuild, error)
+	GetArtifacts(ctx context.Context, in *ArtifactsRequest, opts ...grpc.CallOption) (*Artifacts, error)
+	GetTestStats(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*TestStats, error)
+	// Cancel the running builds of an assignment for a user or group.
+	CancelBuild(ctx context.Context, in *CancelBuildRequest, opts ...grpc.CallOption) (*Void, error)
+	// Get the complete, untruncated output of the latest build of a submission.
+	GetBuildLog(ctx context.Context, in *BuildLogRequest, opts ...grpc.CallOption) (*BuildLog, error)
+	// Get the anonymized leaderboard of an assignment's benchmarks.
+	GetLeaderboard(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	// manual grading //
+	CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error)
+	UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
+	DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
+	CreateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*GradingCriterion, error)
+	UpdateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error)
+	DeleteCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error)
+	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Void, error)
+	GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error)
+	CreateRegrade(ctx context.Context, in *Regrade, opts ...grpc.CallOption) (*Regrade, error)
+	GetRegrades(ctx context.Context, in *RegradesRequest, opts ...grpc.CallOption) (*Regrades, error)
+	UpdateRegrade(ctx context.Context, in *UpdateRegradeRequest, opts ...grpc.CallOption) (*Void, error)
+	LoadCriteria(ctx context.Context, in *LoadCriteriaRequest, opts ...grpc.CallOption) (*Benchmarks, error)
+	GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error)
+	GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error)
+	GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error)
+	IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error)
+}
+
+type autograderServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAutograderServiceClient(cc *grpc.ClientConn) AutograderServiceClient {
+	return &autograderServiceClient{cc}
+}
+
+func (c *autograderServiceClient) GetUser(ctx context.Context, in *Void, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetUser", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetProviders(ctx, req.(*Void))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _AutograderService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OrgRequest)
-	if err := dec(in); err != nil {
+func (c *autograderServiceClient) GetUsers(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Users, error) {
+	out := new(Users)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetUsers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetOrganization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetOrganization",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetOrganization(ctx, req.(*OrgRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _AutograderService_GetRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(URLRequest)
-	if err := dec(in); err != nil {
+func (c *autograderServiceClient) GetUserByCourse(ctx context.Context, in *CourseUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetUserByCourse", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetRepositories(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/GetRepositories",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetRepositories(ctx, req.(*URLRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _AutograderService_IsEmptyRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepositoryRequest)
-	if err := dec(in); err != nil {
+func (c *autograderServiceClient) UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateUser", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/AutograderService/IsEmptyRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, req.(*RepositoryRequest))
+	return out, nil
+}
+
+func (c *autograderServiceClient) IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
+	out := new(AuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/AutograderService/IsAuthorizedTeacher", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _AutograderService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "AutograderService",
-	HandlerType: (*AutograderServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetUser",
-			Handler:    _AutograderService_GetUser_Handler,
-		},
-		{
-			MethodName: "GetUsers",
-			Handler:    _AutograderService_GetUsers_Handler,
-		},
-		{
-			MethodName: "GetUserByCourse",
-			Handler:    _AutograderService_GetUserByCourse_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _AutograderService_UpdateUser_Handler,
-		},
-		{
-			MethodName: "IsAuthorizedTeacher",
-			Handler:    _AutograderService_IsAuthorizedTeacher_Handler,
-		},
-		{
-			MethodName: "GetGroup",
-			Handler:    _AutograderService_GetGroup_Handler,
-		},
-		{
-			MethodName: "GetGroupByUserAndCourse",
-			Handler:    _AutograderService_GetGroupByUserAndCourse_Handler,
-		},
-		{
-			MethodName: "GetGroupsByCourse",
-			Handler:    _AutograderService_GetGroupsByCourse_Handler,
-		},
-		{
-			MethodName: "CreateGroup",
-			Handler:    _AutograderService_CreateGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroup",
-			Handler:    _AutograderService_UpdateGroup_Handler,
-		},
-		{
-			MethodName: "DeleteGroup",
-			Handler:    _AutograderService_DeleteGroup_Handler,
-		},
-		{
-			MethodName: "GetCourse",
-			Handler:    _AutograderService_GetCourse_Handler,
-		},
-		{
-			MethodName: "GetCourses",
-			Handler:    _AutograderService_GetCourses_Handler,
-		},
-		{
-			MethodName: "GetCoursesByUser",
-			Handler:    _AutograderService_GetCoursesByUser_Handler,
-		},
-		{
-			MethodName: "CreateCourse",
-			Handler:    _AutograderService_CreateCourse_Handler,
-		},
-		{
-			MethodName: "UpdateCourse",
-			Handler:    _AutograderService_UpdateCourse_Handler,
-		},
-		{
-			MethodName: "UpdateCourseVisibility",
-			Handler:    _AutograderService_UpdateCourseVisibility_Handler,
-		},
-		{
-			MethodName: "GetAssignments",
-			Handler:    _AutograderService_GetAssignments_Handler,
-		},
-		{
-			MethodName: "UpdateAssignments",
-			Handler:    _AutograderService_UpdateAssignments_Handler,
-		},
-		{
-			MethodName: "GetEnrollmentsByUser",
-			Handler:    _AutograderService_GetEnrollmentsByUser_Handler,
-		},
-		{
-			MethodName: "GetEnrollmentsByCourse",
-			Handler:    _AutograderService_GetEnrollmentsByCourse_Handler,
-		},
-		{
-			MethodName: "CreateEnrollment",
-			Handler:    _AutograderService_CreateEnrollment_Handler,
-		},
-		{
-			MethodName: "UpdateEnrollment",
-			Handler:    _AutograderService_UpdateEnrollment_Handler,
-		},
-		{
-			MethodName: "UpdateEnrollments",
-			Handler:    _AutograderService_UpdateEnrollments_Handler,
-		},
-		{
-			MethodName: "GetSubmissions",
-			Handler:    _AutograderService_GetSubmissions_Handler,
-		},
-		{
-			MethodName: "GetSubmissionsByCourse",
-			Handler:    _AutograderService_GetSubmissionsByCourse_Handler,
-		},
-		{
-			MethodName: "UpdateSubmission",
-			Handler:    _AutograderService_UpdateSubmission_Handler,
-		},
-		{
-			MethodName: "UpdateSubmissions",
-			Handler:    _AutograderService_UpdateSubmissions_Handler,
-		},
-		{
-			MethodName: "RebuildSubmission",
-			Handler:    _AutograderService_RebuildSubmission_Handler,
-		},
-		{
-			MethodName: "CreateBenchmark",
-			Handler:    _AutograderService_CreateBenchmark_Handler,
-		},
-		{
-			MethodName: "UpdateBenchmark",
-			Handler:    _AutograderService_UpdateBenchmark_Handler,
-		},
-		{
-			MethodName: "DeleteBenchmark",
-			Handler:    _AutograderService_DeleteBenchmark_Handler,
-		},
-		{
-			MethodName: "CreateCriterion",
-			Handler:    _AutograderService_CreateCriterion_Handler,
-		},
-		{
-			MethodName: "UpdateCriterion",
-			Handler:    _AutograderService_UpdateCriterion_Handler,
-		},
-		{
-			MethodName: "DeleteCriterion",
-			Handler:    _AutograderService_DeleteCriterion_Handler,
-		},
-		{
-			MethodName: "CreateReview",
-			Handler:    _AutograderService_CreateReview_Handler,
-		},
-		{
-			MethodName: "UpdateReview",
-			Handler:    _AutograderService_UpdateReview_Handler,
-		},
-		{
-			MethodName: "GetReviewers",
-			Handler:    _AutograderService_GetReviewers_Handler,
-		},
-		{
-			MethodName: "LoadCriteria",
-			Handler:    _AutograderService_LoadCriteria_Handler,
-		},
-		{
-			MethodName: "GetProviders",
-			Handler:    _AutograderService_GetProviders_Handler,
-		},
-		{
-			MethodName: "GetOrganization",
-			Handler:    _AutograderService_GetOrganization_Handler,
-		},
-		{
-			MethodName: "GetRepositories",
-			Handler:    _AutograderService_GetRepositories_Handler,
-		},
-		{
-			MethodName: "IsEmptyRepo",
-			Handler:    _AutograderService_IsEmptyRepo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ag.proto",
+func (c *autograderServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) GetGroupByUserAndCourse(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetGroupByUserAndCourse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) GetGroupsByCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Groups, error) {
+	out := new(Groups)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetGroupsByCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Enrollments) > 0 {
-		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+func (c *autograderServiceClient) CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RemoteIdentities) > 0 {
-		for iNdEx := len(m.RemoteIdentities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoteIdentities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Login) > 0 {
-		i -= len(m.Login)
-		copy(dAtA[i:], m.Login)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Login)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AvatarURL) > 0 {
-		i -= len(m.AvatarURL)
-		copy(dAtA[i:], m.AvatarURL)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.AvatarURL)))
-		i--
-		dAtA[i] = 0x32
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/DeleteGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x2a
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StudentID) > 0 {
-		i -= len(m.StudentID)
-		copy(dAtA[i:], m.StudentID)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.StudentID)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCourses(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Courses, error) {
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetCourses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCoursesByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Courses, error) {
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetCoursesByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.IsAdmin {
-		i--
-		if m.IsAdmin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Users) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateCourseVisibility", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Users) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error) {
+	out := new(Assignments)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Users) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *autograderServiceClient) UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*AssignmentChanges, error) {
+	out := new(AssignmentChanges)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ValidateTests(ctx context.Context, in *TestsValidationRequest, opts ...grpc.CallOption) (*TestsValidation, error) {
+	out := new(TestsValidation)
+	err := c.cc.Invoke(ctx, "/AutograderService/ValidateTests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoteIdentity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error) {
+	out := new(Enrollments)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetEnrollmentsByUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoteIdentity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error) {
+	out := new(Enrollments)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetEnrollmentsByCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoteIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *autograderServiceClient) CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x28
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateEnrollments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.RemoteID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.RemoteID))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error) {
+	out := new(Submissions)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error) {
+	out := new(CourseSubmissions)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetSubmissionsByCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Group) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateSubmissions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/AutograderService/RebuildSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *autograderServiceClient) RebuildAssignment(ctx context.Context, in *RebuildAssignmentRequest, opts ...grpc.CallOption) (*AssignmentRebuild, error) {
+	out := new(AssignmentRebuild)
+	err := c.cc.Invoke(ctx, "/AutograderService/RebuildAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Enrollments) > 0 {
-		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAssignmentRebuild(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentRebuild, error) {
+	out := new(AssignmentRebuild)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetAssignmentRebuild", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetArtifacts(ctx context.Context, in *ArtifactsRequest, opts ...grpc.CallOption) (*Artifacts, error) {
+	out := new(Artifacts)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetTestStats(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*TestStats, error) {
+	out := new(TestStats)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetTestStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TeamID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.TeamID))
-		i--
-		dAtA[i] = 0x20
+	return out, nil
+}
+
+func (c *autograderServiceClient) CancelBuild(ctx context.Context, in *CancelBuildRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/CancelBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetBuildLog(ctx context.Context, in *BuildLogRequest, opts ...grpc.CallOption) (*BuildLog, error) {
+	out := new(BuildLog)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetBuildLog", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetLeaderboard(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
+	out := new(GradingBenchmark)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Groups) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateBenchmark", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Groups) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/DeleteBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Groups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *autograderServiceClient) CreateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*GradingCriterion, error) {
+	out := new(GradingCriterion)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Course) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) DeleteCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/DeleteCriterion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Course) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Course) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *autograderServiceClient) UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error) {
+	out := new(Reviewers)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetReviewers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Assignments) > 0 {
-		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateRegrade(ctx context.Context, in *Regrade, opts ...grpc.CallOption) (*Regrade, error) {
+	out := new(Regrade)
+	err := c.cc.Invoke(ctx, "/AutograderService/CreateRegrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Enrollments) > 0 {
-		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetRegrades(ctx context.Context, in *RegradesRequest, opts ...grpc.CallOption) (*Regrades, error) {
+	out := new(Regrades)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetRegrades", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Enrolled != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Enrolled))
-		i--
-		dAtA[i] = 0x58
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateRegrade(ctx context.Context, in *UpdateRegradeRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/UpdateRegrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SlipDays != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.SlipDays))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.OrganizationPath) > 0 {
-		i -= len(m.OrganizationPath)
-		copy(dAtA[i:], m.OrganizationPath)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.OrganizationPath)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.OrganizationID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.OrganizationID))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Year != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Year))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CourseCreatorID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseCreatorID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Courses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) LoadCriteria(ctx context.Context, in *LoadCriteriaRequest, opts ...grpc.CallOption) (*Benchmarks, error) {
+	out := new(Benchmarks)
+	err := c.cc.Invoke(ctx, "/AutograderService/LoadCriteria", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Courses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *Courses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Courses) > 0 {
-		for iNdEx := len(m.Courses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Courses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *autograderServiceClient) GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error) {
+	out := new(Providers)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Repository) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetOrganization", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.RepoType != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.RepoType))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.HTMLURL) > 0 {
-		i -= len(m.HTMLURL)
-		copy(dAtA[i:], m.HTMLURL)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.HTMLURL)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RepositoryID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.RepositoryID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OrganizationID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.OrganizationID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+func (c *autograderServiceClient) GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error) {
+	out := new(Repositories)
+	err := c.cc.Invoke(ctx, "/AutograderService/GetRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Enrollment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *autograderServiceClient) IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/AutograderService/IsEmptyRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Enrollment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AutograderServiceServer is the server API for AutograderService service.
+type AutograderServiceServer interface {
+	GetUser(context.Context, *Void) (*User, error)
+	GetUsers(context.Context, *Void) (*Users, error)
+	GetUserByCourse(context.Context, *CourseUserRequest) (*User, error)
+	UpdateUser(context.Context, *User) (*Void, error)
+	IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
+	GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error)
+	GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error)
+	CreateGroup(context.Context, *Group) (*Group, error)
+	UpdateGroup(context.Context, *Group) (*Void, error)
+	DeleteGroup(context.Context, *GroupRequest) (*Void, error)
+	GetCourse(context.Context, *CourseRequest) (*Course, error)
+	GetCourses(context.Context, *Void) (*Courses, error)
+	GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error)
+	CreateCourse(context.Context, *Course) (*Course, error)
+	UpdateCourse(context.Context, *Course) (*Void, error)
+	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
+	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
+	UpdateAssignments(context.Context, *CourseRequest) (*AssignmentChanges, error)
+	ValidateTests(context.Context, *TestsValidationRequest) (*TestsValidation, error)
+	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
+	GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error)
+	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
+	UpdateEnrollment(context.Context, *Enrollment) (*Void, error)
+	UpdateEnrollments(context.Context, *CourseRequest) (*Void, error)
+	// Get latest submissions for all course assignments for a user or a group.
+	GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error)
+	// Get lab submissions for every course user or every course group
+	GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error)
+	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error)
+	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
+	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
+	// Rebuild the latest submissions for an assignment in the background.
+	RebuildAssignment(context.Context, *RebuildAssignmentRequest) (*AssignmentRebuild, error)
+	// Get the progress of the latest rebuild of an assignment.
+	GetAssignmentRebuild(context.Context, *AssignmentRequest) (*AssignmentRebuild, error)
+	GetArtifacts(context.Context, *ArtifactsRequest) (*Artifacts, error)
+	GetTestStats(context.Context, *AssignmentRequest) (*TestStats, error)
+	// Cancel the running builds of an assignment for a user or group.
+	CancelBuild(context.Context, *CancelBuildRequest) (*Void, error)
+	// Get the complete, untruncated output of the latest build of a submission.
+	GetBuildLog(context.Context, *BuildLogRequest) (*BuildLog, error)
+	// Get the anonymized leaderboard of an assignment's benchmarks.
+	GetLeaderboard(context.Context, *AssignmentRequest) (*Leaderboard, error)
+	// manual grading //
+	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
+	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
+	DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error)
+	CreateCriterion(context.Context, *GradingCriterion) (*GradingCriterion, error)
+	UpdateCriterion(context.Context, *GradingCriterion) (*Void, error)
+	DeleteCriterion(context.Context, *GradingCriterion) (*Void, error)
+	CreateReview(context.Context, *ReviewRequest) (*Review, error)
+	UpdateReview(context.Context, *ReviewRequest) (*Void, error)
+	GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error)
+	CreateRegrade(context.Context, *Regrade) (*Regrade, error)
+	GetRegrades(context.Context, *RegradesRequest) (*Regrades, error)
+	UpdateRegrade(context.Context, *UpdateRegradeRequest) (*Void, error)
+	LoadCriteria(context.Context, *LoadCriteriaRequest) (*Benchmarks, error)
+	GetProviders(context.Context, *Void) (*Providers, error)
+	GetOrganization(context.Context, *OrgRequest) (*Organization, error)
+	GetRepositories(context.Context, *URLRequest) (*Repositories, error)
+	IsEmptyRepo(context.Context, *RepositoryRequest) (*Void, error)
 }
 
-func (m *Enrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.UsedSlipDays) > 0 {
-		for iNdEx := len(m.UsedSlipDays) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UsedSlipDays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.TotalApproved != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.TotalApproved))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.LastActivityDate) > 0 {
-		i -= len(m.LastActivityDate)
-		copy(dAtA[i:], m.LastActivityDate)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.LastActivityDate)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.SlipDaysRemaining != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.SlipDaysRemaining))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.State != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Status != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Group != nil {
-		{
-			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Course != nil {
-		{
-			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.HasTeacherScopes {
-		i--
-		if m.HasTeacherScopes {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedAutograderServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAutograderServiceServer struct {
 }
 
-func (m *UsedSlipDays) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAutograderServiceServer) GetUser(ctx context.Context, req *Void) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
-
-func (m *UsedSlipDays) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAutograderServiceServer) GetUsers(ctx context.Context, req *Void) (*Users, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
 }
-
-func (m *UsedSlipDays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UsedSlipDays != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UsedSlipDays))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AssignmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.EnrollmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.EnrollmentID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAutograderServiceServer) GetUserByCourse(ctx context.Context, req *CourseUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByCourse not implemented")
 }
-
-func (m *Enrollments) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAutograderServiceServer) UpdateUser(ctx context.Context, req *User) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
-
-func (m *Enrollments) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAutograderServiceServer) IsAuthorizedTeacher(ctx context.Context, req *Void) (*AuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorizedTeacher not implemented")
 }
-
-func (m *Enrollments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Enrollments) > 0 {
-		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAutograderServiceServer) GetGroup(ctx context.Context, req *GetGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
 }
-
-func (m *SubmissionLink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAutograderServiceServer) GetGroupByUserAndCourse(ctx context.Context, req *GroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByUserAndCourse not implemented")
 }
-
-func (m *SubmissionLink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAutograderServiceServer) GetGroupsByCourse(ctx context.Context, req *CourseRequest) (*Groups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByCourse not implemented")
 }
-
-func (m *SubmissionLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Submission != nil {
-		{
-			size, err := m.Submission.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Assignment != nil {
-		{
-			size, err := m.Assignment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAutograderServiceServer) CreateGroup(ctx context.Context, req *Group) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
-
-func (m *EnrollmentLink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAutograderServiceServer) UpdateGroup(ctx context.Context, req *Group) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
 }
-
-func (m *EnrollmentLink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAutograderServiceServer) DeleteGroup(ctx context.Context, req *GroupRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
-
-func (m *EnrollmentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Submissions) > 0 {
-		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Enrollment != nil {
-		{
-			size, err := m.Enrollment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAutograderServiceServer) GetCourse(ctx context.Context, req *CourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
 }
-
-func (m *CourseSubmissions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAutograderServiceServer) GetCourses(ctx context.Context, req *Void) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetCoursesByUser(ctx context.Context, req *EnrollmentStatusRequest) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByUser not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CreateCourse(ctx context.Context, req *Course) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateCourse(ctx context.Context, req *Course) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateCourseVisibility(ctx context.Context, req *Enrollment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseVisibility not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetAssignments(ctx context.Context, req *CourseRequest) (*Assignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateAssignments(ctx context.Context, req *CourseRequest) (*AssignmentChanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignments not implemented")
+}
+func (*UnimplementedAutograderServiceServer) ValidateTests(ctx context.Context, req *TestsValidationRequest) (*TestsValidation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTests not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetEnrollmentsByUser(ctx context.Context, req *EnrollmentStatusRequest) (*Enrollments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByUser not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetEnrollmentsByCourse(ctx context.Context, req *EnrollmentRequest) (*Enrollments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByCourse not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CreateEnrollment(ctx context.Context, req *Enrollment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollment not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateEnrollment(ctx context.Context, req *Enrollment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollment not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateEnrollments(ctx context.Context, req *CourseRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollments not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetSubmissions(ctx context.Context, req *SubmissionRequest) (*Submissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissions not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetSubmissionsByCourse(ctx context.Context, req *SubmissionsForCourseRequest) (*CourseSubmissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionsByCourse not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateSubmission(ctx context.Context, req *UpdateSubmissionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmission not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateSubmissions(ctx context.Context, req *UpdateSubmissionsRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmissions not implemented")
+}
+func (*UnimplementedAutograderServiceServer) RebuildSubmission(ctx context.Context, req *RebuildRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmission not implemented")
+}
+func (*UnimplementedAutograderServiceServer) RebuildAssignment(ctx context.Context, req *RebuildAssignmentRequest) (*AssignmentRebuild, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildAssignment not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetAssignmentRebuild(ctx context.Context, req *AssignmentRequest) (*AssignmentRebuild, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentRebuild not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetArtifacts(ctx context.Context, req *ArtifactsRequest) (*Artifacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifacts not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetTestStats(ctx context.Context, req *AssignmentRequest) (*TestStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestStats not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CancelBuild(ctx context.Context, req *CancelBuildRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuild not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetBuildLog(ctx context.Context, req *BuildLogRequest) (*BuildLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildLog not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetLeaderboard(ctx context.Context, req *AssignmentRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CreateBenchmark(ctx context.Context, req *GradingBenchmark) (*GradingBenchmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateBenchmark(ctx context.Context, req *GradingBenchmark) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBenchmark not implemented")
+}
+func (*UnimplementedAutograderServiceServer) DeleteBenchmark(ctx context.Context, req *GradingBenchmark) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBenchmark not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CreateCriterion(ctx context.Context, req *GradingCriterion) (*GradingCriterion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCriterion not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateCriterion(ctx context.Context, req *GradingCriterion) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCriterion not implemented")
+}
+func (*UnimplementedAutograderServiceServer) DeleteCriterion(ctx context.Context, req *GradingCriterion) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCriterion not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CreateReview(ctx context.Context, req *ReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateReview(ctx context.Context, req *ReviewRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetReviewers(ctx context.Context, req *SubmissionReviewersRequest) (*Reviewers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewers not implemented")
+}
+func (*UnimplementedAutograderServiceServer) CreateRegrade(ctx context.Context, req *Regrade) (*Regrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRegrade not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetRegrades(ctx context.Context, req *RegradesRequest) (*Regrades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegrades not implemented")
+}
+func (*UnimplementedAutograderServiceServer) UpdateRegrade(ctx context.Context, req *UpdateRegradeRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegrade not implemented")
+}
+func (*UnimplementedAutograderServiceServer) LoadCriteria(ctx context.Context, req *LoadCriteriaRequest) (*Benchmarks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadCriteria not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetProviders(ctx context.Context, req *Void) (*Providers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviders not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetOrganization(ctx context.Context, req *OrgRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (*UnimplementedAutograderServiceServer) GetRepositories(ctx context.Context, req *URLRequest) (*Repositories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositories not implemented")
+}
+func (*UnimplementedAutograderServiceServer) IsEmptyRepo(ctx context.Context, req *RepositoryRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsEmptyRepo not implemented")
 }
 
-func (m *CourseSubmissions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterAutograderServiceServer(s *grpc.Server, srv AutograderServiceServer) {
+	s.RegisterService(&_AutograderService_serviceDesc, srv)
 }
 
-func (m *CourseSubmissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Links) > 0 {
-		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUser(ctx, in)
 	}
-	if m.Course != nil {
-		{
-			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetUser",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUser(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Assignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUsers(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Assignment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_GetUserByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUserByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetUserByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUserByCourse(ctx, req.(*CourseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Assignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ContainerTimeout != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ContainerTimeout))
-		i--
-		dAtA[i] = 0x70
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateUser(ctx, in)
 	}
-	if len(m.GradingBenchmarks) > 0 {
-		for iNdEx := len(m.GradingBenchmarks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GradingBenchmarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateUser",
 	}
-	if len(m.Submissions) > 0 {
-		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateUser(ctx, req.(*User))
 	}
-	if m.SkipTests {
-		i--
-		if m.SkipTests {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_IsAuthorizedTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Reviewers != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Reviewers))
-		i--
-		dAtA[i] = 0x50
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, in)
 	}
-	if m.ScoreLimit != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ScoreLimit))
-		i--
-		dAtA[i] = 0x48
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/IsAuthorizedTeacher",
 	}
-	if m.IsGroupLab {
-		i--
-		if m.IsGroupLab {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, req.(*Void))
 	}
-	if m.Order != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x38
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AutoApprove {
-		i--
-		if m.AutoApprove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroup(ctx, in)
 	}
-	if len(m.Deadline) > 0 {
-		i -= len(m.Deadline)
-		copy(dAtA[i:], m.Deadline)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Deadline)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetGroup",
 	}
-	if len(m.ScriptFile) > 0 {
-		i -= len(m.ScriptFile)
-		copy(dAtA[i:], m.ScriptFile)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.ScriptFile)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroupByUserAndCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, in)
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetGroupByUserAndCourse",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Assignments) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetGroupsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetGroupsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Assignments) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateGroup(ctx, req.(*Group))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Assignments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Assignments) > 0 {
-		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateGroup(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateGroup(ctx, req.(*Group))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Submission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Submission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourse(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Submission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Reviews) > 0 {
-		for iNdEx := len(m.Reviews) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+func _AutograderService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ApprovedDate) > 0 {
-		i -= len(m.ApprovedDate)
-		copy(dAtA[i:], m.ApprovedDate)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.ApprovedDate)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourses(ctx, in)
 	}
-	if m.Status != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetCourses",
 	}
-	if m.Released {
-		i--
-		if m.Released {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourses(ctx, req.(*Void))
 	}
-	if len(m.CommitHash) > 0 {
-		i -= len(m.CommitHash)
-		copy(dAtA[i:], m.CommitHash)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.CommitHash)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCoursesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BuildInfo) > 0 {
-		i -= len(m.BuildInfo)
-		copy(dAtA[i:], m.BuildInfo)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.BuildInfo)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, in)
 	}
-	if len(m.ScoreObjects) > 0 {
-		i -= len(m.ScoreObjects)
-		copy(dAtA[i:], m.ScoreObjects)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.ScoreObjects)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetCoursesByUser",
 	}
-	if m.Score != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, req.(*EnrollmentStatusRequest))
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Course)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateCourse(ctx, in)
 	}
-	if m.AssignmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateCourse",
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateCourse(ctx, req.(*Course))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Submissions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Course)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Submissions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Submissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCourse(ctx, in)
 	}
-	if len(m.Submissions) > 0 {
-		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateCourse",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourse(ctx, req.(*Course))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GradingBenchmark) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GradingBenchmark) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GradingBenchmark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, in)
 	}
-	if len(m.Criteria) > 0 {
-		for iNdEx := len(m.Criteria) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Criteria[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateCourseVisibility",
 	}
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, req.(*Enrollment))
 	}
-	if len(m.Heading) > 0 {
-		i -= len(m.Heading)
-		copy(dAtA[i:], m.Heading)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Heading)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AssignmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetAssignments(ctx, in)
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetAssignments",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Benchmarks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Benchmarks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Benchmarks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateAssignments(ctx, in)
 	}
-	if len(m.Benchmarks) > 0 {
-		for iNdEx := len(m.Benchmarks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Benchmarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateAssignments",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GradingCriterion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_ValidateTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestsValidationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GradingCriterion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GradingCriterion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ValidateTests(ctx, in)
 	}
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/ValidateTests",
 	}
-	if m.Grade != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Grade))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ValidateTests(ctx, req.(*TestsValidationRequest))
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BenchmarkID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.BenchmarkID))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, in)
 	}
-	if m.Points != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Points))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetEnrollmentsByUser",
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, req.(*EnrollmentStatusRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Review) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetEnrollmentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetEnrollmentsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, req.(*EnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Review) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateEnrollment(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Review) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Benchmarks) > 0 {
-		for iNdEx := len(m.Benchmarks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Benchmarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+func _AutograderService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Score != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, in)
 	}
-	if m.Ready {
-		i--
-		if m.Ready {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateEnrollment",
 	}
-	if len(m.Feedback) > 0 {
-		i -= len(m.Feedback)
-		copy(dAtA[i:], m.Feedback)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Feedback)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, req.(*Enrollment))
 	}
-	if len(m.Review) > 0 {
-		i -= len(m.Review)
-		copy(dAtA[i:], m.Review)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Review)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ReviewerID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ReviewerID))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, in)
 	}
-	if m.SubmissionID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateEnrollments",
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, req.(*CourseRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Reviewers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissions(ctx, req.(*SubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Reviewers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Reviewers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_GetSubmissionsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionsForCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Reviewers) > 0 {
-		for iNdEx := len(m.Reviewers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reviewers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetSubmissionsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, req.(*SubmissionsForCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReviewRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReviewRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSubmission(ctx, in)
 	}
-	if m.Review != nil {
-		{
-			size, err := m.Review.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAg(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateSubmission",
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CourseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CourseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, req.(*UpdateSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CourseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RebuildSubmission(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/RebuildSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RebuildSubmission(ctx, req.(*RebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_RebuildAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildAssignmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RebuildAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/RebuildAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RebuildAssignment(ctx, req.(*RebuildAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_GetAssignmentRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetAssignmentRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetAssignmentRebuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetAssignmentRebuild(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_GetArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetArtifacts(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetArtifacts(ctx, req.(*ArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetGroupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetTestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetTestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetTestStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetTestStats(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetGroupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_CancelBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CancelBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CancelBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CancelBuild(ctx, req.(*CancelBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_GetBuildLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetBuildLog(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetBuildLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetBuildLog(ctx, req.(*BuildLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GroupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetLeaderboard(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GroupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateBenchmark(ctx, in)
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateBenchmark",
 	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateBenchmark(ctx, req.(*GradingBenchmark))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Provider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, req.(*GradingBenchmark))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_DeleteBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/DeleteBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, req.(*GradingBenchmark))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OrgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateCriterion(ctx, req.(*GradingCriterion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OrgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_UpdateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCriterion(ctx, req.(*GradingCriterion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OrgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OrgName) > 0 {
-		i -= len(m.OrgName)
-		copy(dAtA[i:], m.OrgName)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.OrgName)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteCriterion(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/DeleteCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteCriterion(ctx, req.(*GradingCriterion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Organization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _AutograderService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Organization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PaymentPlan) > 0 {
-		i -= len(m.PaymentPlan)
-		copy(dAtA[i:], m.PaymentPlan)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.PaymentPlan)))
-		i--
-		dAtA[i] = 0x22
+func _AutograderService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Avatar) > 0 {
-		i -= len(m.Avatar)
-		copy(dAtA[i:], m.Avatar)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Avatar)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetReviewers(ctx, in)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetReviewers",
 	}
-	if m.ID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetReviewers(ctx, req.(*SubmissionReviewersRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Organizations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_CreateRegrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Regrade)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateRegrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/CreateRegrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateRegrade(ctx, req.(*Regrade))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Organizations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Organizations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_GetRegrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Organizations) > 0 {
-		for iNdEx := len(m.Organizations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Organizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAg(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetRegrades(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetRegrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetRegrades(ctx, req.(*RegradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EnrollmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_UpdateRegrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRegradeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EnrollmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateRegrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/UpdateRegrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateRegrade(ctx, req.(*UpdateRegradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EnrollmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Statuses) > 0 {
-		dAtA10 := make([]byte, len(m.Statuses)*10)
-		var j9 int
-		for _, num := range m.Statuses {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintAg(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x22
+func _AutograderService_LoadCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.WithActivity {
-		i--
-		if m.WithActivity {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).LoadCriteria(ctx, in)
 	}
-	if m.IgnoreGroupMembers {
-		i--
-		if m.IgnoreGroupMembers {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/LoadCriteria",
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).LoadCriteria(ctx, req.(*LoadCriteriaRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EnrollmentStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EnrollmentStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EnrollmentStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetProviders(ctx, in)
 	}
-	if len(m.Statuses) > 0 {
-		dAtA12 := make([]byte, len(m.Statuses)*10)
-		var j11 int
-		for _, num := range m.Statuses {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintAg(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetProviders",
 	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetProviders(ctx, req.(*Void))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubmissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetOrganization(ctx, req.(*OrgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AutograderService_GetRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(URLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetRepositories(ctx, in)
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/GetRepositories",
 	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetRepositories(ctx, req.(*URLRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateSubmissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AutograderService_IsEmptyRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AutograderService/IsEmptyRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, req.(*RepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Released {
-		i--
-		if m.Released {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Score != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubmissionID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var _AutograderService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "AutograderService",
+	HandlerType: (*AutograderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _AutograderService_GetUser_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _AutograderService_GetUsers_Handler,
+		},
+		{
+			MethodName: "GetUserByCourse",
+			Handler:    _AutograderService_GetUserByCourse_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _AutograderService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "IsAuthorizedTeacher",
+			Handler:    _AutograderService_IsAuthorizedTeacher_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _AutograderService_GetGroup_Handler,
+		},
+		{
+			MethodName: "GetGroupByUserAndCourse",
+			Handler:    _AutograderService_GetGroupByUserAndCourse_Handler,
+		},
+		{
+			MethodName: "GetGroupsByCourse",
+			Handler:    _AutograderService_GetGroupsByCourse_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _AutograderService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _AutograderService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _AutograderService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "GetCourse",
+			Handler:    _AutograderService_GetCourse_Handler,
+		},
+		{
+			MethodName: "GetCourses",
+			Handler:    _AutograderService_GetCourses_Handler,
+		},
+		{
+			MethodName: "GetCoursesByUser",
+			Handler:    _AutograderService_GetCoursesByUser_Handler,
+		},
+		{
+			MethodName: "CreateCourse",
+			Handler:    _AutograderService_CreateCourse_Handler,
+		},
+		{
+			MethodName: "UpdateCourse",
+			Handler:    _AutograderService_UpdateCourse_Handler,
+		},
+		{
+			MethodName: "UpdateCourseVisibility",
+			Handler:    _AutograderService_UpdateCourseVisibility_Handler,
+		},
+		{
+			MethodName: "GetAssignments",
+			Handler:    _AutograderService_GetAssignments_Handler,
+		},
+		{
+			MethodName: "UpdateAssignments",
+			Handler:    _AutograderService_UpdateAssignments_Handler,
+		},
+		{
+			MethodName: "ValidateTests",
+			Handler:    _AutograderService_ValidateTests_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentsByUser",
+			Handler:    _AutograderService_GetEnrollmentsByUser_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentsByCourse",
+			Handler:    _AutograderService_GetEnrollmentsByCourse_Handler,
+		},
+		{
+			MethodName: "CreateEnrollment",
+			Handler:    _AutograderService_CreateEnrollment_Handler,
+		},
+		{
+			MethodName: "UpdateEnrollment",
+			Handler:    _AutograderService_UpdateEnrollment_Handler,
+		},
+		{
+			MethodName: "UpdateEnrollments",
+			Handler:    _AutograderService_UpdateEnrollments_Handler,
+		},
+		{
+			MethodName: "GetSubmissions",
+			Handler:    _AutograderService_GetSubmissions_Handler,
+		},
+		{
+			MethodName: "GetSubmissionsByCourse",
+			Handler:    _AutograderService_GetSubmissionsByCourse_Handler,
+		},
+		{
+			MethodName: "UpdateSubmission",
+			Handler:    _AutograderService_UpdateSubmission_Handler,
+		},
+		{
+			MethodName: "UpdateSubmissions",
+			Handler:    _AutograderService_UpdateSubmissions_Handler,
+		},
+		{
+			MethodName: "RebuildSubmission",
+			Handler:    _AutograderService_RebuildSubmission_Handler,
+		},
+		{
+			MethodName: "RebuildAssignment",
+			Handler:    _AutograderService_RebuildAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignmentRebuild",
+			Handler:    _AutograderService_GetAssignmentRebuild_Handler,
+		},
+		{
+			MethodName: "GetArtifacts",
+			Handler:    _AutograderService_GetArtifacts_Handler,
+		},
+		{
+			MethodName: "GetTestStats",
+			Handler:    _AutograderService_GetTestStats_Handler,
+		},
+		{
+			MethodName: "CancelBuild",
+			Handler:    _AutograderService_CancelBuild_Handler,
+		},
+		{
+			MethodName: "GetBuildLog",
+			Handler:    _AutograderService_GetBuildLog_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _AutograderService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "CreateBenchmark",
+			Handler:    _AutograderService_CreateBenchmark_Handler,
+		},
+		{
+			MethodName: "UpdateBenchmark",
+			Handler:    _AutograderService_UpdateBenchmark_Handler,
+		},
+		{
+			MethodName: "DeleteBenchmark",
+			Handler:    _AutograderService_DeleteBenchmark_Handler,
+		},
+		{
+			MethodName: "CreateCriterion",
+			Handler:    _AutograderService_CreateCriterion_Handler,
+		},
+		{
+			MethodName: "UpdateCriterion",
+			Handler:    _AutograderService_UpdateCriterion_Handler,
+		},
+		{
+			MethodName: "DeleteCriterion",
+			Handler:    _AutograderService_DeleteCriterion_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _AutograderService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _AutograderService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "GetReviewers",
+			Handler:    _AutograderService_GetReviewers_Handler,
+		},
+		{
+			MethodName: "CreateRegrade",
+			Handler:    _AutograderService_CreateRegrade_Handler,
+		},
+		{
+			MethodName: "GetRegrades",
+			Handler:    _AutograderService_GetRegrades_Handler,
+		},
+		{
+			MethodName: "UpdateRegrade",
+			Handler:    _AutograderService_UpdateRegrade_Handler,
+		},
+		{
+			MethodName: "LoadCriteria",
+			Handler:    _AutograderService_LoadCriteria_Handler,
+		},
+		{
+			MethodName: "GetProviders",
+			Handler:    _AutograderService_GetProviders_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _AutograderService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "GetRepositories",
+			Handler:    _AutograderService_GetRepositories_Handler,
+		},
+		{
+			MethodName: "IsEmptyRepo",
+			Handler:    _AutograderService_IsEmptyRepo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ag.proto",
 }
 
-func (m *UpdateSubmissionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *User) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7541,12 +7979,12 @@ func (m *UpdateSubmissionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateSubmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7555,45 +7993,88 @@ func (m *UpdateSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Approve {
-		i--
-		if m.Approve {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Release {
-		i--
-		if m.Release {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RemoteIdentities) > 0 {
+		for iNdEx := len(m.RemoteIdentities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteIdentities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
+	}
+	if len(m.Login) > 0 {
+		i -= len(m.Login)
+		copy(dAtA[i:], m.Login)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Login)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x3a
 	}
-	if m.ScoreLimit != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.ScoreLimit))
+	if len(m.AvatarURL) > 0 {
+		i -= len(m.AvatarURL)
+		copy(dAtA[i:], m.AvatarURL)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.AvatarURL)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x32
 	}
-	if m.AssignmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StudentID) > 0 {
+		i -= len(m.StudentID)
+		copy(dAtA[i:], m.StudentID)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.StudentID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsAdmin {
+		i--
+		if m.IsAdmin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubmissionReviewersRequest) Marshal() (dAtA []byte, err error) {
+func (m *Users) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7603,12 +8084,12 @@ func (m *SubmissionReviewersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubmissionReviewersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Users) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubmissionReviewersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Users) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7617,20 +8098,24 @@ func (m *SubmissionReviewersRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SubmissionID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Providers) Marshal() (dAtA []byte, err error) {
+func (m *RemoteIdentity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7640,12 +8125,12 @@ func (m *Providers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Providers) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoteIdentity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Providers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoteIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7654,19 +8139,39 @@ func (m *Providers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Providers[iNdEx])
-			copy(dAtA[i:], m.Providers[iNdEx])
-			i = encodeVarintAg(dAtA, i, uint64(len(m.Providers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemoteID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.RemoteID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *URLRequest) Marshal() (dAtA []byte, err error) {
+func (m *Group) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7676,12 +8181,12 @@ func (m *URLRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *URLRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *URLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7690,33 +8195,65 @@ func (m *URLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RepoTypes) > 0 {
-		dAtA14 := make([]byte, len(m.RepoTypes)*10)
-		var j13 int
-		for _, num := range m.RepoTypes {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			i--
+			dAtA[i] = 0x3a
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintAg(dAtA, i, uint64(j13))
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
+	}
+	if m.TeamID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.TeamID))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.CourseID != 0 {
 		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
 		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryRequest) Marshal() (dAtA []byte, err error) {
+func (m *Groups) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7726,12 +8263,12 @@ func (m *RepositoryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Groups) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepositoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Groups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7740,25 +8277,24 @@ func (m *RepositoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.UserID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Repositories) Marshal() (dAtA []byte, err error) {
+func (m *Course) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7768,12 +8304,12 @@ func (m *Repositories) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Repositories) MarshalTo(dAtA []byte) (int, error) {
+func (m *Course) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Repositories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Course) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7782,29 +8318,154 @@ func (m *Repositories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.URLs) > 0 {
-		for k := range m.URLs {
-			v := m.URLs[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintAg(dAtA, i, uint64(len(v)))
+	if len(m.DockerImageError) > 0 {
+		i -= len(m.DockerImageError)
+		copy(dAtA[i:], m.DockerImageError)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.DockerImageError)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DockerImage) > 0 {
+		i -= len(m.DockerImage)
+		copy(dAtA[i:], m.DockerImage)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.DockerImage)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.PushAssignments {
+		i--
+		if m.PushAssignments {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.TemplateBranch) > 0 {
+		i -= len(m.TemplateBranch)
+		copy(dAtA[i:], m.TemplateBranch)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.TemplateBranch)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAg(dAtA, i, uint64(len(k)))
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAg(dAtA, i, uint64(baseI-i))
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x62
 		}
 	}
+	if m.Enrolled != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Enrolled))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SlipDays != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SlipDays))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.OrganizationPath) > 0 {
+		i -= len(m.OrganizationPath)
+		copy(dAtA[i:], m.OrganizationPath)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.OrganizationPath)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OrganizationID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.OrganizationID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Year != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CourseCreatorID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseCreatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AuthorizationResponse) Marshal() (dAtA []byte, err error) {
+func (m *Courses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7814,12 +8475,12 @@ func (m *AuthorizationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Courses) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Courses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7828,20 +8489,24 @@ func (m *AuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsAuthorized {
-		i--
-		if m.IsAuthorized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Courses) > 0 {
+		for iNdEx := len(m.Courses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Courses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
+func (m *Repository) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7851,12 +8516,12 @@ func (m *Status) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7865,59 +8530,47 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.Error)))
+	if m.RepoType != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.RepoType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x38
 	}
-	if m.Code != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Code))
+	if len(m.HTMLURL) > 0 {
+		i -= len(m.HTMLURL)
+		copy(dAtA[i:], m.HTMLURL)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.HTMLURL)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SubmissionsForCourseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SubmissionsForCourseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubmissionsForCourseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Type != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.Type))
+	if m.RepositoryID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.RepositoryID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrganizationID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.OrganizationID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RebuildRequest) Marshal() (dAtA []byte, err error) {
+func (m *Enrollment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7927,12 +8580,12 @@ func (m *RebuildRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RebuildRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Enrollment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RebuildRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Enrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7941,20 +8594,117 @@ func (m *RebuildRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AssignmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
-		i--
-		dAtA[i] = 0x10
+	if len(m.UsedSlipDays) > 0 {
+		for iNdEx := len(m.UsedSlipDays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedSlipDays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if m.SubmissionID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+	if m.TotalApproved != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.TotalApproved))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x68
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.LastActivityDate) > 0 {
+		i -= len(m.LastActivityDate)
+		copy(dAtA[i:], m.LastActivityDate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.LastActivityDate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SlipDaysRemaining != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SlipDaysRemaining))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.State != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Group != nil {
+		{
+			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Course != nil {
+		{
+			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HasTeacherScopes {
+		i--
+		if m.HasTeacherScopes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *CourseUserRequest) Marshal() (dAtA []byte, err error) {
+func (m *UsedSlipDays) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7964,12 +8714,12 @@ func (m *CourseUserRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CourseUserRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UsedSlipDays) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CourseUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UsedSlipDays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7978,29 +8728,30 @@ func (m *CourseUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.UserLogin) > 0 {
-		i -= len(m.UserLogin)
-		copy(dAtA[i:], m.UserLogin)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.UserLogin)))
+	if m.UsedSlipDays != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UsedSlipDays))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if m.CourseYear != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseYear))
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EnrollmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.EnrollmentID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.CourseCode) > 0 {
-		i -= len(m.CourseCode)
-		copy(dAtA[i:], m.CourseCode)
-		i = encodeVarintAg(dAtA, i, uint64(len(m.CourseCode)))
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LoadCriteriaRequest) Marshal() (dAtA []byte, err error) {
+func (m *Enrollments) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8010,12 +8761,12 @@ func (m *LoadCriteriaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LoadCriteriaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Enrollments) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LoadCriteriaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Enrollments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8024,20 +8775,24 @@ func (m *LoadCriteriaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AssignmentID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CourseID != 0 {
-		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Void) Marshal() (dAtA []byte, err error) {
+func (m *SubmissionLink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8047,12 +8802,12 @@ func (m *Void) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Void) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubmissionLink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubmissionLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8061,1273 +8816,9337 @@ func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Submission != nil {
+		{
+			size, err := m.Submission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Assignment != nil {
+		{
+			size, err := m.Assignment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAg(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAg(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EnrollmentLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EnrollmentLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnrollmentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsAdmin {
-		n += 2
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.Enrollment != nil {
+		{
+			size, err := m.Enrollment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.StudentID)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CourseSubmissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	l = len(m.AvatarURL)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	l = len(m.Login)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if len(m.RemoteIdentities) > 0 {
-		for _, e := range m.RemoteIdentities {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if len(m.Enrollments) > 0 {
-		for _, e := range m.Enrollments {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Users) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *CourseSubmissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoteIdentity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CourseSubmissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.RemoteID != 0 {
-		n += 1 + sovAg(uint64(m.RemoteID))
-	}
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Group) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.TeamID != 0 {
-		n += 1 + sovAg(uint64(m.TeamID))
-	}
-	if m.Status != 0 {
-		n += 1 + sovAg(uint64(m.Status))
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Enrollments) > 0 {
-		for _, e := range m.Enrollments {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if m.Course != nil {
+		{
+			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Groups) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Assignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Course) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Assignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Assignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
-	}
-	if m.CourseCreatorID != 0 {
-		n += 1 + sovAg(uint64(m.CourseCreatorID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.Leaderboard {
+		i--
+		if m.Leaderboard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Benchmarks) > 0 {
+		i -= len(m.Benchmarks)
+		copy(dAtA[i:], m.Benchmarks)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Benchmarks)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if m.Year != 0 {
-		n += 1 + sovAg(uint64(m.Year))
+	if len(m.RunnerLabels) > 0 {
+		i -= len(m.RunnerLabels)
+		copy(dAtA[i:], m.RunnerLabels)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.RunnerLabels)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.Reruns != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Reruns))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.ArtifactsDir) > 0 {
+		i -= len(m.ArtifactsDir)
+		copy(dAtA[i:], m.ArtifactsDir)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ArtifactsDir)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.OrganizationID != 0 {
-		n += 1 + sovAg(uint64(m.OrganizationID))
+	if len(m.Coverage) > 0 {
+		i -= len(m.Coverage)
+		copy(dAtA[i:], m.Coverage)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Coverage)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	l = len(m.OrganizationPath)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Analyzers) > 0 {
+		i -= len(m.Analyzers)
+		copy(dAtA[i:], m.Analyzers)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Analyzers)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.SlipDays != 0 {
-		n += 1 + sovAg(uint64(m.SlipDays))
+	if len(m.ScriptTemplate) > 0 {
+		i -= len(m.ScriptTemplate)
+		copy(dAtA[i:], m.ScriptTemplate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ScriptTemplate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.Enrolled != 0 {
-		n += 1 + sovAg(uint64(m.Enrolled))
+	if len(m.TestsCommit) > 0 {
+		i -= len(m.TestsCommit)
+		copy(dAtA[i:], m.TestsCommit)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.TestsCommit)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if len(m.Enrollments) > 0 {
-		for _, e := range m.Enrollments {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if len(m.Assignments) > 0 {
-		for _, e := range m.Assignments {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
+	if m.PrerequisitePolicy != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.PrerequisitePolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if len(m.Prerequisites) > 0 {
+		i -= len(m.Prerequisites)
+		copy(dAtA[i:], m.Prerequisites)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Prerequisites)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ReleaseDate) > 0 {
+		i -= len(m.ReleaseDate)
+		copy(dAtA[i:], m.ReleaseDate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ReleaseDate)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
-}
-
-func (m *Courses) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ContainerTimeout != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ContainerTimeout))
+		i--
+		dAtA[i] = 0x70
 	}
-	var l int
-	_ = l
-	if len(m.Courses) > 0 {
-		for _, e := range m.Courses {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if len(m.GradingBenchmarks) > 0 {
+		for iNdEx := len(m.GradingBenchmarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GradingBenchmarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	return n
-}
-
-func (m *Repository) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SkipTests {
+		i--
+		if m.SkipTests {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.Reviewers != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Reviewers))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.OrganizationID != 0 {
-		n += 1 + sovAg(uint64(m.OrganizationID))
+	if m.ScoreLimit != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ScoreLimit))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.RepositoryID != 0 {
-		n += 1 + sovAg(uint64(m.RepositoryID))
+	if m.IsGroupLab {
+		i--
+		if m.IsGroupLab {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
+	if m.Order != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
+	if m.AutoApprove {
+		i--
+		if m.AutoApprove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.HTMLURL)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Deadline) > 0 {
+		i -= len(m.Deadline)
+		copy(dAtA[i:], m.Deadline)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Deadline)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.RepoType != 0 {
-		n += 1 + sovAg(uint64(m.RepoType))
+	if len(m.ScriptFile) > 0 {
+		i -= len(m.ScriptFile)
+		copy(dAtA[i:], m.ScriptFile)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ScriptFile)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Enrollment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Assignments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Assignments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Assignments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
-	}
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
-	}
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
-	}
-	if m.HasTeacherScopes {
-		n += 2
-	}
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.Course != nil {
-		l = m.Course.Size()
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.Group != nil {
-		l = m.Group.Size()
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovAg(uint64(m.Status))
-	}
-	if m.State != 0 {
-		n += 1 + sovAg(uint64(m.State))
-	}
-	if m.SlipDaysRemaining != 0 {
-		n += 1 + sovAg(uint64(m.SlipDaysRemaining))
-	}
-	l = len(m.LastActivityDate)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.TotalApproved != 0 {
-		n += 1 + sovAg(uint64(m.TotalApproved))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.UsedSlipDays) > 0 {
-		for _, e := range m.UsedSlipDays {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UsedSlipDays) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignmentChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EnrollmentID != 0 {
-		n += 1 + sovAg(uint64(m.EnrollmentID))
+	if len(m.ImageError) > 0 {
+		i -= len(m.ImageError)
+		copy(dAtA[i:], m.ImageError)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ImageError)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.AssignmentID != 0 {
-		n += 1 + sovAg(uint64(m.AssignmentID))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.UsedSlipDays != 0 {
-		n += 1 + sovAg(uint64(m.UsedSlipDays))
+	if len(m.Archived) > 0 {
+		for iNdEx := len(m.Archived) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Archived[iNdEx])
+			copy(dAtA[i:], m.Archived[iNdEx])
+			i = encodeVarintAg(dAtA, i, uint64(len(m.Archived[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Enrollments) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Renamed) > 0 {
+		for k := range m.Renamed {
+			v := m.Renamed[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAg(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAg(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAg(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Enrollments) > 0 {
-		for _, e := range m.Enrollments {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if len(m.Updated) > 0 {
+		for iNdEx := len(m.Updated) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Updated[iNdEx])
+			copy(dAtA[i:], m.Updated[iNdEx])
+			i = encodeVarintAg(dAtA, i, uint64(len(m.Updated[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintAg(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubmissionLink) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Submission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Submission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Assignment != nil {
-		l = m.Assignment.Size()
-		n += 1 + l + sovAg(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Submission != nil {
-		l = m.Submission.Size()
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Tests) > 0 {
+		for iNdEx := len(m.Tests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Reviews) > 0 {
+		for iNdEx := len(m.Reviews) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	return n
-}
-
-func (m *EnrollmentLink) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ApprovedDate) > 0 {
+		i -= len(m.ApprovedDate)
+		copy(dAtA[i:], m.ApprovedDate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ApprovedDate)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	if m.Enrollment != nil {
-		l = m.Enrollment.Size()
-		n += 1 + l + sovAg(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.Submissions) > 0 {
-		for _, e := range m.Submissions {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *CourseSubmissions) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BuildInfo) > 0 {
+		i -= len(m.BuildInfo)
+		copy(dAtA[i:], m.BuildInfo)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.BuildInfo)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.Course != nil {
-		l = m.Course.Size()
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.ScoreObjects) > 0 {
+		i -= len(m.ScoreObjects)
+		copy(dAtA[i:], m.ScoreObjects)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ScoreObjects)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Links) > 0 {
-		for _, e := range m.Links {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
+	if m.Score != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Assignment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TestResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TestResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
-	}
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ScriptFile)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Actual) > 0 {
+		i -= len(m.Actual)
+		copy(dAtA[i:], m.Actual)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Actual)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Deadline)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Expected) > 0 {
+		i -= len(m.Expected)
+		copy(dAtA[i:], m.Expected)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Expected)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.AutoApprove {
-		n += 2
+	if len(m.Failure) > 0 {
+		i -= len(m.Failure)
+		copy(dAtA[i:], m.Failure)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Failure)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Order != 0 {
-		n += 1 + sovAg(uint64(m.Order))
+	if m.Duration != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.IsGroupLab {
-		n += 2
+	if m.Weight != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ScoreLimit != 0 {
-		n += 1 + sovAg(uint64(m.ScoreLimit))
+	if m.MaxScore != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.MaxScore))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Reviewers != 0 {
-		n += 1 + sovAg(uint64(m.Reviewers))
+	if m.Score != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SkipTests {
-		n += 2
+	if len(m.TestName) > 0 {
+		i -= len(m.TestName)
+		copy(dAtA[i:], m.TestName)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.TestName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Submissions) > 0 {
-		for _, e := range m.Submissions {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if len(m.GradingBenchmarks) > 0 {
-		for _, e := range m.GradingBenchmarks {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if m.ContainerTimeout != 0 {
-		n += 1 + sovAg(uint64(m.ContainerTimeout))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Assignments) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Assignments) > 0 {
-		for _, e := range m.Assignments {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *BuildLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Submission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BuildLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
-	}
-	if m.AssignmentID != 0 {
-		n += 1 + sovAg(uint64(m.AssignmentID))
-	}
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
-	}
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
-	}
-	if m.Score != 0 {
-		n += 1 + sovAg(uint64(m.Score))
-	}
-	l = len(m.ScoreObjects)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	l = len(m.BuildInfo)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	l = len(m.CommitHash)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.Released {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovAg(uint64(m.Status))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ApprovedDate)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Reviews) > 0 {
-		for _, e := range m.Reviews {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Submissions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Submissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Submissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Submissions) > 0 {
-		for _, e := range m.Submissions {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GradingBenchmark) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GradingBenchmark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GradingBenchmark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GradingBenchmark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AssignmentID != 0 {
-		n += 1 + sovAg(uint64(m.AssignmentID))
+	if len(m.Criteria) > 0 {
+		for iNdEx := len(m.Criteria) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Criteria[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Heading)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Heading) > 0 {
+		i -= len(m.Heading)
+		copy(dAtA[i:], m.Heading)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Heading)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Criteria) > 0 {
-		for _, e := range m.Criteria {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Benchmarks) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Benchmarks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Benchmarks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Benchmarks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Benchmarks) > 0 {
-		for _, e := range m.Benchmarks {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *GradingCriterion) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Benchmarks) > 0 {
+		for iNdEx := len(m.Benchmarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Benchmarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GradingCriterion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GradingCriterion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GradingCriterion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Points != 0 {
-		n += 1 + sovAg(uint64(m.Points))
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.BenchmarkID != 0 {
-		n += 1 + sovAg(uint64(m.BenchmarkID))
+	if m.Grade != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Grade))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Grade != 0 {
-		n += 1 + sovAg(uint64(m.Grade))
+	if m.BenchmarkID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.BenchmarkID))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.Points != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Review) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Review) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Review) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Review) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SubmissionID != 0 {
-		n += 1 + sovAg(uint64(m.SubmissionID))
+	if len(m.Benchmarks) > 0 {
+		for iNdEx := len(m.Benchmarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Benchmarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.ReviewerID != 0 {
-		n += 1 + sovAg(uint64(m.ReviewerID))
+	if m.Score != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Review)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Feedback)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Feedback) > 0 {
+		i -= len(m.Feedback)
+		copy(dAtA[i:], m.Feedback)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Feedback)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Ready {
-		n += 2
+	if len(m.Review) > 0 {
+		i -= len(m.Review)
+		copy(dAtA[i:], m.Review)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Review)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Score != 0 {
-		n += 1 + sovAg(uint64(m.Score))
+	if m.ReviewerID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ReviewerID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Benchmarks) > 0 {
-		for _, e := range m.Benchmarks {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Reviewers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Reviewers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Reviewers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reviewers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Reviewers) > 0 {
-		for _, e := range m.Reviewers {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
+		for iNdEx := len(m.Reviewers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reviewers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReviewRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Regrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Regrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Regrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.Review != nil {
-		l = m.Review.Size()
-		n += 1 + l + sovAg(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *CourseRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ScoreUpdates) > 0 {
+		for iNdEx := len(m.ScoreUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScoreUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	return n
-}
-
-func (m *UserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
+	if len(m.ResolvedDate) > 0 {
+		i -= len(m.ResolvedDate)
+		copy(dAtA[i:], m.ResolvedDate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.ResolvedDate)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ResolverID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ResolverID))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *GetGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CreatedDate) > 0 {
+		i -= len(m.CreatedDate)
+		copy(dAtA[i:], m.CreatedDate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.CreatedDate)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
+	if m.State != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TestName) > 0 {
+		i -= len(m.TestName)
+		copy(dAtA[i:], m.TestName)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.TestName)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *GroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
 	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
-	}
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Provider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScoreUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OrgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrgName)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ScoreUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Organization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScoreUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAg(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.UpdatedDate) > 0 {
+		i -= len(m.UpdatedDate)
+		copy(dAtA[i:], m.UpdatedDate)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.UpdatedDate)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Avatar)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.NewScore != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.NewScore))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.PaymentPlan)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if m.OldScore != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.OldScore))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UpdatedByID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UpdatedByID))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *Organizations) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RegradeID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.RegradeID))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.Organizations) > 0 {
-		for _, e := range m.Organizations {
-			l = e.Size()
-			n += 1 + l + sovAg(uint64(l))
-		}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EnrollmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Regrades) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Regrades) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Regrades) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.IgnoreGroupMembers {
-		n += 2
-	}
-	if m.WithActivity {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Statuses) > 0 {
-		l = 0
-		for _, e := range m.Statuses {
-			l += sovAg(uint64(e))
+	if len(m.Regrades) > 0 {
+		for iNdEx := len(m.Regrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Regrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 1 + sovAg(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EnrollmentStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Statuses) > 0 {
-		l = 0
-		for _, e := range m.Statuses {
-			l += sovAg(uint64(e))
+	if m.Review != nil {
+		{
+			size, err := m.Review.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAg(dAtA, i, uint64(size))
 		}
-		n += 1 + sovAg(uint64(l)) + l
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubmissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CourseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CourseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CourseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
-	}
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateSubmissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SubmissionID != 0 {
-		n += 1 + sovAg(uint64(m.SubmissionID))
-	}
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.Score != 0 {
-		n += 1 + sovAg(uint64(m.Score))
-	}
-	if m.Released {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovAg(uint64(m.Status))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateSubmissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.AssignmentID != 0 {
-		n += 1 + sovAg(uint64(m.AssignmentID))
-	}
-	if m.ScoreLimit != 0 {
-		n += 1 + sovAg(uint64(m.ScoreLimit))
-	}
-	if m.Release {
-		n += 2
-	}
-	if m.Approve {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubmissionReviewersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SubmissionID != 0 {
-		n += 1 + sovAg(uint64(m.SubmissionID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Providers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Provider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Providers) > 0 {
-		for _, s := range m.Providers {
-			l = len(s)
-			n += 1 + l + sovAg(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *URLRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RepoTypes) > 0 {
-		l = 0
-		for _, e := range m.RepoTypes {
-			l += sovAg(uint64(e))
-		}
-		n += 1 + sovAg(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.OrgName) > 0 {
+		i -= len(m.OrgName)
+		copy(dAtA[i:], m.OrgName)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.OrgName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Organization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Organization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UserID != 0 {
-		n += 1 + sovAg(uint64(m.UserID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovAg(uint64(m.GroupID))
+	if len(m.PaymentPlan) > 0 {
+		i -= len(m.PaymentPlan)
+		copy(dAtA[i:], m.PaymentPlan)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.PaymentPlan)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
+	if len(m.Avatar) > 0 {
+		i -= len(m.Avatar)
+		copy(dAtA[i:], m.Avatar)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Avatar)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Repositories) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.URLs) > 0 {
-		for k, v := range m.URLs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAg(uint64(len(k))) + 1 + len(v) + sovAg(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAg(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Organizations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsAuthorized {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Organizations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Organizations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovAg(uint64(m.Code))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Organizations) > 0 {
+		for iNdEx := len(m.Organizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Organizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubmissionsForCourseRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EnrollmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EnrollmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnrollmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
-	}
-	if m.Type != 0 {
-		n += 1 + sovAg(uint64(m.Type))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *RebuildRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Statuses) > 0 {
+		dAtA10 := make([]byte, len(m.Statuses)*10)
+		var j9 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintAg(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.SubmissionID != 0 {
-		n += 1 + sovAg(uint64(m.SubmissionID))
+	if m.WithActivity {
+		i--
+		if m.WithActivity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.AssignmentID != 0 {
-		n += 1 + sovAg(uint64(m.AssignmentID))
+	if m.IgnoreGroupMembers {
+		i--
+		if m.IgnoreGroupMembers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CourseUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EnrollmentStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EnrollmentStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnrollmentStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CourseCode)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
-	}
-	if m.CourseYear != 0 {
-		n += 1 + sovAg(uint64(m.CourseYear))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.UserLogin)
-	if l > 0 {
-		n += 1 + l + sovAg(uint64(l))
+	if len(m.Statuses) > 0 {
+		dAtA12 := make([]byte, len(m.Statuses)*10)
+		var j11 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintAg(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LoadCriteriaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Score != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CourseID != 0 {
-		n += 1 + sovAg(uint64(m.CourseID))
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSubmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSubmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Release {
+		i--
+		if m.Release {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScoreLimit != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ScoreLimit))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.AssignmentID != 0 {
-		n += 1 + sovAg(uint64(m.AssignmentID))
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionReviewersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionReviewersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionReviewersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Providers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Providers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Providers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintAg(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *URLRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *URLRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *URLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RepoTypes) > 0 {
+		dAtA14 := make([]byte, len(m.RepoTypes)*10)
+		var j13 int
+		for _, num := range m.RepoTypes {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintAg(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Repositories) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Repositories) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Repositories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.URLs) > 0 {
+		for k := range m.URLs {
+			v := m.URLs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAg(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAg(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAg(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsAuthorized {
+		i--
+		if m.IsAuthorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionsForCourseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionsForCourseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionsForCourseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Type != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NoCache {
+		i--
+		if m.NoCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LatestTests {
+		i--
+		if m.LatestTests {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildAssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildAssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildAssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NoCache {
+		i--
+		if m.NoCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LatestTests {
+		i--
+		if m.LatestTests {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScoreBelow != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ScoreBelow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Statuses) > 0 {
+		dAtA16 := make([]byte, len(m.Statuses)*10)
+		var j15 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintAg(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoreChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NewScore != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.NewScore))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OldScore != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.OldScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentRebuild) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRebuild) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentRebuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ScoreChanges) > 0 {
+		for iNdEx := len(m.ScoreChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScoreChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Decreased != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Decreased))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Increased != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Increased))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Rebuilt != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Rebuilt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Passes != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Passes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Runs != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TestName) > 0 {
+		i -= len(m.TestName)
+		copy(dAtA[i:], m.TestName)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.TestName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestStat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FlakyCommits != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.FlakyCommits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Commits != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Commits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Passes != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Passes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Runs != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TestName) > 0 {
+		i -= len(m.TestName)
+		copy(dAtA[i:], m.TestName)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.TestName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Leaderboard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Leaderboard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Leaderboard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaderboardEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaderboardEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaderboardEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Own {
+		i--
+		if m.Own {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BytesPerOp != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.BytesPerOp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AllocsPerOp != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AllocsPerOp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NsPerOp != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.NsPerOp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rank != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Benchmark) > 0 {
+		i -= len(m.Benchmark)
+		copy(dAtA[i:], m.Benchmark)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Benchmark)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRegradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRegradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRegradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Score != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RegradeID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.RegradeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubmissionID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.SubmissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelBuildRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelBuildRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelBuildRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.UserID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Artifacts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Artifacts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Artifacts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CourseUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CourseUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CourseUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserLogin) > 0 {
+		i -= len(m.UserLogin)
+		copy(dAtA[i:], m.UserLogin)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.UserLogin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CourseYear != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CourseCode) > 0 {
+		i -= len(m.CourseCode)
+		copy(dAtA[i:], m.CourseCode)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.CourseCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoadCriteriaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadCriteriaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadCriteriaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AssignmentID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.AssignmentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestsValidationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestsValidationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestsValidationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SolutionsRepo) > 0 {
+		i -= len(m.SolutionsRepo)
+		copy(dAtA[i:], m.SolutionsRepo)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.SolutionsRepo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CourseID != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.CourseID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ScoreLimit != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.ScoreLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Score != 0 {
+		i = encodeVarintAg(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Tested {
+		i--
+		if m.Tested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintAg(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestsValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestsValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestsValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintAg(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Void) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Void) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.IsAdmin {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.StudentID)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.AvatarURL)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Login)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.RemoteIdentities) > 0 {
+		for _, e := range m.RemoteIdentities {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Users) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoteIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.RemoteID != 0 {
+		n += 1 + sovAg(uint64(m.RemoteID))
+	}
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Group) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.TeamID != 0 {
+		n += 1 + sovAg(uint64(m.TeamID))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAg(uint64(m.Status))
+	}
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Groups) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Course) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.CourseCreatorID != 0 {
+		n += 1 + sovAg(uint64(m.CourseCreatorID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Year != 0 {
+		n += 1 + sovAg(uint64(m.Year))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.OrganizationID != 0 {
+		n += 1 + sovAg(uint64(m.OrganizationID))
+	}
+	l = len(m.OrganizationPath)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.SlipDays != 0 {
+		n += 1 + sovAg(uint64(m.SlipDays))
+	}
+	if m.Enrolled != 0 {
+		n += 1 + sovAg(uint64(m.Enrolled))
+	}
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	l = len(m.TemplateBranch)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.PushAssignments {
+		n += 3
+	}
+	l = len(m.DockerImage)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	l = len(m.DockerImageError)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Courses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Courses) > 0 {
+		for _, e := range m.Courses {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Repository) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.OrganizationID != 0 {
+		n += 1 + sovAg(uint64(m.OrganizationID))
+	}
+	if m.RepositoryID != 0 {
+		n += 1 + sovAg(uint64(m.RepositoryID))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	l = len(m.HTMLURL)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.RepoType != 0 {
+		n += 1 + sovAg(uint64(m.RepoType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Enrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.HasTeacherScopes {
+		n += 2
+	}
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Course != nil {
+		l = m.Course.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Group != nil {
+		l = m.Group.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAg(uint64(m.Status))
+	}
+	if m.State != 0 {
+		n += 1 + sovAg(uint64(m.State))
+	}
+	if m.SlipDaysRemaining != 0 {
+		n += 1 + sovAg(uint64(m.SlipDaysRemaining))
+	}
+	l = len(m.LastActivityDate)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.TotalApproved != 0 {
+		n += 1 + sovAg(uint64(m.TotalApproved))
+	}
+	if len(m.UsedSlipDays) > 0 {
+		for _, e := range m.UsedSlipDays {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsedSlipDays) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.EnrollmentID != 0 {
+		n += 1 + sovAg(uint64(m.EnrollmentID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.UsedSlipDays != 0 {
+		n += 1 + sovAg(uint64(m.UsedSlipDays))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Enrollments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmissionLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Assignment != nil {
+		l = m.Assignment.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Submission != nil {
+		l = m.Submission.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnrollmentLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enrollment != nil {
+		l = m.Enrollment.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CourseSubmissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Course != nil {
+		l = m.Course.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Assignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.ScriptFile)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Deadline)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.AutoApprove {
+		n += 2
+	}
+	if m.Order != 0 {
+		n += 1 + sovAg(uint64(m.Order))
+	}
+	if m.IsGroupLab {
+		n += 2
+	}
+	if m.ScoreLimit != 0 {
+		n += 1 + sovAg(uint64(m.ScoreLimit))
+	}
+	if m.Reviewers != 0 {
+		n += 1 + sovAg(uint64(m.Reviewers))
+	}
+	if m.SkipTests {
+		n += 2
+	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.GradingBenchmarks) > 0 {
+		for _, e := range m.GradingBenchmarks {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.ContainerTimeout != 0 {
+		n += 1 + sovAg(uint64(m.ContainerTimeout))
+	}
+	l = len(m.ReleaseDate)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Hidden {
+		n += 3
+	}
+	l = len(m.Prerequisites)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	if m.PrerequisitePolicy != 0 {
+		n += 2 + sovAg(uint64(m.PrerequisitePolicy))
+	}
+	if m.Archived {
+		n += 3
+	}
+	l = len(m.TestsCommit)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	l = len(m.ScriptTemplate)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	l = len(m.Analyzers)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	l = len(m.Coverage)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	l = len(m.ArtifactsDir)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	if m.Reruns != 0 {
+		n += 2 + sovAg(uint64(m.Reruns))
+	}
+	l = len(m.RunnerLabels)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	l = len(m.Benchmarks)
+	if l > 0 {
+		n += 2 + l + sovAg(uint64(l))
+	}
+	if m.Leaderboard {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Assignments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Updated) > 0 {
+		for _, s := range m.Updated {
+			l = len(s)
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Renamed) > 0 {
+		for k, v := range m.Renamed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAg(uint64(len(k))) + 1 + len(v) + sovAg(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAg(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Archived) > 0 {
+		for _, s := range m.Archived {
+			l = len(s)
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.ImageError)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Submission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.Score != 0 {
+		n += 1 + sovAg(uint64(m.Score))
+	}
+	l = len(m.ScoreObjects)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.BuildInfo)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Released {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovAg(uint64(m.Status))
+	}
+	l = len(m.ApprovedDate)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.Reviews) > 0 {
+		for _, e := range m.Reviews {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Tests) > 0 {
+		for _, e := range m.Tests {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TestResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TestName)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovAg(uint64(m.Score))
+	}
+	if m.MaxScore != 0 {
+		n += 1 + sovAg(uint64(m.MaxScore))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovAg(uint64(m.Weight))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovAg(uint64(m.Duration))
+	}
+	l = len(m.Failure)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Expected)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Actual)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BuildLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Submissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GradingBenchmark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	l = len(m.Heading)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.Criteria) > 0 {
+		for _, e := range m.Criteria {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Benchmarks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Benchmarks) > 0 {
+		for _, e := range m.Benchmarks {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GradingCriterion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.Points != 0 {
+		n += 1 + sovAg(uint64(m.Points))
+	}
+	if m.BenchmarkID != 0 {
+		n += 1 + sovAg(uint64(m.BenchmarkID))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Grade != 0 {
+		n += 1 + sovAg(uint64(m.Grade))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Review) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.ReviewerID != 0 {
+		n += 1 + sovAg(uint64(m.ReviewerID))
+	}
+	l = len(m.Review)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Feedback)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Ready {
+		n += 2
+	}
+	if m.Score != 0 {
+		n += 1 + sovAg(uint64(m.Score))
+	}
+	if len(m.Benchmarks) > 0 {
+		for _, e := range m.Benchmarks {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Reviewers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reviewers) > 0 {
+		for _, e := range m.Reviewers {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Regrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.TestName)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovAg(uint64(m.State))
+	}
+	l = len(m.CreatedDate)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.ResolverID != 0 {
+		n += 1 + sovAg(uint64(m.ResolverID))
+	}
+	l = len(m.ResolvedDate)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.ScoreUpdates) > 0 {
+		for _, e := range m.ScoreUpdates {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScoreUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.RegradeID != 0 {
+		n += 1 + sovAg(uint64(m.RegradeID))
+	}
+	if m.UpdatedByID != 0 {
+		n += 1 + sovAg(uint64(m.UpdatedByID))
+	}
+	if m.OldScore != 0 {
+		n += 1 + sovAg(uint64(m.OldScore))
+	}
+	if m.NewScore != 0 {
+		n += 1 + sovAg(uint64(m.NewScore))
+	}
+	l = len(m.UpdatedDate)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Regrades) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Regrades) > 0 {
+		for _, e := range m.Regrades {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.Review != nil {
+		l = m.Review.Size()
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CourseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Provider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrgName)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Organization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.Avatar)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.PaymentPlan)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Organizations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Organizations) > 0 {
+		for _, e := range m.Organizations {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnrollmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.IgnoreGroupMembers {
+		n += 2
+	}
+	if m.WithActivity {
+		n += 2
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovAg(uint64(e))
+		}
+		n += 1 + sovAg(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnrollmentStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovAg(uint64(e))
+		}
+		n += 1 + sovAg(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.Score != 0 {
+		n += 1 + sovAg(uint64(m.Score))
+	}
+	if m.Released {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovAg(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateSubmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.ScoreLimit != 0 {
+		n += 1 + sovAg(uint64(m.ScoreLimit))
+	}
+	if m.Release {
+		n += 2
+	}
+	if m.Approve {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmissionReviewersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Providers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *URLRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if len(m.RepoTypes) > 0 {
+		l = 0
+		for _, e := range m.RepoTypes {
+			l += sovAg(uint64(e))
+		}
+		n += 1 + sovAg(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Repositories) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.URLs) > 0 {
+		for k, v := range m.URLs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAg(uint64(len(k))) + 1 + len(v) + sovAg(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAg(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsAuthorized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovAg(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmissionsForCourseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAg(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RebuildRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.LatestTests {
+		n += 2
+	}
+	if m.NoCache {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RebuildAssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovAg(uint64(e))
+		}
+		n += 1 + sovAg(uint64(l)) + l
+	}
+	if m.ScoreBelow != 0 {
+		n += 1 + sovAg(uint64(m.ScoreBelow))
+	}
+	if m.LatestTests {
+		n += 2
+	}
+	if m.NoCache {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScoreChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.OldScore != 0 {
+		n += 1 + sovAg(uint64(m.OldScore))
+	}
+	if m.NewScore != 0 {
+		n += 1 + sovAg(uint64(m.NewScore))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRebuild) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.Total != 0 {
+		n += 1 + sovAg(uint64(m.Total))
+	}
+	if m.Rebuilt != 0 {
+		n += 1 + sovAg(uint64(m.Rebuilt))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovAg(uint64(m.Failed))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.Increased != 0 {
+		n += 1 + sovAg(uint64(m.Increased))
+	}
+	if m.Decreased != 0 {
+		n += 1 + sovAg(uint64(m.Decreased))
+	}
+	if len(m.ScoreChanges) > 0 {
+		for _, e := range m.ScoreChanges {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TestOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAg(uint64(m.ID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	l = len(m.TestName)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovAg(uint64(m.Runs))
+	}
+	if m.Passes != 0 {
+		n += 1 + sovAg(uint64(m.Passes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TestStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TestName)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovAg(uint64(m.Runs))
+	}
+	if m.Passes != 0 {
+		n += 1 + sovAg(uint64(m.Passes))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovAg(uint64(m.Commits))
+	}
+	if m.FlakyCommits != 0 {
+		n += 1 + sovAg(uint64(m.FlakyCommits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TestStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArtifactsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Leaderboard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaderboardEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Benchmark)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovAg(uint64(m.Rank))
+	}
+	if m.NsPerOp != 0 {
+		n += 1 + sovAg(uint64(m.NsPerOp))
+	}
+	if m.AllocsPerOp != 0 {
+		n += 1 + sovAg(uint64(m.AllocsPerOp))
+	}
+	if m.BytesPerOp != 0 {
+		n += 1 + sovAg(uint64(m.BytesPerOp))
+	}
+	if m.Own {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRegradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.RegradeID != 0 {
+		n += 1 + sovAg(uint64(m.RegradeID))
+	}
+	if m.State != 0 {
+		n += 1 + sovAg(uint64(m.State))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovAg(uint64(m.Score))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BuildLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionID != 0 {
+		n += 1 + sovAg(uint64(m.SubmissionID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelBuildRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.UserID != 0 {
+		n += 1 + sovAg(uint64(m.UserID))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovAg(uint64(m.GroupID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Artifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovAg(uint64(m.FileSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Artifacts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CourseUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CourseCode)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.CourseYear != 0 {
+		n += 1 + sovAg(uint64(m.CourseYear))
+	}
+	l = len(m.UserLogin)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoadCriteriaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	if m.AssignmentID != 0 {
+		n += 1 + sovAg(uint64(m.AssignmentID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TestsValidationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CourseID != 0 {
+		n += 1 + sovAg(uint64(m.CourseID))
+	}
+	l = len(m.SolutionsRepo)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAg(uint64(l))
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.Tested {
+		n += 2
+	}
+	if m.Score != 0 {
+		n += 1 + sovAg(uint64(m.Score))
+	}
+	if m.ScoreLimit != 0 {
+		n += 1 + sovAg(uint64(m.ScoreLimit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TestsValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovAg(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Void) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAg(x uint64) (n int) {
+	return sovAg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAdmin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAdmin = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvatarURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvatarURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Login", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Login = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIdentities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteIdentities = append(m.RemoteIdentities, &RemoteIdentity{})
+			if err := m.RemoteIdentities[len(m.RemoteIdentities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, &Enrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Users) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteID", wireType)
+			}
+			m.RemoteID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			m.UserID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Group) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			}
+			m.CourseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamID", wireType)
+			}
+			m.TeamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Group_GroupStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, &Enrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Groups) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Course) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Course: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Course: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseCreatorID", wireType)
+			}
+			m.CourseCreatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseCreatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationID", wireType)
+			}
+			m.OrganizationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrganizationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrganizationPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlipDays", wireType)
+			}
+			m.SlipDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlipDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrolled", wireType)
+			}
+			m.Enrolled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Enrolled |= Enrollment_UserStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, &Enrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, &Assignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushAssignments", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PushAssignments = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DockerImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerImageError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DockerImageError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Courses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Courses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Courses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Courses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Courses = append(m.Courses, &Course{})
+			if err := m.Courses[len(m.Courses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Repository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationID", wireType)
+			}
+			m.OrganizationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrganizationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryID", wireType)
+			}
+			m.RepositoryID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			m.UserID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTMLURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTMLURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
+			}
+			m.RepoType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepoType |= Repository_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Enrollment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Enrollment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Enrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			}
+			m.CourseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			m.UserID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTeacherScopes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasTeacherScopes = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Course == nil {
+				m.Course = &Course{}
+			}
+			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Group == nil {
+				m.Group = &Group{}
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Enrollment_UserStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Enrollment_DisplayState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlipDaysRemaining", wireType)
+			}
+			m.SlipDaysRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlipDaysRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastActivityDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalApproved", wireType)
+			}
+			m.TotalApproved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalApproved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSlipDays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedSlipDays = append(m.UsedSlipDays, &UsedSlipDays{})
+			if err := m.UsedSlipDays[len(m.UsedSlipDays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsedSlipDays) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsedSlipDays: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsedSlipDays: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentID", wireType)
+			}
+			m.EnrollmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnrollmentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			}
+			m.AssignmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignmentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSlipDays", wireType)
+			}
+			m.UsedSlipDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedSlipDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Enrollments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Enrollments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Enrollments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, &Enrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Assignment == nil {
+				m.Assignment = &Assignment{}
+			}
+			if err := m.Assignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Submission == nil {
+				m.Submission = &Submission{}
+			}
+			if err := m.Submission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnrollmentLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnrollmentLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnrollmentLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enrollment == nil {
+				m.Enrollment = &Enrollment{}
+			}
+			if err := m.Enrollment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &SubmissionLink{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CourseSubmissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CourseSubmissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CourseSubmissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Course == nil {
+				m.Course = &Course{}
+			}
+			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, &EnrollmentLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Assignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			}
+			m.CourseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApprove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApprove = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGroupLab", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGroupLab = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreLimit", wireType)
+			}
+			m.ScoreLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
+			}
+			m.Reviewers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reviewers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipTests = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &Submission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradingBenchmarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GradingBenchmarks = append(m.GradingBenchmarks, &GradingBenchmark{})
+			if err := m.GradingBenchmarks[len(m.GradingBenchmarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTimeout", wireType)
+			}
+			m.ContainerTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prerequisites", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prerequisites = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrerequisitePolicy", wireType)
+			}
+			m.PrerequisitePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrerequisitePolicy |= Assignment_PrerequisitePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestsCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestsCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyzers = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coverage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactsDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactsDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reruns", wireType)
+			}
+			m.Reruns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reruns |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunnerLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunnerLabels = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmarks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benchmarks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaderboard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leaderboard = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Assignments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assignments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assignments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, &Assignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignmentChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignmentChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignmentChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updated = append(m.Updated, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Renamed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Renamed == nil {
+				m.Renamed = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAg
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAg
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAg
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAg
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAg(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAg
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Renamed[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Archived = append(m.Archived, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Void) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAg(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAg(x uint64) (n int) {
-	return sovAg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *Submission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9337,30 +18156,189 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowAg
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Submission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Submission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			}
+			m.AssignmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignmentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			m.UserID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreObjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScoreObjects = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ID = 0
+			m.BuildInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9370,14 +18348,27 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9394,10 +18385,29 @@ func (m *User) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsAdmin = bool(v != 0)
-		case 3:
+			m.Released = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Submission_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9425,13 +18435,186 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ApprovedDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StudentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviews", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviews = append(m.Reviews, &Review{})
+			if err := m.Reviews[len(m.Reviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tests = append(m.Tests, &TestResult{})
+			if err := m.Tests[len(m.Tests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScore", wireType)
+			}
+			m.MaxScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9441,29 +18624,16 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxScore |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StudentID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9473,29 +18643,16 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvatarURL", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9505,27 +18662,14 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AvatarURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Login", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9553,13 +18697,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Login = string(dAtA[iNdEx:postIndex])
+			m.Failure = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIdentities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9569,31 +18713,29 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteIdentities = append(m.RemoteIdentities, &RemoteIdentity{})
-			if err := m.RemoteIdentities[len(m.RemoteIdentities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expected = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9603,25 +18745,23 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enrollments = append(m.Enrollments, &Enrollment{})
-			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actual = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9648,7 +18788,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Users) Unmarshal(dAtA []byte) error {
+func (m *BuildLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9671,17 +18811,55 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
+			}
+			m.SubmissionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9691,24 +18869,24 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Log = append(m.Log[:0], dAtA[iNdEx:postIndex]...)
+			if m.Log == nil {
+				m.Log = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9736,7 +18914,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoteIdentity) Unmarshal(dAtA []byte) error {
+func (m *Submissions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9759,36 +18937,17 @@ func (m *RemoteIdentity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoteIdentity: wiretype end group for non-group")
+			return fmt.Errorf("proto: Submissions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoteIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Submissions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9798,94 +18957,26 @@ func (m *RemoteIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteID", wireType)
-			}
-			m.RemoteID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RemoteID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Submissions = append(m.Submissions, &Submission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AccessToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
-			}
-			m.UserID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -9911,7 +19002,7 @@ func (m *RemoteIdentity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9934,10 +19025,10 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: GradingBenchmark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GradingBenchmark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9960,10 +19051,10 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			var stringLen uint64
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -9973,29 +19064,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heading", wireType)
 			}
-			m.CourseID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10005,54 +19083,29 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamID", wireType)
-			}
-			m.TeamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TeamID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Group_GroupStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			m.Heading = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10062,29 +19115,27 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Criteria", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10111,8 +19162,8 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enrollments = append(m.Enrollments, &Enrollment{})
-			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Criteria = append(m.Criteria, &GradingCriterion{})
+			if err := m.Criteria[len(m.Criteria)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10141,7 +19192,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Groups) Unmarshal(dAtA []byte) error {
+func (m *Benchmarks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10164,15 +19215,15 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+			return fmt.Errorf("proto: Benchmarks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Benchmarks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmarks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10199,8 +19250,8 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &Group{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Benchmarks = append(m.Benchmarks, &GradingBenchmark{})
+			if err := m.Benchmarks[len(m.Benchmarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10229,7 +19280,7 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Course) Unmarshal(dAtA []byte) error {
+func (m *GradingCriterion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10252,10 +19303,10 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Course: wiretype end group for non-group")
+			return fmt.Errorf("proto: GradingCriterion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Course: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GradingCriterion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10279,9 +19330,9 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseCreatorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			m.CourseCreatorID = 0
+			m.Points = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10291,16 +19342,16 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseCreatorID |= uint64(b&0x7F) << shift
+				m.Points |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BenchmarkID", wireType)
 			}
-			var stringLen uint64
+			m.BenchmarkID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10310,27 +19361,14 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BenchmarkID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10358,13 +19396,13 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
 			}
-			m.Year = 0
+			m.Grade = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10374,14 +19412,14 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Year |= uint32(b&0x7F) << shift
+				m.Grade |= GradingCriterion_Grade(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10409,64 +19447,67 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationID", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Review) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
 			}
-			m.OrganizationID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrganizationID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationPath", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Review: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Review: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var stringLen uint64
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10476,29 +19517,16 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrganizationPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlipDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			m.SlipDays = 0
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10508,16 +19536,16 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlipDays |= uint32(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrolled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewerID", wireType)
 			}
-			m.Enrolled = 0
+			m.ReviewerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10527,16 +19555,16 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Enrolled |= Enrollment_UserStatus(b&0x7F) << shift
+				m.ReviewerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10546,31 +19574,29 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enrollments = append(m.Enrollments, &Enrollment{})
-			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Review = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feedback", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10580,29 +19606,66 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assignments = append(m.Assignments, &Assignment{})
-			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Feedback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmarks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10629,8 +19692,8 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &Group{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Benchmarks = append(m.Benchmarks, &GradingBenchmark{})
+			if err := m.Benchmarks[len(m.Benchmarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10659,7 +19722,7 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Courses) Unmarshal(dAtA []byte) error {
+func (m *Reviewers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10682,15 +19745,15 @@ func (m *Courses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Courses: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reviewers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Courses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reviewers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Courses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10717,8 +19780,8 @@ func (m *Courses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Courses = append(m.Courses, &Course{})
-			if err := m.Courses[len(m.Courses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reviewers = append(m.Reviewers, &User{})
+			if err := m.Reviewers[len(m.Reviewers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10747,7 +19810,7 @@ func (m *Courses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *Regrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10770,10 +19833,10 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+			return fmt.Errorf("proto: Regrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Regrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10797,9 +19860,9 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			m.OrganizationID = 0
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10809,16 +19872,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrganizationID |= uint64(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			m.RepositoryID = 0
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10828,16 +19891,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepositoryID |= uint64(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.UserID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10847,16 +19910,131 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Regrade_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverID", wireType)
+			}
+			m.ResolverID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolverID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedDate", wireType)
 			}
-			m.GroupID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10866,14 +20044,27 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTMLURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10901,13 +20092,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HTMLURL = string(dAtA[iNdEx:postIndex])
+			m.Response = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreUpdates", wireType)
 			}
-			m.RepoType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -10917,11 +20108,26 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepoType |= Repository_Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScoreUpdates = append(m.ScoreUpdates, &ScoreUpdate{})
+			if err := m.ScoreUpdates[len(m.ScoreUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -10947,7 +20153,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Enrollment) Unmarshal(dAtA []byte) error {
+func (m *ScoreUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10970,10 +20176,10 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Enrollment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScoreUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Enrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScoreUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10997,9 +20203,9 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			m.CourseID = 0
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11009,16 +20215,16 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegradeID", wireType)
 			}
-			m.UserID = 0
+			m.RegradeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11028,16 +20234,16 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
+				m.RegradeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedByID", wireType)
 			}
-			m.GroupID = 0
+			m.UpdatedByID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11047,16 +20253,16 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
+				m.UpdatedByID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasTeacherScopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldScore", wireType)
 			}
-			var v int
+			m.OldScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11066,17 +20272,16 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OldScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasTeacherScopes = bool(v != 0)
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewScore", wireType)
 			}
-			var msglen int
+			m.NewScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11086,33 +20291,16 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11122,175 +20310,81 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Course == nil {
-				m.Course = &Course{}
-			}
-			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpdatedDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Group == nil {
-				m.Group = &Group{}
-			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Enrollment_UserStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= Enrollment_DisplayState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlipDaysRemaining", wireType)
-			}
-			m.SlipDaysRemaining = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlipDaysRemaining |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityDate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastActivityDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalApproved", wireType)
-			}
-			m.TotalApproved = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalApproved |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Regrades) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
 			}
-		case 14:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Regrades: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Regrades: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSlipDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11317,8 +20411,8 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UsedSlipDays = append(m.UsedSlipDays, &UsedSlipDays{})
-			if err := m.UsedSlipDays[len(m.UsedSlipDays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regrades = append(m.Regrades, &Regrade{})
+			if err := m.Regrades[len(m.Regrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11347,7 +20441,7 @@ func (m *Enrollment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UsedSlipDays) Unmarshal(dAtA []byte) error {
+func (m *ReviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11370,17 +20464,17 @@ func (m *UsedSlipDays) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UsedSlipDays: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UsedSlipDays: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.ID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11390,16 +20484,16 @@ func (m *UsedSlipDays) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
 			}
-			m.EnrollmentID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11409,49 +20503,28 @@ func (m *UsedSlipDays) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnrollmentID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAg
 			}
-			m.AssignmentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssignmentID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSlipDays", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UsedSlipDays = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsedSlipDays |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Review == nil {
+				m.Review = &Review{}
+			}
+			if err := m.Review.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -11477,7 +20550,7 @@ func (m *UsedSlipDays) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Enrollments) Unmarshal(dAtA []byte) error {
+func (m *CourseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11500,17 +20573,17 @@ func (m *Enrollments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Enrollments: wiretype end group for non-group")
+			return fmt.Errorf("proto: CourseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Enrollments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CourseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			var msglen int
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11520,26 +20593,11 @@ func (m *Enrollments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Enrollments = append(m.Enrollments, &Enrollment{})
-			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -11565,7 +20623,7 @@ func (m *Enrollments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmissionLink) Unmarshal(dAtA []byte) error {
+func (m *UserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11588,17 +20646,17 @@ func (m *SubmissionLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmissionLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmissionLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignment", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			var msglen int
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11608,33 +20666,70 @@ func (m *SubmissionLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Assignment == nil {
-				m.Assignment = &Assignment{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
 			}
-			if err := m.Assignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submission", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11644,28 +20739,11 @@ func (m *SubmissionLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Submission == nil {
-				m.Submission = &Submission{}
-			}
-			if err := m.Submission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -11691,7 +20769,7 @@ func (m *SubmissionLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnrollmentLink) Unmarshal(dAtA []byte) error {
+func (m *GroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11714,17 +20792,17 @@ func (m *EnrollmentLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnrollmentLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnrollmentLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollment", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			var msglen int
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11734,33 +20812,16 @@ func (m *EnrollmentLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Enrollment == nil {
-				m.Enrollment = &Enrollment{}
-			}
-			if err := m.Enrollment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			var msglen int
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11770,26 +20831,30 @@ func (m *EnrollmentLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.Submissions = append(m.Submissions, &SubmissionLink{})
-			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CourseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -11815,7 +20880,7 @@ func (m *EnrollmentLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CourseSubmissions) Unmarshal(dAtA []byte) error {
+func (m *Provider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11838,53 +20903,17 @@ func (m *CourseSubmissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CourseSubmissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CourseSubmissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Course == nil {
-				m.Course = &Course{}
-			}
-			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -11894,25 +20923,23 @@ func (m *CourseSubmissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Links = append(m.Links, &EnrollmentLink{})
-			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11939,7 +20966,7 @@ func (m *CourseSubmissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Assignment) Unmarshal(dAtA []byte) error {
+func (m *OrgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11962,53 +20989,15 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Assignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Assignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
-			}
-			m.CourseID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12036,97 +21025,67 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.OrgName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptFile", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScriptFile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Organization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deadline = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoApprove", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AutoApprove = bool(v != 0)
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Organization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Organization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Order = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12136,16 +21095,16 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Order |= uint32(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsGroupLab", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12155,36 +21114,29 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsGroupLab = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScoreLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
 			}
-			m.ScoreLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScoreLimit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Reviewers = 0
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Avatar", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12194,16 +21146,29 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reviewers |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Avatar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentPlan", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12213,15 +21178,81 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipTests = bool(v != 0)
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentPlan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Organizations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Organizations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Organizations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12248,16 +21279,70 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submissions = append(m.Submissions, &Submission{})
-			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Organizations = append(m.Organizations, &Organization{})
+			if err := m.Organizations[len(m.Organizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GradingBenchmarks", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnrollmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnrollmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			}
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12267,31 +21352,36 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreGroupMembers", wireType)
 			}
-			m.GradingBenchmarks = append(m.GradingBenchmarks, &GradingBenchmark{})
-			if err := m.GradingBenchmarks[len(m.GradingBenchmarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 14:
+			m.IgnoreGroupMembers = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithActivity", wireType)
 			}
-			m.ContainerTimeout = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12301,11 +21391,81 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContainerTimeout |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.WithActivity = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v Enrollment_UserStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Enrollment_UserStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAg
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]Enrollment_UserStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Enrollment_UserStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Enrollment_UserStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -12331,7 +21491,7 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Assignments) Unmarshal(dAtA []byte) error {
+func (m *EnrollmentStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12354,17 +21514,17 @@ func (m *Assignments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Assignments: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnrollmentStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Assignments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnrollmentStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			var msglen int
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12374,26 +21534,80 @@ func (m *Assignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Assignments = append(m.Assignments, &Assignment{})
-			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 2:
+			if wireType == 0 {
+				var v Enrollment_UserStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Enrollment_UserStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAg
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]Enrollment_UserStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Enrollment_UserStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Enrollment_UserStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -12419,7 +21633,7 @@ func (m *Assignments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Submission) Unmarshal(dAtA []byte) error {
+func (m *SubmissionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12442,93 +21656,17 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Submission: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submission: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
-			}
-			m.AssignmentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssignmentID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
-			}
-			m.UserID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			m.GroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+			return fmt.Errorf("proto: SubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			m.Score = 0
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12538,16 +21676,16 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= uint32(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScoreObjects", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			var stringLen uint64
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12557,29 +21695,16 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GroupID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScoreObjects = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildInfo", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			var stringLen uint64
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12589,61 +21714,70 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildInfo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			var v int
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12653,17 +21787,16 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Released = bool(v != 0)
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.Status = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12673,16 +21806,16 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Submission_Status(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedDate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var stringLen uint64
+			m.Score = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12692,29 +21825,16 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Score |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ApprovedDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reviews", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12724,85 +21844,17 @@ func (m *Submission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reviews = append(m.Reviews, &Review{})
-			if err := m.Reviews[len(m.Reviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAg(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAg
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAg
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Submissions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAg
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Submissions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submissions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			m.Released = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12812,26 +21864,11 @@ func (m *Submissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= Submission_Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Submissions = append(m.Submissions, &Submission{})
-			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -12857,7 +21894,7 @@ func (m *Submissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
+func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12880,17 +21917,17 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GradingBenchmark: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateSubmissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GradingBenchmark: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateSubmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.ID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12900,7 +21937,7 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12925,10 +21962,10 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Heading", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreLimit", wireType)
 			}
-			var stringLen uint64
+			m.ScoreLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12938,29 +21975,16 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScoreLimit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Heading = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -12970,29 +21994,17 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Release = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Criteria", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13002,26 +22014,12 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Criteria = append(m.Criteria, &GradingCriterion{})
-			if err := m.Criteria[len(m.Criteria)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Approve = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -13047,7 +22045,7 @@ func (m *GradingBenchmark) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Benchmarks) Unmarshal(dAtA []byte) error {
+func (m *SubmissionReviewersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13070,17 +22068,17 @@ func (m *Benchmarks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Benchmarks: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmissionReviewersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Benchmarks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmissionReviewersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Benchmarks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			var msglen int
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13090,26 +22088,30 @@ func (m *Benchmarks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.Benchmarks = append(m.Benchmarks, &GradingBenchmark{})
-			if err := m.Benchmarks[len(m.Benchmarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CourseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -13135,7 +22137,7 @@ func (m *Benchmarks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GradingCriterion) Unmarshal(dAtA []byte) error {
+func (m *Providers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13158,123 +22160,15 @@ func (m *GradingCriterion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GradingCriterion: wiretype end group for non-group")
+			return fmt.Errorf("proto: Providers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GradingCriterion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
-			}
-			m.Points = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Points |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BenchmarkID", wireType)
-			}
-			m.BenchmarkID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BenchmarkID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
-			}
-			m.Grade = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Grade |= GradingCriterion_Grade(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+			return fmt.Errorf("proto: Providers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13302,7 +22196,7 @@ func (m *GradingCriterion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13329,7 +22223,7 @@ func (m *GradingCriterion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Review) Unmarshal(dAtA []byte) error {
+func (m *URLRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13352,17 +22246,17 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Review: wiretype end group for non-group")
+			return fmt.Errorf("proto: URLRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Review: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: URLRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.ID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13372,86 +22266,139 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
-			}
-			m.SubmissionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
+			if wireType == 0 {
+				var v Repository_Type
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Repository_Type(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.RepoTypes = append(m.RepoTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubmissionID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthAg
 				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReviewerID", wireType)
-			}
-			m.ReviewerID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAg
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReviewerID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				var elementCount int
+				if elementCount != 0 && len(m.RepoTypes) == 0 {
+					m.RepoTypes = make([]Repository_Type, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v Repository_Type
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Repository_Type(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepoTypes = append(m.RepoTypes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoTypes", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Review = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feedback", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13461,29 +22408,16 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Feedback = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
-			var v int
+			m.GroupID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13493,17 +22427,16 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ready = bool(v != 0)
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.Score = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13513,14 +22446,68 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Repositories) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repositories: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repositories: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Benchmarks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URLs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13547,10 +22534,103 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Benchmarks = append(m.Benchmarks, &GradingBenchmark{})
-			if err := m.Benchmarks[len(m.Benchmarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.URLs == nil {
+				m.URLs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAg
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAg
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAg
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAg
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAg(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAg
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.URLs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13577,7 +22657,7 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Reviewers) Unmarshal(dAtA []byte) error {
+func (m *AuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13600,17 +22680,17 @@ func (m *Reviewers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reviewers: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reviewers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAuthorized", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13620,26 +22700,12 @@ func (m *Reviewers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reviewers = append(m.Reviewers, &User{})
-			if err := m.Reviewers[len(m.Reviewers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsAuthorized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -13665,7 +22731,7 @@ func (m *Reviewers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReviewRequest) Unmarshal(dAtA []byte) error {
+func (m *Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13688,17 +22754,17 @@ func (m *ReviewRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReviewRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.CourseID = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13708,16 +22774,16 @@ func (m *ReviewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.Code |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13727,27 +22793,23 @@ func (m *ReviewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Review == nil {
-				m.Review = &Review{}
-			}
-			if err := m.Review.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13774,7 +22836,7 @@ func (m *ReviewRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CourseRequest) Unmarshal(dAtA []byte) error {
+func (m *SubmissionsForCourseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13797,10 +22859,10 @@ func (m *CourseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CourseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmissionsForCourseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CourseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmissionsForCourseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13822,6 +22884,25 @@ func (m *CourseRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SubmissionsForCourseRequest_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -13847,7 +22928,7 @@ func (m *CourseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserRequest) Unmarshal(dAtA []byte) error {
+func (m *RebuildRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13866,21 +22947,59 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
+			}
+			m.SubmissionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			}
+			m.AssignmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignmentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestTests", wireType)
 			}
-			m.UserID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13890,11 +23009,32 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestTests = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.NoCache = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -13920,7 +23060,7 @@ func (m *UserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13943,17 +23083,17 @@ func (m *GetGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.GroupID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -13963,7 +23103,26 @@ func (m *GetGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			}
+			m.AssignmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13993,7 +23152,7 @@ func (m *GetGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupRequest) Unmarshal(dAtA []byte) error {
+func (m *RebuildAssignmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14016,17 +23175,17 @@ func (m *GroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebuildAssignmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebuildAssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.UserID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14036,16 +23195,16 @@ func (m *GroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			m.GroupID = 0
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14055,16 +23214,85 @@ func (m *GroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType == 0 {
+				var v Submission_Status
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Submission_Status(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAg
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]Submission_Status, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Submission_Status
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Submission_Status(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreBelow", wireType)
 			}
-			m.CourseID = 0
+			m.ScoreBelow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14074,11 +23302,51 @@ func (m *GroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.ScoreBelow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestTests", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestTests = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.NoCache = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -14104,7 +23372,7 @@ func (m *GroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *ScoreChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14127,17 +23395,93 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScoreChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScoreChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
+			}
+			m.SubmissionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			m.UserID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldScore", wireType)
+			}
+			m.OldScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldScore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewScore", wireType)
+			}
+			m.NewScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14147,24 +23491,11 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NewScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -14190,7 +23521,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrgRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentRebuild) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14213,17 +23544,17 @@ func (m *OrgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentRebuild: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentRebuild: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrgName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			var stringLen uint64
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14233,83 +23564,35 @@ func (m *OrgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrgName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAg(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAg
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAg
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Organization) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAg
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Organization: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Organization: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebuilt", wireType)
 			}
-			m.ID = 0
+			m.Rebuilt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14319,16 +23602,16 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Rebuilt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var stringLen uint64
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14338,29 +23621,36 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Failed |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Avatar", wireType)
+			m.Done = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increased", wireType)
 			}
-			var stringLen uint64
+			m.Increased = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14370,29 +23660,35 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Increased |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decreased", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Decreased = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decreased |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Avatar = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentPlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreChanges", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14402,23 +23698,25 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentPlan = string(dAtA[iNdEx:postIndex])
+			m.ScoreChanges = append(m.ScoreChanges, &ScoreChange{})
+			if err := m.ScoreChanges[len(m.ScoreChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14445,7 +23743,7 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Organizations) Unmarshal(dAtA []byte) error {
+func (m *TestOutcome) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14468,17 +23766,87 @@ func (m *Organizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Organizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestOutcome: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Organizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			}
+			m.AssignmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignmentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TestName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14488,26 +23856,62 @@ func (m *Organizations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organizations = append(m.Organizations, &Organization{})
-			if err := m.Organizations[len(m.Organizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TestName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+			}
+			m.Passes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Passes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -14533,7 +23937,7 @@ func (m *Organizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
+func (m *TestStat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14556,17 +23960,17 @@ func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnrollmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnrollmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestName", wireType)
 			}
-			m.CourseID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14576,16 +23980,29 @@ func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			var v int
+			m.Runs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14595,17 +24012,16 @@ func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Runs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreGroupMembers = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithActivity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
 			}
-			var v int
+			m.Passes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14615,80 +24031,48 @@ func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Passes |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithActivity = bool(v != 0)
 		case 4:
-			if wireType == 0 {
-				var v Enrollment_UserStatus
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Enrollment_UserStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
 				}
-				m.Statuses = append(m.Statuses, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAg
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAg
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlakyCommits", wireType)
+			}
+			m.FlakyCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Statuses) == 0 {
-					m.Statuses = make([]Enrollment_UserStatus, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Enrollment_UserStatus
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAg
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Enrollment_UserStatus(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Statuses = append(m.Statuses, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlakyCommits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -14715,7 +24099,7 @@ func (m *EnrollmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnrollmentStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *TestStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14738,17 +24122,17 @@ func (m *EnrollmentStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnrollmentStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnrollmentStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			m.UserID = 0
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14758,80 +24142,45 @@ func (m *EnrollmentStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v Enrollment_UserStatus
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Enrollment_UserStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Statuses = append(m.Statuses, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAg
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAg
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Statuses) == 0 {
-					m.Statuses = make([]Enrollment_UserStatus, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Enrollment_UserStatus
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAg
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Enrollment_UserStatus(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Statuses = append(m.Statuses, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &TestStat{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -14857,7 +24206,7 @@ func (m *EnrollmentStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmissionRequest) Unmarshal(dAtA []byte) error {
+func (m *ArtifactsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14880,55 +24229,17 @@ func (m *SubmissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
-			}
-			m.UserID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			m.GroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			m.CourseID = 0
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -14938,7 +24249,7 @@ func (m *SubmissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14968,7 +24279,7 @@ func (m *SubmissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateSubmissionRequest) Unmarshal(dAtA []byte) error {
+func (m *Leaderboard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14991,17 +24302,17 @@ func (m *UpdateSubmissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateSubmissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Leaderboard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Leaderboard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			m.SubmissionID = 0
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15011,35 +24322,16 @@ func (m *UpdateSubmissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmissionID |= uint64(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
-			}
-			m.CourseID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.Score = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15049,50 +24341,26 @@ func (m *UpdateSubmissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAg
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
 			}
-			m.Released = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Submission_Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Entries = append(m.Entries, &LeaderboardEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -15118,7 +24386,7 @@ func (m *UpdateSubmissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaderboardEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15141,17 +24409,17 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateSubmissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaderboardEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateSubmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaderboardEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmark", wireType)
 			}
-			m.CourseID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15161,16 +24429,29 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benchmark = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
 			}
-			m.AssignmentID = 0
+			m.Rank = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15180,16 +24461,16 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssignmentID |= uint64(b&0x7F) << shift
+				m.Rank |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScoreLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsPerOp", wireType)
 			}
-			m.ScoreLimit = 0
+			m.NsPerOp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15199,16 +24480,16 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScoreLimit |= uint32(b&0x7F) << shift
+				m.NsPerOp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocsPerOp", wireType)
 			}
-			var v int
+			m.AllocsPerOp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15218,15 +24499,33 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AllocsPerOp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Release = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerOp", wireType)
+			}
+			m.BytesPerOp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerOp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Own", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -15243,7 +24542,7 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Approve = bool(v != 0)
+			m.Own = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -15269,7 +24568,7 @@ func (m *UpdateSubmissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmissionReviewersRequest) Unmarshal(dAtA []byte) error {
+func (m *RegradesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15292,17 +24591,17 @@ func (m *SubmissionReviewersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmissionReviewersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegradesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmissionReviewersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.SubmissionID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15312,16 +24611,16 @@ func (m *SubmissionReviewersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmissionID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			m.CourseID = 0
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15331,7 +24630,7 @@ func (m *SubmissionReviewersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15361,7 +24660,7 @@ func (m *SubmissionReviewersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Providers) Unmarshal(dAtA []byte) error {
+func (m *UpdateRegradeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15384,15 +24683,72 @@ func (m *Providers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Providers: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRegradeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Providers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRegradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			}
+			m.CourseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegradeID", wireType)
+			}
+			m.RegradeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegradeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Regrade_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15420,8 +24776,27 @@ func (m *Providers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			m.Response = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -15447,7 +24822,7 @@ func (m *Providers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *URLRequest) Unmarshal(dAtA []byte) error {
+func (m *BuildLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15470,17 +24845,17 @@ func (m *URLRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: URLRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: URLRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
 			}
-			m.CourseID = 0
+			m.SubmissionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15490,80 +24865,11 @@ func (m *URLRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.SubmissionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v Repository_Type
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Repository_Type(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RepoTypes = append(m.RepoTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAg
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAg
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.RepoTypes) == 0 {
-					m.RepoTypes = make([]Repository_Type, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Repository_Type
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAg
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Repository_Type(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RepoTypes = append(m.RepoTypes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoTypes", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -15589,7 +24895,7 @@ func (m *URLRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelBuildRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15612,17 +24918,17 @@ func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelBuildRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelBuildRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.UserID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15632,16 +24938,16 @@ func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			m.GroupID = 0
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15651,16 +24957,16 @@ func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= uint64(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			m.CourseID = 0
+			m.UserID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15670,7 +24976,26 @@ func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				m.UserID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15700,7 +25025,7 @@ func (m *RepositoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repositories) Unmarshal(dAtA []byte) error {
+func (m *Artifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15723,17 +25048,17 @@ func (m *Repositories) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repositories: wiretype end group for non-group")
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repositories: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15743,119 +25068,43 @@ func (m *Repositories) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAg
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAg
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.URLs == nil {
-				m.URLs = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAg
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAg
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAg
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAg
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAg
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthAg
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthAg
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAg(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthAg
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.URLs[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -15881,7 +25130,7 @@ func (m *Repositories) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *Artifacts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15904,17 +25153,17 @@ func (m *AuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Artifacts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Artifacts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAuthorized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15924,12 +25173,26 @@ func (m *AuthorizationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsAuthorized = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, &Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -15955,7 +25218,7 @@ func (m *AuthorizationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Status) Unmarshal(dAtA []byte) error {
+func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15978,17 +25241,17 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: CourseUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CourseUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseCode", wireType)
 			}
-			m.Code = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -15998,14 +25261,46 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CourseCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseYear", wireType)
+			}
+			m.CourseYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseYear |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLogin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16033,7 +25328,7 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.UserLogin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16060,7 +25355,7 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmissionsForCourseRequest) Unmarshal(dAtA []byte) error {
+func (m *LoadCriteriaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16083,10 +25378,10 @@ func (m *SubmissionsForCourseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmissionsForCourseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoadCriteriaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmissionsForCourseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoadCriteriaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16110,9 +25405,9 @@ func (m *SubmissionsForCourseRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
 			}
-			m.Type = 0
+			m.AssignmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16122,7 +25417,7 @@ func (m *SubmissionsForCourseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= SubmissionsForCourseRequest_Type(b&0x7F) << shift
+				m.AssignmentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16152,7 +25447,7 @@ func (m *SubmissionsForCourseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RebuildRequest) Unmarshal(dAtA []byte) error {
+func (m *TestsValidationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16175,17 +25470,17 @@ func (m *RebuildRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RebuildRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestsValidationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebuildRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestsValidationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
 			}
-			m.SubmissionID = 0
+			m.CourseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16195,16 +25490,16 @@ func (m *RebuildRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmissionID |= uint64(b&0x7F) << shift
+				m.CourseID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SolutionsRepo", wireType)
 			}
-			m.AssignmentID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16214,11 +25509,24 @@ func (m *RebuildRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssignmentID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SolutionsRepo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -16244,7 +25552,7 @@ func (m *RebuildRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16267,15 +25575,15 @@ func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CourseUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CourseUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16303,13 +25611,13 @@ func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CourseCode = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseYear", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			m.CourseYear = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16319,16 +25627,29 @@ func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseYear |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserLogin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tested", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16338,24 +25659,50 @@ func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAg
+			m.Tested = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAg
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreLimit", wireType)
+			}
+			m.ScoreLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.UserLogin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
@@ -16381,7 +25728,7 @@ func (m *CourseUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadCriteriaRequest) Unmarshal(dAtA []byte) error {
+func (m *TestsValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16404,17 +25751,17 @@ func (m *LoadCriteriaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadCriteriaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestsValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadCriteriaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestsValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			m.CourseID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16424,16 +25771,29 @@ func (m *LoadCriteriaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CourseID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignmentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
 			}
-			m.AssignmentID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAg
@@ -16443,11 +25803,26 @@ func (m *LoadCriteriaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssignmentID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, &AssignmentValidation{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAg(dAtA[iNdEx:])
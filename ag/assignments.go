@@ -1,6 +1,7 @@
 package ag
 
 import (
+	"strings"
 	"time"
 )
 
@@ -22,6 +23,43 @@ func (m Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
 	return now.Sub(deadline), nil
 }
 
+// ReleaseDatePassed returns true if the assignment's release date has passed,
+// or if the assignment has no release date.
+func (m Assignment) ReleaseDatePassed(now time.Time) bool {
+	if m.GetReleaseDate() == "" {
+		return true
+	}
+	releaseDate, err := time.ParseInLocation(layout, m.GetReleaseDate(), now.Location())
+	if err != nil {
+		// this should not happen if release dates are parsed and recorded correctly
+		return false
+	}
+	return !now.Before(releaseDate)
+}
+
+// PrerequisiteNames returns the names of the assignments that must be
+// approved before this assignment can be approved.
+func (m Assignment) PrerequisiteNames() []string {
+	var names []string
+	for _, name := range strings.Split(m.GetPrerequisites(), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// RunnerLabelNames returns the labels that a runner agent must have to run the assignment's tests.
+func (m Assignment) RunnerLabelNames() []string {
+	var labels []string
+	for _, label := range strings.Split(m.GetRunnerLabels(), ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
 // IsApproved returns true if this assignment is already approved for the
 // latest submission, or if the score of the latest submission is sufficient
 // to autoapprove the assignment.
@@ -38,17 +76,32 @@ func (m Assignment) IsApproved(latest *Submission, score uint32) bool {
 // without submissions
 func (a Assignment) CloneWithoutSubmissions() *Assignment {
 	return &Assignment{
-		ID:                a.ID,
-		CourseID:          a.CourseID,
-		Name:              a.Name,
-		ScriptFile:        a.ScriptFile,
-		Deadline:          a.Deadline,
-		AutoApprove:       a.AutoApprove,
-		Order:             a.Order,
-		IsGroupLab:        a.IsGroupLab,
-		ScoreLimit:        a.ScoreLimit,
-		Reviewers:         a.Reviewers,
-		SkipTests:         a.SkipTests,
-		GradingBenchmarks: a.GradingBenchmarks,
+		ID:                 a.ID,
+		CourseID:           a.CourseID,
+		Name:               a.Name,
+		ScriptFile:         a.ScriptFile,
+		Deadline:           a.Deadline,
+		AutoApprove:        a.AutoApprove,
+		Order:              a.Order,
+		IsGroupLab:         a.IsGroupLab,
+		ScoreLimit:         a.ScoreLimit,
+		Reviewers:          a.Reviewers,
+		SkipTests:          a.SkipTests,
+		GradingBenchmarks:  a.GradingBenchmarks,
+		ContainerTimeout:   a.ContainerTimeout,
+		ReleaseDate:        a.ReleaseDate,
+		Hidden:             a.Hidden,
+		Prerequisites:      a.Prerequisites,
+		PrerequisitePolicy: a.PrerequisitePolicy,
+		Archived:           a.Archived,
+		TestsCommit:        a.TestsCommit,
+		ScriptTemplate:     a.ScriptTemplate,
+		Analyzers:          a.Analyzers,
+		Coverage:           a.Coverage,
+		ArtifactsDir:       a.ArtifactsDir,
+		Reruns:             a.Reruns,
+		RunnerLabels:       a.RunnerLabels,
+		Benchmarks:         a.Benchmarks,
+		Leaderboard:        a.Leaderboard,
 	}
 }
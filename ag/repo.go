@@ -25,6 +25,11 @@ func (t Repository) IsTestsRepo() bool {
 	return t.RepoType == Repository_TESTS
 }
 
+// IsAssignmentsRepo returns true if the repository is an 'assignments' type.
+func (t Repository) IsAssignmentsRepo() bool {
+	return t.RepoType == Repository_ASSIGNMENTS
+}
+
 // IsStudentRepo returns true if the repository is a user repo type.
 func (t Repository) IsStudentRepo() bool {
 	return t.RepoType == Repository_USER || t.RepoType == Repository_GROUP
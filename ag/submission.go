@@ -1,5 +1,21 @@
 package ag
 
+import "encoding/json"
+
 func (s *Submission) IsApproved() bool {
 	return s.GetStatus() == Submission_APPROVED
 }
+
+// MakeTestResults decodes the submission's score objects into its test results.
+func (s *Submission) MakeTestResults() error {
+	if s.GetScoreObjects() == "" {
+		return nil
+	}
+	// the score objects' field names match the test results' JSON names, except for case
+	var tests []*TestResult
+	if err := json.Unmarshal([]byte(s.GetScoreObjects()), &tests); err != nil {
+		return err
+	}
+	s.Tests = tests
+	return nil
+}
@@ -0,0 +1,34 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/gogo/protobuf/proto"
+)
+
+func TestMakeTestResults(t *testing.T) {
+	submission := &pb.Submission{
+		ScoreObjects: `[{"Secret":"hidden","TestName":"TestA","Score":8,"MaxScore":10,"Weight":1},` +
+			`{"Secret":"hidden","TestName":"TestB","Score":0,"MaxScore":5,"Weight":2,"Duration":12,"Failure":"wrong sum","Expected":"3","Actual":"4"}]`,
+	}
+	if err := submission.MakeTestResults(); err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.TestResult{
+		{TestName: "TestA", Score: 8, MaxScore: 10, Weight: 1},
+		{TestName: "TestB", Score: 0, MaxScore: 5, Weight: 2, Duration: 12, Failure: "wrong sum", Expected: "3", Actual: "4"},
+	}
+	if len(submission.Tests) != len(want) {
+		t.Fatalf("MakeTestResults() gave %d tests, want %d", len(submission.Tests), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(submission.Tests[i], want[i]) {
+			t.Errorf("Tests[%d] = %v, want %v", i, submission.Tests[i], want[i])
+		}
+	}
+
+	if err := (&pb.Submission{ScoreObjects: "not json"}).MakeTestResults(); err == nil {
+		t.Error("MakeTestResults() of invalid score objects succeeded, want error")
+	}
+}
@@ -3,6 +3,7 @@ package ag
 import (
 	"context"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -82,7 +83,22 @@ func (c Course) IsValid() bool {
 		(c.GetProvider() == "github" || c.GetProvider() == "gitlab" || c.GetProvider() == "fake") &&
 		c.GetOrganizationID() != 0 &&
 		c.GetYear() != 0 &&
-		c.GetTag() != ""
+		c.GetTag() != "" &&
+		(c.GetTemplateBranch() == "" || IsValidBranchName(c.GetTemplateBranch()))
+}
+
+// branchNamePattern matches the branch names accepted for course repositories;
+// a stricter subset of the names accepted by git.
+var branchNamePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._/-]*$`)
+
+// IsValidBranchName returns true if the given name is a valid branch name
+// that can safely be used in git commands.
+func IsValidBranchName(name string) bool {
+	return branchNamePattern.MatchString(name) &&
+		!strings.Contains(name, "..") &&
+		!strings.Contains(name, "//") &&
+		!strings.HasSuffix(name, "/") &&
+		!strings.HasSuffix(name, ".lock")
 }
 
 // IsValid checks required fields of a user request
@@ -188,6 +204,33 @@ func (req RebuildRequest) IsValid() bool {
 	return aid > 0 && sid > 0
 }
 
+// IsValid ensures that both course and assignment IDs are set
+func (req AssignmentRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that both course and assignment IDs are set
+func (req RebuildAssignmentRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that submission ID is set
+func (req ArtifactsRequest) IsValid() bool {
+	return req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that submission ID is set
+func (req BuildLogRequest) IsValid() bool {
+	return req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that course and assignment IDs are set, and that
+// the request is for either a user or a group.
+func (req CancelBuildRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 &&
+		(req.GetUserID() > 0) != (req.GetGroupID() > 0)
+}
+
 // IsValid checks that either ID or path field is set
 func (org Organization) IsValid() bool {
 	id, path := org.GetID(), org.GetPath()
@@ -220,6 +263,23 @@ func (r ReviewRequest) IsValid() bool {
 	return r.CourseID > 0 && r.Review.IsValid()
 }
 
+// IsValid ensures that a regrade is for a submission and gives a reason.
+func (r Regrade) IsValid() bool {
+	return r.SubmissionID > 0 && strings.TrimSpace(r.Reason) != ""
+}
+
+// IsValid ensures that course ID is set.
+func (req RegradesRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that course and regrade IDs are set, and that
+// the regrade is either accepted or rejected.
+func (req UpdateRegradeRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetRegradeID() > 0 &&
+		(req.GetState() == Regrade_ACCEPTED || req.GetState() == Regrade_REJECTED)
+}
+
 // IsValid ensures that a grading benchmark always belongs to an assignment
 // and is not empty.
 func (bm GradingBenchmark) IsValid() bool {
@@ -236,3 +296,8 @@ func (c GradingCriterion) IsValid() bool {
 func (r CourseUserRequest) IsValid() bool {
 	return r.CourseCode != "" && r.UserLogin != "" && r.CourseYear > 2019
 }
+
+// IsValid ensures that course ID is provided
+func (req TestsValidationRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"reflect"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -13,32 +15,108 @@ import (
 	"go.uber.org/zap"
 )
 
-// UpdateFromTestsRepo updates the database record for the course assignments.
-func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, repo *pb.Repository, course *pb.Course) {
+// UpdateFromTestsRepo updates the database record for the course assignments,
+// and the course's docker image if the runner can build images.
+func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, repo *pb.Repository, course *pb.Course) {
 	logger.Debugf("Updating %s from '%s' repository", course.GetCode(), pb.TestsRepo)
 	s, err := scm.NewSCMClient(logger, course.GetProvider(), course.GetAccessToken())
 	if err != nil {
 		logger.Errorf("Failed to create SCM Client: %w", err)
 		return
 	}
-	assignments, err := FetchAssignments(context.Background(), s, course)
+	changes, err := UpdateCourse(context.Background(), logger, db, s, runner, course)
 	if err != nil {
-		logger.Errorf("Failed to fetch assignments from '%s' repository: %w", pb.TestsRepo, err)
+		logger.Errorf("Failed to update assignments from '%s' repository: %w", pb.TestsRepo, err)
 		return
 	}
-	for _, assignment := range assignments {
+	logger.Debugf("Assignments for %s successfully updated from '%s' repo: %v", course.GetCode(), pb.TestsRepo, changes)
+}
+
+// UpdateCourse clones the course's 'tests' repository, updates the course's assignments
+// in the database from the 'assignment.yml' files, and builds the course's docker image,
+// and returns the changes made. Failing to build the image does not fail the update;
+// the error is recorded for the course and returned with the changes.
+func UpdateCourse(c context.Context, logger *zap.SugaredLogger, db database.Database, sc scm.SCM, runner ci.Runner, course *pb.Course) (*pb.AssignmentChanges, error) {
+	cloneCtx, cancel := context.WithTimeout(c, pb.MaxWait)
+	defer cancel()
+	cloneDir, err := cloneTestsRepo(cloneCtx, sc, course)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	testsDir := filepath.Join(cloneDir, pb.TestsRepo)
+	fetched, err := parseAssignments(testsDir, course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, assignment := range fetched {
 		logger.Debugf("Found assignment in '%s' repository: %v", pb.TestsRepo, assignment)
 	}
+	changes, err := UpdateAssignments(db, course.GetID(), fetched)
+	if err != nil {
+		return nil, err
+	}
+	changes.Image, err = updateCourseImage(c, db, runner, course, testsDir)
+	if err != nil {
+		logger.Errorf("Failed to update docker image for %s: %v", course.GetCode(), err)
+		changes.ImageError = err.Error()
+	}
+	return changes, nil
+}
+
+// UpdateAssignments updates the course's assignments in the database from the
+// given assignments, and returns the changes made. Assignments are identified
+// by their order, i.e., the assignmentid field in 'assignment.yml', so that
+// renaming an assignment's folder keeps its submissions. Assignments no longer
+// found in the 'tests' repository are archived.
+func UpdateAssignments(db database.Database, courseID uint64, assignments []*pb.Assignment) (*pb.AssignmentChanges, error) {
+	existing, err := db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	changes := diffAssignments(existing, assignments)
+	if err = db.UpdateAssignments(courseID, assignments); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
 
-	if err = db.UpdateAssignments(assignments); err != nil {
-		for _, assignment := range assignments {
-			logger.Debugf("Failed to update database for: %v", assignment)
+// diffAssignments returns the changes from the existing to the updated assignments.
+func diffAssignments(existing, updated []*pb.Assignment) *pb.AssignmentChanges {
+	changes := &pb.AssignmentChanges{Renamed: make(map[string]string)}
+	byOrder := make(map[uint32]*pb.Assignment)
+	for _, assignment := range existing {
+		byOrder[assignment.GetOrder()] = assignment
+	}
+	found := make(map[uint32]bool)
+	for _, assignment := range updated {
+		found[assignment.GetOrder()] = true
+		old, ok := byOrder[assignment.GetOrder()]
+		switch {
+		case !ok || old.GetArchived():
+			changes.Added = append(changes.Added, assignment.GetName())
+		case old.GetName() != assignment.GetName():
+			changes.Renamed[old.GetName()] = assignment.GetName()
+		case !sameAssignment(old, assignment):
+			changes.Updated = append(changes.Updated, assignment.GetName())
+		}
+	}
+	for _, assignment := range existing {
+		if !found[assignment.GetOrder()] && !assignment.GetArchived() {
+			changes.Archived = append(changes.Archived, assignment.GetName())
 		}
-		logger.Errorf("Failed to update assignments in database: %w", err)
-		return
 	}
+	return changes
+}
 
-	logger.Debugf("Assignments for %s successfully updated from '%s' repo", course.GetCode(), pb.TestsRepo)
+// sameAssignment returns true if the two assignments have the same
+// information, ignoring their IDs, submissions and grading benchmarks.
+func sameAssignment(a, b *pb.Assignment) bool {
+	x, y := a.CloneWithoutSubmissions(), b.CloneWithoutSubmissions()
+	x.ID, y.ID = 0, 0
+	x.GradingBenchmarks, y.GradingBenchmarks = nil, nil
+	return reflect.DeepEqual(x, y)
 }
 
 // FetchAssignments returns a list of assignments for the given course, by
@@ -56,13 +134,21 @@ func FetchAssignments(c context.Context, sc scm.SCM, course *pb.Course) ([]*pb.A
 	ctx, cancel := context.WithTimeout(c, pb.MaxWait)
 	defer cancel()
 
-	// ensuring compatibility with the old database:
-	if course.OrganizationPath == "" {
-		org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.OrganizationID})
-		if err != nil {
-			return nil, err
-		}
-		course.OrganizationPath = org.GetPath()
+	cloneDir, err := cloneTestsRepo(ctx, sc, course)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	// parse assignments found in the cloned tests directory
+	return parseAssignments(filepath.Join(cloneDir, pb.TestsRepo), course.ID)
+}
+
+// cloneTestsRepo clones the course's 'tests' repository into a new temporary
+// directory, which is returned. The caller must remove the directory.
+func cloneTestsRepo(ctx context.Context, sc scm.SCM, course *pb.Course) (string, error) {
+	if err := ensureOrganizationPath(ctx, sc, course); err != nil {
+		return "", err
 	}
 
 	log.Printf("org %s\n", course.GetOrganizationPath())
@@ -75,26 +161,17 @@ func FetchAssignments(c context.Context, sc scm.SCM, course *pb.Course) ([]*pb.A
 
 	cloneDir, err := ioutil.TempDir("", pb.TestsRepo)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer os.RemoveAll(cloneDir)
 
 	// clone the tests repository to cloneDir
-	job := &ci.Job{
-		Commands: []string{
-			"cd " + cloneDir,
-			"git clone " + cloneURL,
-		},
-	}
 	log.Printf("cd %v\n", cloneDir)
 	log.Printf("git clone %v\n", cloneURL)
 
-	runner := ci.Local{}
-	_, err = runner.Run(ctx, job)
+	_, err = ci.RunCommands(ctx, "cd "+cloneDir, "git clone "+cloneURL)
 	if err != nil {
-		return nil, err
+		os.RemoveAll(cloneDir)
+		return "", err
 	}
-
-	// parse assignments found in the cloned tests directory
-	return parseAssignments(cloneDir, course.ID)
+	return cloneDir, nil
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
 
 	"gopkg.in/yaml.v2"
 )
@@ -17,6 +18,7 @@ const (
 	target                       = "assignment.yml"
 	targetYaml                   = "assignment.yaml"
 	defaultAutoApproveScoreLimit = 80
+	invalidDate                  = "Invalid date format: "
 )
 
 // assignmentData holds information about a single assignment.
@@ -24,15 +26,35 @@ const (
 // Note that the struct can be private, but the fields must be
 // public to allow parsing.
 type assignmentData struct {
-	AssignmentID     uint   `yaml:"assignmentid"`
-	ScriptFile       string `yaml:"scriptfile"`
-	Deadline         string `yaml:"deadline"`
-	AutoApprove      bool   `yaml:"autoapprove"`
-	ScoreLimit       uint   `yaml:"scorelimit"`
-	IsGroupLab       bool   `yaml:"isgrouplab"`
-	Reviewers        uint   `yaml:"reviewers"`
-	ContainerTimeout uint   `yaml:"containertimeout"`
-	SkipTests        bool   `yaml:"skiptests"`
+	AssignmentID       uint            `yaml:"assignmentid"`
+	ScriptFile         string          `yaml:"scriptfile"`
+	Deadline           string          `yaml:"deadline"`
+	AutoApprove        bool            `yaml:"autoapprove"`
+	ScoreLimit         uint            `yaml:"scorelimit"`
+	IsGroupLab         bool            `yaml:"isgrouplab"`
+	Reviewers          uint            `yaml:"reviewers"`
+	ContainerTimeout   uint            `yaml:"containertimeout"`
+	SkipTests          bool            `yaml:"skiptests"`
+	ReleaseDate        string          `yaml:"releasedate"`
+	Hidden             bool            `yaml:"hidden"`
+	Prerequisites      []string        `yaml:"prerequisites"`
+	PrerequisitePolicy string          `yaml:"prerequisitepolicy"`
+	TestsCommit        string          `yaml:"testscommit"`
+	Analyzers          []*ci.Analyzer  `yaml:"analyzers"`
+	Coverage           *ci.Coverage    `yaml:"coverage"`
+	Artifacts          string          `yaml:"artifacts"`
+	Reruns             uint            `yaml:"reruns"`
+	RunnerLabels       []string        `yaml:"runnerlabels"`
+	Benchmarks         []*ci.Benchmark `yaml:"benchmarks"`
+	Leaderboard        bool            `yaml:"leaderboard"`
+}
+
+// prerequisitePolicies maps the policies accepted in 'assignment.yml'
+// to the corresponding prerequisite policy.
+var prerequisitePolicies = map[string]pb.Assignment_PrerequisitePolicy{
+	"":              pb.Assignment_BLOCK_TESTS,
+	"blocktests":    pb.Assignment_BLOCK_TESTS,
+	"blockapproval": pb.Assignment_BLOCK_APPROVAL,
 }
 
 // ParseAssignments recursively walks the given directory and parses
@@ -64,22 +86,69 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 				if newAssignment.ScriptFile == "" && !newAssignment.SkipTests {
 					return fmt.Errorf("error unmarshalling assignment: missing field 'scriptfile'")
 				}
+				var releaseDate string
+				if newAssignment.ReleaseDate != "" {
+					releaseDate = FixDeadline(newAssignment.ReleaseDate)
+					if strings.HasPrefix(releaseDate, invalidDate) {
+						return fmt.Errorf("error unmarshalling assignment: %s", releaseDate)
+					}
+				}
+				policy, ok := prerequisitePolicies[strings.ToLower(newAssignment.PrerequisitePolicy)]
+				if !ok {
+					return fmt.Errorf("error unmarshalling assignment: unknown prerequisite policy %q", newAssignment.PrerequisitePolicy)
+				}
+				analyzers, err := ci.EncodeAnalyzers(newAssignment.Analyzers)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: %w", err)
+				}
+				coverage, err := ci.EncodeCoverage(newAssignment.Coverage)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: %w", err)
+				}
+				benchmarks, err := ci.EncodeBenchmarks(newAssignment.Benchmarks)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: %w", err)
+				}
+				if err := ci.CheckArtifactsDir(newAssignment.Artifacts); err != nil {
+					return fmt.Errorf("error unmarshalling assignment: %w", err)
+				}
+				if newAssignment.Reruns > ci.MaxReruns {
+					return fmt.Errorf("error unmarshalling assignment: reruns cannot exceed %d", ci.MaxReruns)
+				}
 
 				// AssignmentID field from the parsed yaml is used to set Order, not assignment ID,
 				// or it will cause a database constraint violation (IDs must be unique)
 				// The Name field below is the folder name of the assignment.
 				assignment := &pb.Assignment{
-					CourseID:         courseID,
-					Deadline:         FixDeadline(newAssignment.Deadline),
-					ScriptFile:       strings.ToLower(newAssignment.ScriptFile),
-					Name:             filepath.Base(filepath.Dir(path)),
-					Order:            uint32(newAssignment.AssignmentID),
-					AutoApprove:      newAssignment.AutoApprove,
-					ScoreLimit:       uint32(newAssignment.ScoreLimit),
-					IsGroupLab:       newAssignment.IsGroupLab,
-					Reviewers:        uint32(newAssignment.Reviewers),
-					ContainerTimeout: uint32(newAssignment.ContainerTimeout),
-					SkipTests:        newAssignment.SkipTests,
+					CourseID:           courseID,
+					Deadline:           FixDeadline(newAssignment.Deadline),
+					ScriptFile:         strings.ToLower(newAssignment.ScriptFile),
+					Name:               filepath.Base(filepath.Dir(path)),
+					Order:              uint32(newAssignment.AssignmentID),
+					AutoApprove:        newAssignment.AutoApprove,
+					ScoreLimit:         uint32(newAssignment.ScoreLimit),
+					IsGroupLab:         newAssignment.IsGroupLab,
+					Reviewers:          uint32(newAssignment.Reviewers),
+					ContainerTimeout:   uint32(newAssignment.ContainerTimeout),
+					SkipTests:          newAssignment.SkipTests,
+					ReleaseDate:        releaseDate,
+					Prerequisites:      strings.Join(newAssignment.Prerequisites, ","),
+					PrerequisitePolicy: policy,
+					TestsCommit:        newAssignment.TestsCommit,
+					Analyzers:          analyzers,
+					Coverage:           coverage,
+					ArtifactsDir:       newAssignment.Artifacts,
+					Reruns:             uint32(newAssignment.Reruns),
+					RunnerLabels:       strings.Join(newAssignment.RunnerLabels, ","),
+					Benchmarks:         benchmarks,
+					Leaderboard:        newAssignment.Leaderboard,
+				}
+				// assignments are hidden until their release date; hidden assignments stay
+				// hidden after their release date, and their release date is not recorded,
+				// so that they are not released by the release scheduler
+				assignment.Hidden = newAssignment.Hidden || !assignment.ReleaseDatePassed(time.Now())
+				if newAssignment.Hidden {
+					assignment.ReleaseDate = ""
 				}
 
 				assignments = append(assignments, assignment)
@@ -90,9 +159,76 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkPrerequisites(assignments); err != nil {
+		return nil, err
+	}
+	// pinned tags and branches are resolved to the commit they refer to now
+	for _, assignment := range assignments {
+		if assignment.TestsCommit == "" {
+			continue
+		}
+		commit, err := ci.ResolveTestsCommit(dir, assignment.TestsCommit)
+		if err != nil {
+			return nil, fmt.Errorf("assignment %s: %w", assignment.Name, err)
+		}
+		assignment.TestsCommit = commit
+	}
+	// script templates in the tests repository replace the built-in scripts
+	if err := ci.LoadCourseScripts(dir, assignments); err != nil {
+		return nil, err
+	}
 	return assignments, nil
 }
 
+// checkPrerequisites returns an error if an assignment has a prerequisite
+// that is not among the given assignments, or if the prerequisites are cyclic.
+func checkPrerequisites(assignments []*pb.Assignment) error {
+	byName := make(map[string]*pb.Assignment)
+	for _, assignment := range assignments {
+		byName[assignment.GetName()] = assignment
+	}
+	for _, assignment := range assignments {
+		for _, name := range assignment.PrerequisiteNames() {
+			if _, ok := byName[name]; !ok {
+				return fmt.Errorf("assignment %s: unknown prerequisite %s", assignment.GetName(), name)
+			}
+		}
+	}
+
+	// depth-first search for cycles in the prerequisite graph
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("assignment %s: cyclic prerequisites", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, prerequisite := range byName[name].PrerequisiteNames() {
+			if err := visit(prerequisite); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, assignment := range assignments {
+		if err := visit(assignment.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FixDeadline returns the given date string in the layout used by QuickFeed,
+// or an "Invalid date format" string if the date could not be parsed.
 func FixDeadline(in string) string {
 	wantLayout := "2006-01-02T15:04:05"
 	acceptedLayouts := []string{
@@ -126,5 +262,5 @@ func FixDeadline(in string) string {
 		}
 		return t.Format(wantLayout)
 	}
-	return "Invalid date format: " + in
+	return invalidDate + in
 }
@@ -154,6 +154,194 @@ func TestParseUnknownFields(t *testing.T) {
 	}
 }
 
+func TestParseReleaseDate(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+
+	labs := map[string]string{
+		"lab1": "assignmentid: 1\nscriptfile: \"go.sh\"\nreleasedate: \"2017-08-20 12:00\"\n",
+		"lab2": "assignmentid: 2\nscriptfile: \"go.sh\"\nreleasedate: \"2999-08-20 12:00\"\n",
+		"lab3": "assignmentid: 3\nscriptfile: \"go.sh\"\nhidden: true\n",
+		"lab4": "assignmentid: 4\nscriptfile: \"go.sh\"\n",
+		// hidden assignments stay hidden after their release date
+		"lab5": "assignmentid: 5\nscriptfile: \"go.sh\"\nhidden: true\nreleasedate: \"2017-08-20 12:00\"\n",
+		"lab6": "assignmentid: 6\nscriptfile: \"go.sh\"\nhidden: true\nreleasedate: \"2999-08-20 12:00\"\n",
+	}
+	for lab, content := range labs {
+		if err := os.Mkdir(filepath.Join(testsDir, lab), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(testsDir, lab, "assignment.yml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assignments, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]struct {
+		releaseDate string
+		hidden      bool
+	}{
+		"lab1": {"2017-08-20T12:00:00", false},
+		"lab2": {"2999-08-20T12:00:00", true},
+		"lab3": {"", true},
+		"lab4": {"", false},
+		"lab5": {"", true},
+		"lab6": {"", true},
+	}
+	if len(assignments) != len(want) {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), len(want))
+	}
+	for _, assignment := range assignments {
+		w := want[assignment.GetName()]
+		if assignment.GetReleaseDate() != w.releaseDate || assignment.GetHidden() != w.hidden {
+			t.Errorf("%s: (releaseDate, hidden) = (%q, %t), want (%q, %t)",
+				assignment.GetName(), assignment.GetReleaseDate(), assignment.GetHidden(), w.releaseDate, w.hidden)
+		}
+	}
+}
+
+func TestParsePrerequisites(t *testing.T) {
+	tests := []struct {
+		name    string
+		labs    map[string]string
+		wantErr bool
+	}{
+		{"valid", map[string]string{
+			"lab1": "assignmentid: 1\nscriptfile: \"go.sh\"\n",
+			"lab2": "assignmentid: 2\nscriptfile: \"go.sh\"\nprerequisites: [lab1]\nprerequisitepolicy: blockapproval\n",
+		}, false},
+		{"unknown prerequisite", map[string]string{
+			"lab1": "assignmentid: 1\nscriptfile: \"go.sh\"\nprerequisites: [lab0]\n",
+		}, true},
+		{"unknown policy", map[string]string{
+			"lab1": "assignmentid: 1\nscriptfile: \"go.sh\"\n",
+			"lab2": "assignmentid: 2\nscriptfile: \"go.sh\"\nprerequisites: [lab1]\nprerequisitepolicy: sometimes\n",
+		}, true},
+		{"cycle", map[string]string{
+			"lab1": "assignmentid: 1\nscriptfile: \"go.sh\"\nprerequisites: [lab2]\n",
+			"lab2": "assignmentid: 2\nscriptfile: \"go.sh\"\nprerequisites: [lab1]\n",
+		}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsDir)
+			for lab, content := range test.labs {
+				if err := os.Mkdir(filepath.Join(testsDir, lab), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(filepath.Join(testsDir, lab, "assignment.yml"), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			assignments, err := parseAssignments(testsDir, 0)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseAssignments() error = %v, wantErr %t", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			lab2 := assignments[1]
+			if diff := cmp.Diff([]string{"lab1"}, lab2.PrerequisiteNames()); diff != "" {
+				t.Errorf("PrerequisiteNames() mismatch (-want +got):\n%s", diff)
+			}
+			if lab2.GetPrerequisitePolicy() != pb.Assignment_BLOCK_APPROVAL {
+				t.Errorf("PrerequisitePolicy = %v, want %v", lab2.GetPrerequisitePolicy(), pb.Assignment_BLOCK_APPROVAL)
+			}
+		})
+	}
+}
+
+func TestParseAnalyzers(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+	if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	yml := filepath.Join(testsDir, "lab1", "assignment.yml")
+	content := "assignmentid: 1\nscriptfile: \"go.sh\"\nanalyzers:\n  - name: gofmt\n    weight: 2\n  - name: govet\n    deduction: 5\n    maxdeduction: 20\n"
+	if err := ioutil.WriteFile(yml, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	assignments, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyzers, err := ci.Analyzers(assignments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ci.Analyzer{
+		{Name: "gofmt", Weight: 2, Deduction: 1, MaxDeduction: 10},
+		{Name: "govet", Weight: 1, Deduction: 5, MaxDeduction: 20},
+	}
+	if diff := cmp.Diff(want, analyzers); diff != "" {
+		t.Errorf("Analyzers() mismatch (-want +got):\n%s", diff)
+	}
+
+	content = "assignmentid: 1\nscriptfile: \"go.sh\"\nanalyzers:\n  - name: pylint\n"
+	if err := ioutil.WriteFile(yml, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() with unknown analyzer: expected error")
+	}
+}
+
+func TestParseBenchmarks(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+	if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	yml := filepath.Join(testsDir, "lab1", "assignment.yml")
+	content := "assignmentid: 1\nscriptfile: \"go.sh\"\nleaderboard: true\nbenchmarks:\n  - name: TestSort\n    maxnsperop: 5000\n    maxallocsperop: 2\n    weight: 3\n  - name: TestSearch\n"
+	if err := ioutil.WriteFile(yml, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	assignments, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assignments[0].GetLeaderboard() {
+		t.Error("Leaderboard = false, want true")
+	}
+	benchmarks, err := ci.Benchmarks(assignments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ci.Benchmark{
+		{Name: "TestSort", MaxNsPerOp: 5000, MaxAllocsPerOp: 2, Weight: 3},
+		{Name: "TestSearch", Weight: 1},
+	}
+	if diff := cmp.Diff(want, benchmarks); diff != "" {
+		t.Errorf("Benchmarks() mismatch (-want +got):\n%s", diff)
+	}
+
+	content = "assignmentid: 1\nscriptfile: \"go.sh\"\nbenchmarks:\n  - maxnsperop: 5000\n"
+	if err := ioutil.WriteFile(yml, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() with unnamed benchmark: expected error")
+	}
+}
+
 func TestFixDeadline(t *testing.T) {
 	deadlineTests := []struct {
 		in, want string
@@ -7,6 +7,7 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/scm"
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/zap"
 )
 
@@ -52,3 +53,41 @@ func TestFetchAssignments(t *testing.T) {
 		t.Logf("assignment: %v", assignment)
 	}
 }
+
+func TestDiffAssignments(t *testing.T) {
+	existing := []*pb.Assignment{
+		{ID: 1, CourseID: 1, Order: 1, Name: "lab1", ScriptFile: "go.sh"},
+		{ID: 2, CourseID: 1, Order: 2, Name: "lab2", ScriptFile: "go.sh"},
+		{ID: 3, CourseID: 1, Order: 3, Name: "lab3", ScriptFile: "go.sh"},
+		{ID: 4, CourseID: 1, Order: 4, Name: "lab4", ScriptFile: "go.sh"},
+		{ID: 5, CourseID: 1, Order: 5, Name: "lab5", ScriptFile: "go.sh", Archived: true},
+		{ID: 6, CourseID: 1, Order: 7, Name: "lab7", ScriptFile: "go.sh"},
+	}
+	updated := []*pb.Assignment{
+		{CourseID: 1, Order: 1, Name: "lab1", ScriptFile: "go.sh"},
+		{CourseID: 1, Order: 2, Name: "lab2", ScriptFile: "go.sh", Deadline: "2020-08-30T23:59:00"},
+		{CourseID: 1, Order: 3, Name: "lab3-renamed", ScriptFile: "go.sh"},
+		{CourseID: 1, Order: 5, Name: "lab5", ScriptFile: "go.sh"},
+		{CourseID: 1, Order: 6, Name: "lab6", ScriptFile: "go.sh"},
+		{CourseID: 1, Order: 7, Name: "lab7", ScriptFile: "go.sh", Coverage: `{"thresholds":[50,80],"weight":1}`},
+	}
+	want := &pb.AssignmentChanges{
+		Added:    []string{"lab5", "lab6"},
+		Updated:  []string{"lab2", "lab7"},
+		Renamed:  map[string]string{"lab3": "lab3-renamed"},
+		Archived: []string{"lab4"},
+	}
+	got := diffAssignments(existing, updated)
+	if diff := cmp.Diff(want.Added, got.Added); diff != "" {
+		t.Errorf("diffAssignments().Added mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.Updated, got.Updated); diff != "" {
+		t.Errorf("diffAssignments().Updated mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.Renamed, got.Renamed); diff != "" {
+		t.Errorf("diffAssignments().Renamed mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.Archived, got.Archived); diff != "" {
+		t.Errorf("diffAssignments().Archived mismatch (-want +got):\n%s", diff)
+	}
+}
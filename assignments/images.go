@@ -0,0 +1,40 @@
+package assignments
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+)
+
+// updateCourseImage builds the course's docker image from the Dockerfile in the
+// scripts folder of the given clone of the course's 'tests' repository, if the
+// runner can build images, and records the image, and the error building it, if
+// any, for the course. The name of the course's image is returned, or the empty
+// string if there is no image to build.
+func updateCourseImage(ctx context.Context, db database.Database, runner ci.Runner, course *pb.Course, testsDir string) (string, error) {
+	builder, ok := runner.(ci.ImageBuilder)
+	if !ok {
+		return "", nil
+	}
+	commit, _, err := TestsCommit(ctx, testsDir)
+	if err != nil {
+		return "", err
+	}
+	image, err := ci.UpdateCourseImage(ctx, builder, course, testsDir, commit)
+	var imageErr string
+	if err != nil {
+		imageErr = err.Error()
+	}
+	if (image != "" && image != course.GetDockerImage()) || imageErr != course.GetDockerImageError() {
+		if image != "" {
+			course.DockerImage = image
+		}
+		course.DockerImageError = imageErr
+		if dbErr := db.UpdateCourseImage(course); dbErr != nil && err == nil {
+			err = dbErr
+		}
+	}
+	return image, err
+}
@@ -0,0 +1,44 @@
+package assignments
+
+import (
+	"time"
+
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+const releaseLayout = "2006-01-02T15:04:05"
+
+// StartReleaseScheduler periodically releases hidden assignments whose
+// release date has passed. For courses that push new assignments to
+// student and group repositories, the repositories are updated when
+// one or more assignments have been released. This function never returns.
+func StartReleaseScheduler(logger *zap.SugaredLogger, db database.Database, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		releaseAssignments(logger, db, now)
+	}
+}
+
+// releaseAssignments releases the assignments whose release date has passed.
+func releaseAssignments(logger *zap.SugaredLogger, db database.Database, now time.Time) {
+	released, err := db.ReleaseAssignments(now.Format(releaseLayout))
+	if err != nil {
+		logger.Errorf("Failed to release assignments: %w", err)
+		return
+	}
+	courses := make(map[uint64]bool)
+	for _, assignment := range released {
+		logger.Debugf("Released assignment %s (course %d)", assignment.GetName(), assignment.GetCourseID())
+		courses[assignment.GetCourseID()] = true
+	}
+	for courseID := range courses {
+		course, err := db.GetCourse(courseID, false)
+		if err != nil {
+			logger.Errorf("Failed to get course %d: %w", courseID, err)
+			continue
+		}
+		PushToStudentRepos(logger, db, course)
+	}
+}
@@ -0,0 +1,375 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultTemplateBranch is the branch of the 'assignments' repository used
+	// to seed student and group repositories, if the course does not specify one.
+	defaultTemplateBranch = "master"
+	// studentBranch is the branch pushed to in student and group repositories.
+	studentBranch = "master"
+	// CommitName and CommitEmail are the author of commits pushed by QuickFeed.
+	CommitName  = "QuickFeed"
+	CommitEmail = "quickfeed@users.noreply.github.com"
+	// CommitMarker ends the message of commits pushed by QuickFeed, so that
+	// their push events are recognized as QuickFeed's own pushes.
+	CommitMarker = "Pushed-by: QuickFeed"
+	// commitAuthor is used as author of commits pushed by QuickFeed.
+	commitAuthor = "-c user.name=" + CommitName + " -c user.email=" + CommitEmail
+)
+
+// SeedRepository pushes the contents of the course's 'assignments' repository
+// to the student or group repository with the given path, as a single commit.
+// Only the folders of released assignments are pushed; see releasedAssignments.
+// The target repository is expected to be newly created and empty.
+func SeedRepository(ctx context.Context, sc scm.SCM, db database.Database, course *pb.Course, repoPath string) error {
+	ctx, cancel := context.WithTimeout(ctx, pb.MaxWait)
+	defer cancel()
+
+	released, err := loadReleasedAssignments(db, course)
+	if err != nil {
+		return err
+	}
+	if err := ensureOrganizationPath(ctx, sc, course); err != nil {
+		return err
+	}
+	assignmentsURL, err := cloneURL(sc, course, pb.AssignmentRepo)
+	if err != nil {
+		return err
+	}
+	targetURL, err := cloneURL(sc, course, repoPath)
+	if err != nil {
+		return err
+	}
+	branch, err := templateBranch(course)
+	if err != nil {
+		return err
+	}
+
+	cloneDir, err := ioutil.TempDir("", pb.AssignmentRepo)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	if _, err = ci.RunCommands(ctx,
+		"set -e",
+		"cd "+cloneDir,
+		"git clone --quiet --single-branch --branch "+ci.Quote(branch)+" "+assignmentsURL+" "+pb.AssignmentRepo,
+	); err != nil {
+		return fmt.Errorf("failed to clone '%s' repository: %w", pb.AssignmentRepo, err)
+	}
+	// the history of the 'assignments' repository is not pushed,
+	// since it contains the folders of unreleased assignments
+	templateDir := filepath.Join(cloneDir, pb.AssignmentRepo)
+	repoDir := filepath.Join(cloneDir, repoPath)
+	if err := copyFiles(templateDir, repoDir); err != nil {
+		return err
+	}
+	added, err := newAssignments(templateDir, repoDir, released)
+	if err != nil {
+		return err
+	}
+	for _, name := range added {
+		if err := copyDir(filepath.Join(templateDir, name), filepath.Join(repoDir, name)); err != nil {
+			return err
+		}
+	}
+	if _, err = ci.RunCommands(ctx,
+		"set -e",
+		"cd "+repoDir,
+		"git init --quiet",
+		"git add --all",
+		"git "+commitAuthor+" commit --quiet --allow-empty -m "+ci.Quote(commitMessage("Add starter code")),
+		"git push --quiet "+targetURL+" HEAD:refs/heads/"+studentBranch,
+	); err != nil {
+		return fmt.Errorf("failed to seed repository %s: %w", repoPath, err)
+	}
+	return nil
+}
+
+// PushNewAssignments copies assignment folders found in the course's
+// 'assignments' repository, but missing in the given student or group
+// repositories, and pushes them to those repositories. Only the folders
+// of released assignments are copied; see releasedAssignments.
+// Existing folders are never overwritten, to avoid interfering with
+// the students' work. An error is returned for the first repository
+// that could not be updated; the remaining repositories are still updated.
+func PushNewAssignments(ctx context.Context, sc scm.SCM, db database.Database, course *pb.Course, repoPaths []string) error {
+	released, err := loadReleasedAssignments(db, course)
+	if err != nil {
+		return err
+	}
+	if err := ensureOrganizationPath(ctx, sc, course); err != nil {
+		return err
+	}
+	assignmentsURL, err := cloneURL(sc, course, pb.AssignmentRepo)
+	if err != nil {
+		return err
+	}
+	branch, err := templateBranch(course)
+	if err != nil {
+		return err
+	}
+
+	cloneDir, err := ioutil.TempDir("", pb.AssignmentRepo)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	if _, err = ci.RunCommands(ctx,
+		"set -e",
+		"cd "+cloneDir,
+		"git clone --quiet --single-branch --branch "+ci.Quote(branch)+" "+assignmentsURL+" "+pb.AssignmentRepo,
+	); err != nil {
+		return fmt.Errorf("failed to clone '%s' repository: %w", pb.AssignmentRepo, err)
+	}
+	templateDir := filepath.Join(cloneDir, pb.AssignmentRepo)
+
+	var firstErr error
+	for _, repoPath := range repoPaths {
+		if err := pushNewAssignments(ctx, sc, course, released, templateDir, cloneDir, repoPath); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// pushNewAssignments pushes the folders of the released assignments from
+// templateDir that are missing in the repository with the given path.
+func pushNewAssignments(ctx context.Context, sc scm.SCM, course *pb.Course, released map[string]bool, templateDir, workDir, repoPath string) error {
+	targetURL, err := cloneURL(sc, course, repoPath)
+	if err != nil {
+		return err
+	}
+	repoDir := filepath.Join(workDir, repoPath)
+	defer os.RemoveAll(repoDir)
+
+	if _, err := ci.RunCommands(ctx,
+		"set -e",
+		"cd "+workDir,
+		"git clone --quiet "+targetURL+" "+repoPath,
+	); err != nil {
+		return fmt.Errorf("failed to clone repository %s: %w", repoPath, err)
+	}
+
+	added, err := newAssignments(templateDir, repoDir, released)
+	if err != nil {
+		return err
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	quoted := make([]string, len(added))
+	for i, name := range added {
+		if err := copyDir(filepath.Join(templateDir, name), filepath.Join(repoDir, name)); err != nil {
+			return err
+		}
+		quoted[i] = ci.Quote(name)
+	}
+	if _, err := ci.RunCommands(ctx,
+		"set -e",
+		"cd "+repoDir,
+		"git add -- "+strings.Join(quoted, " "),
+		"git "+commitAuthor+" commit --quiet -m "+ci.Quote(commitMessage("Add assignments: "+strings.Join(added, ", "))),
+		"git push --quiet origin HEAD:refs/heads/"+studentBranch,
+	); err != nil {
+		return fmt.Errorf("failed to push new assignments to repository %s: %w", repoPath, err)
+	}
+	return nil
+}
+
+// PushToStudentRepos pushes new assignments from the course's 'assignments'
+// repository to all student and group repositories of the given course,
+// if enabled for the course.
+func PushToStudentRepos(logger *zap.SugaredLogger, db database.Database, course *pb.Course) {
+	if !course.GetPushAssignments() {
+		logger.Debugf("Pushing new assignments is disabled for %s", course.GetCode())
+		return
+	}
+	s, err := scm.NewSCMClient(logger, course.GetProvider(), course.GetAccessToken())
+	if err != nil {
+		logger.Errorf("Failed to create SCM Client: %w", err)
+		return
+	}
+	var repoPaths []string
+	for _, repoType := range []pb.Repository_Type{pb.Repository_USER, pb.Repository_GROUP} {
+		repos, err := db.GetRepositories(&pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			RepoType:       repoType,
+		})
+		if err != nil {
+			logger.Errorf("Failed to get %s repositories from database: %w", repoType, err)
+			return
+		}
+		for _, repo := range repos {
+			repoPaths = append(repoPaths, path.Base(repo.GetHTMLURL()))
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pb.MaxWait)
+	defer cancel()
+	if err := PushNewAssignments(ctx, s, db, course, repoPaths); err != nil {
+		logger.Errorf("Failed to push new assignments for %s: %w", course.GetCode(), err)
+		return
+	}
+	logger.Debugf("New assignments for %s pushed to %d repositories", course.GetCode(), len(repoPaths))
+}
+
+// newAssignments returns the names of the folders of released assignments in
+// templateDir that are not present in repoDir. Hidden folders, such as .git,
+// and folders of assignments that are not released are ignored.
+func newAssignments(templateDir, repoDir string, released map[string]bool) ([]string, error) {
+	entries, err := ioutil.ReadDir(templateDir)
+	if err != nil {
+		return nil, err
+	}
+	var added []string
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || !released[entry.Name()] {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(repoDir, entry.Name())); os.IsNotExist(err) {
+			added = append(added, entry.Name())
+		}
+	}
+	return added, nil
+}
+
+// loadReleasedAssignments returns the names of the released assignments of the given course.
+func loadReleasedAssignments(db database.Database, course *pb.Course) (map[string]bool, error) {
+	assignments, err := db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignments for %s: %w", course.GetCode(), err)
+	}
+	return releasedAssignments(assignments, time.Now()), nil
+}
+
+// releasedAssignments returns the names of the given assignments that are released
+// to students: their release date has passed, and they are neither hidden nor archived.
+// The folders of other assignments are not pushed to student and group repositories,
+// since students could otherwise see assignments before they are released.
+func releasedAssignments(assignments []*pb.Assignment, now time.Time) map[string]bool {
+	released := make(map[string]bool)
+	for _, assignment := range assignments {
+		if !assignment.GetHidden() && !assignment.GetArchived() && assignment.ReleaseDatePassed(now) {
+			released[assignment.GetName()] = true
+		}
+	}
+	return released
+}
+
+// copyFiles copies the files, but not the folders, of the src directory to dst.
+func copyFiles(src, dst string) error {
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Mode().IsRegular() {
+			continue
+		}
+		if err := copyFile(filepath.Join(src, entry.Name()), filepath.Join(dst, entry.Name()), entry.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitMessage returns the message of a commit pushed by QuickFeed with the given subject.
+func commitMessage(subject string) string {
+	return subject + "\n\n" + CommitMarker
+}
+
+// copyDir recursively copies the src directory to dst.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// ensureOrganizationPath sets the course's organization path, if missing.
+// This ensures compatibility with the old database.
+func ensureOrganizationPath(ctx context.Context, sc scm.SCM, course *pb.Course) error {
+	if course.OrganizationPath != "" {
+		return nil
+	}
+	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.OrganizationID})
+	if err != nil {
+		return err
+	}
+	course.OrganizationPath = org.GetPath()
+	return nil
+}
+
+// cloneURL returns the clone URL for the given repository of the course.
+func cloneURL(sc scm.SCM, course *pb.Course, repoPath string) (string, error) {
+	url := sc.CreateCloneURL(&scm.CreateClonePathOptions{
+		Organization: course.GetOrganizationPath(),
+		Repository:   repoPath,
+	})
+	if url == "" {
+		return "", fmt.Errorf("no clone URL for repository %s", repoPath)
+	}
+	return url, nil
+}
+
+// templateBranch returns the branch of the 'assignments' repository
+// to use as template for student and group repositories.
+func templateBranch(course *pb.Course) (string, error) {
+	branch := course.GetTemplateBranch()
+	if branch == "" {
+		return defaultTemplateBranch, nil
+	}
+	if !pb.IsValidBranchName(branch) {
+		return "", fmt.Errorf("invalid template branch %q for course %s", branch, course.GetCode())
+	}
+	return branch, nil
+}
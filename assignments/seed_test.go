@@ -0,0 +1,102 @@
+package assignments
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewAssignments(t *testing.T) {
+	templateDir, err := ioutil.TempDir("", pb.AssignmentRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(templateDir)
+	repoDir, err := ioutil.TempDir("", pb.StudentRepoName("meling"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoDir)
+
+	for _, dir := range []string{".git", "lab1", "lab2", filepath.Join("lab3", "part1"), "lab4"} {
+		if err := os.MkdirAll(filepath.Join(templateDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(templateDir, "README.md"), []byte("# Assignments"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(templateDir, "lab3", "part1", "main.go"), []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// the student has already started on lab1
+	if err := os.MkdirAll(filepath.Join(repoDir, "lab1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// lab4 is hidden, and is not released
+	released := map[string]bool{"lab1": true, "lab2": true, "lab3": true}
+	added, err := newAssignments(templateDir, repoDir, released)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"lab2", "lab3"}
+	if diff := cmp.Diff(want, added); diff != "" {
+		t.Errorf("newAssignments() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := copyDir(filepath.Join(templateDir, "lab3"), filepath.Join(repoDir, "lab3")); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(repoDir, "lab3", "part1", "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "package main" {
+		t.Errorf("copyDir() copied %q, want %q", content, "package main")
+	}
+}
+
+func TestReleasedAssignments(t *testing.T) {
+	now := time.Date(2020, 8, 20, 12, 0, 0, 0, time.Local)
+	assignments := []*pb.Assignment{
+		{Name: "lab1"},
+		{Name: "lab2", ReleaseDate: "2020-08-19T12:00:00"},
+		{Name: "lab3", ReleaseDate: "2020-08-21T12:00:00", Hidden: true},
+		{Name: "lab4", Hidden: true},
+		{Name: "lab5", Archived: true},
+	}
+	want := map[string]bool{"lab1": true, "lab2": true}
+	if diff := cmp.Diff(want, releasedAssignments(assignments, now)); diff != "" {
+		t.Errorf("releasedAssignments() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTemplateBranch(t *testing.T) {
+	for _, test := range []struct {
+		branch  string
+		want    string
+		wantErr bool
+	}{
+		{"", defaultTemplateBranch, false},
+		{"starter-code", "starter-code", false},
+		{"release/2020", "release/2020", false},
+		{"main; rm -rf /", "", true},
+		{"--upload-pack=evil", "", true},
+		{"a..b", "", true},
+		{"$(id)", "", true},
+	} {
+		got, err := templateBranch(&pb.Course{TemplateBranch: test.branch})
+		if (err != nil) != test.wantErr {
+			t.Errorf("templateBranch(%q): got error %v, want error %t", test.branch, err, test.wantErr)
+		}
+		if got != test.want {
+			t.Errorf("templateBranch(%q) = %q, want %q", test.branch, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+// ValidateTestsRepo clones the course's 'tests' repository and validates the
+// assignments found in it. If solutionsRepo is non-empty, the tests of every
+// assignment are also run against the repository with that name in the
+// course organization, and the resulting scores are reported.
+func ValidateTestsRepo(ctx context.Context, logger *zap.SugaredLogger, sc scm.SCM, runner ci.Runner, course *pb.Course, solutionsRepo string) (*pb.TestsValidation, error) {
+	ctx, cancel := context.WithTimeout(ctx, pb.MaxWait)
+	defer cancel()
+
+	cloneDir, err := cloneTestsRepo(ctx, sc, course)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone '%s' repository: %w", pb.TestsRepo, err)
+	}
+	defer os.RemoveAll(cloneDir)
+
+	testsDir := filepath.Join(cloneDir, pb.TestsRepo)
+	assignments, report := Validate(course, testsDir)
+	if solutionsRepo == "" || len(report.GetErrors()) > 0 {
+		return report, nil
+	}
+	// run the tests of the validated commit, even if the repository is updated meanwhile
+	commit, _, err := TestsCommit(ctx, testsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit of '%s' repository: %w", pb.TestsRepo, err)
+	}
+	PinTestsCommit(assignments, commit)
+	repo, err := sc.GetRepository(ctx, &scm.RepositoryOptions{
+		Path:  solutionsRepo,
+		Owner: course.GetOrganizationPath(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get solutions repository %s: %w", solutionsRepo, err)
+	}
+	DryRun(logger, runner, course, assignments, &pb.Repository{HTMLURL: repo.WebURL}, report)
+	return report, nil
+}
+
+// Validate parses the assignments found in testsDir and checks their fields and
+// script templates. The parsed assignments are returned along with a report of
+// the problems found. If the assignments could not be parsed, the report's
+// errors explain why, and no assignments are returned.
+func Validate(course *pb.Course, testsDir string) ([]*pb.Assignment, *pb.TestsValidation) {
+	report := &pb.TestsValidation{}
+	assignments, err := parseAssignments(testsDir, course.GetID())
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return nil, report
+	}
+	if len(assignments) == 0 {
+		report.Errors = append(report.Errors, fmt.Sprintf("no assignments found in '%s' repository", pb.TestsRepo))
+		return nil, report
+	}
+	orders := make(map[uint32]string)
+	for _, assignment := range assignments {
+		validation := &pb.AssignmentValidation{
+			Name:       assignment.GetName(),
+			ScoreLimit: assignment.GetScoreLimit(),
+			Errors:     validateAssignment(course, assignment),
+		}
+		if other, ok := orders[assignment.GetOrder()]; ok {
+			validation.Errors = append(validation.Errors, fmt.Sprintf("assignmentid %d already used by %s", assignment.GetOrder(), other))
+		}
+		orders[assignment.GetOrder()] = assignment.GetName()
+		report.Assignments = append(report.Assignments, validation)
+	}
+	return assignments, report
+}
+
+// validateAssignment returns the problems found for the given assignment.
+func validateAssignment(course *pb.Course, assignment *pb.Assignment) []string {
+	var errs []string
+	if strings.HasPrefix(assignment.GetDeadline(), invalidDate) {
+		errs = append(errs, "deadline: "+assignment.GetDeadline())
+	}
+	if releasedAfterDeadline(assignment) {
+		errs = append(errs, "releasedate is after the deadline")
+	}
+	if assignment.GetOrder() == 0 {
+		errs = append(errs, "missing field 'assignmentid'")
+	}
+	if assignment.GetScoreLimit() > 100 {
+		errs = append(errs, fmt.Sprintf("scorelimit %d is above 100", assignment.GetScoreLimit()))
+	}
+	if !assignment.GetSkipTests() {
+		if err := ci.ValidateScript(course, assignment); err != nil {
+			errs = append(errs, "scriptfile: "+err.Error())
+		}
+	}
+	return errs
+}
+
+// releasedAfterDeadline returns true if the assignment's release date is after its
+// deadline. Unset and invalid dates are not compared; invalid dates are reported separately.
+func releasedAfterDeadline(assignment *pb.Assignment) bool {
+	releaseDate, err := time.Parse(releaseLayout, assignment.GetReleaseDate())
+	if err != nil {
+		return false
+	}
+	deadline, err := time.Parse(releaseLayout, assignment.GetDeadline())
+	if err != nil {
+		return false
+	}
+	return releaseDate.After(deadline)
+}
+
+// TestsCommit returns the commit checked out in the given clone of the 'tests'
+// repository, and whether the clone has changes that are not committed.
+func TestsCommit(ctx context.Context, testsDir string) (string, bool, error) {
+	out, err := ci.RunCommands(ctx, "git -C "+ci.Quote(testsDir)+" rev-parse HEAD")
+	if err != nil {
+		return "", false, err
+	}
+	commit := strings.TrimSpace(out)
+	if !ci.IsCommit(commit) {
+		return "", false, fmt.Errorf("unexpected output from git rev-parse: %s", commit)
+	}
+	status, err := ci.RunCommands(ctx, "git -C "+ci.Quote(testsDir)+" status --porcelain")
+	if err != nil {
+		return "", false, err
+	}
+	return commit, strings.TrimSpace(status) != "", nil
+}
+
+// PinTestsCommit sets the tests commit of the given assignments to the given commit,
+// unless an assignment already pins a commit, so that a dry run tests the same
+// commit of the 'tests' repository that was validated.
+func PinTestsCommit(assignments []*pb.Assignment, commit string) {
+	for _, assignment := range assignments {
+		if assignment.GetTestsCommit() == "" {
+			assignment.TestsCommit = commit
+		}
+	}
+}
+
+// DryRun runs the tests of the given assignments against the solutions
+// repository and records the scores in the report. Assignments that have
+// validation errors, or that skip tests, are not run.
+func DryRun(logger *zap.SugaredLogger, runner ci.Runner, course *pb.Course, assignments []*pb.Assignment, solutions *pb.Repository, report *pb.TestsValidation) {
+	for i, assignment := range assignments {
+		validation := report.Assignments[i]
+		if len(validation.GetErrors()) > 0 || assignment.GetSkipTests() {
+			continue
+		}
+		result, err := ci.DryRun(logger, runner, course, assignment, solutions)
+		if err != nil {
+			validation.Errors = append(validation.Errors, "test run failed: "+err.Error())
+			continue
+		}
+		validation.Tested = true
+		validation.Score = result.TotalScore()
+		if assignment.GetAutoApprove() && validation.Score < assignment.GetScoreLimit() {
+			validation.Errors = append(validation.Errors, fmt.Sprintf("solution score %d%% is below the scorelimit %d%%", validation.Score, assignment.GetScoreLimit()))
+		}
+	}
+}
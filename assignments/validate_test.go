@@ -0,0 +1,70 @@
+package assignments
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestValidate(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+
+	labs := map[string]string{
+		"lab1": "assignmentid: 1\nskiptests: true\ndeadline: \"2020-08-30 23:59\"\n",
+		"lab2": "assignmentid: 1\nskiptests: true\ndeadline: \"next week\"\nscorelimit: 120\n",
+		"lab3": "assignmentid: 3\nscriptfile: \"missing.sh\"\ndeadline: \"2020-08-30 23:59\"\n",
+		"lab4": "assignmentid: 4\nskiptests: true\ndeadline: \"2020-08-30 23:59\"\nreleasedate: \"2020-09-01 12:00\"\n",
+		"lab5": "assignmentid: 5\nskiptests: true\ndeadline: \"2020-10-01 23:59\"\nreleasedate: \"2020-09-01 12:00\"\n",
+	}
+	for lab, content := range labs {
+		if err := os.Mkdir(filepath.Join(testsDir, lab), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(testsDir, lab, "assignment.yml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assignments, report := Validate(&pb.Course{}, testsDir)
+	if len(report.GetErrors()) > 0 {
+		t.Fatalf("Validate() errors = %v, want none", report.GetErrors())
+	}
+	if len(assignments) != 5 || len(report.GetAssignments()) != 5 {
+		t.Fatalf("Validate() returned %d assignments and %d reports, want 5", len(assignments), len(report.GetAssignments()))
+	}
+	wantErrors := map[string][]string{
+		"lab1": nil,
+		"lab2": {"deadline: " + invalidDate, "scorelimit 120", "assignmentid 1 already used by lab1"},
+		"lab3": {"scriptfile: "},
+		"lab4": {"releasedate is after the deadline"},
+		// dates are compared as dates, not as strings
+		"lab5": nil,
+	}
+	for _, validation := range report.GetAssignments() {
+		want := wantErrors[validation.GetName()]
+		if len(validation.GetErrors()) != len(want) {
+			t.Errorf("%s: errors = %v, want %d errors", validation.GetName(), validation.GetErrors(), len(want))
+			continue
+		}
+		for i, prefix := range want {
+			if !strings.HasPrefix(validation.GetErrors()[i], prefix) {
+				t.Errorf("%s: error %q, want prefix %q", validation.GetName(), validation.GetErrors()[i], prefix)
+			}
+		}
+	}
+}
+
+func TestValidateParseError(t *testing.T) {
+	_, report := Validate(&pb.Course{}, "invalid/dir")
+	if len(report.GetErrors()) != 1 {
+		t.Errorf("Validate() errors = %v, want 1 error", report.GetErrors())
+	}
+}
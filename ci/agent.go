@@ -0,0 +1,196 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/autograde/quickfeed/ci/agent"
+	"google.golang.org/grpc/metadata"
+)
+
+// DefaultHeartbeatInterval is the interval between an agent's heartbeats.
+// It must be shorter than the server's heartbeat timeout.
+const DefaultHeartbeatInterval = 10 * time.Second
+
+// maxAgentArtifactsSize is the maximum size of the artifacts archive that
+// an agent returns with a job's result; larger archives are dropped.
+const maxAgentArtifactsSize = 32 << 20 // bytes
+
+// maxAgentLogSize is the maximum size of the compressed complete log that
+// an agent returns with a job's result; larger logs are dropped.
+const maxAgentLogSize = 8 << 20 // bytes
+
+// MaxAgentMessageSize is the maximum size of the messages that the server receives
+// from agents, the largest being a job's result with its output, compressed log and
+// artifacts archive.
+var MaxAgentMessageSize = maxAgentArtifactsSize + maxAgentLogSize + maxLogSize + maxToScan + lastSegmentSize + 1<<20
+
+// Agent runs the jobs dispatched by a QuickFeed server on its runner,
+// typically a Docker runner on the agent's host.
+type Agent struct {
+	// Name identifies the agent to the server.
+	Name string
+	// Labels lists the agent's labels; jobs are only dispatched to agents
+	// that have all the labels required by the job.
+	Labels []string
+	// Capacity is the number of jobs the agent can run concurrently.
+	Capacity int
+	// Runner runs the agent's jobs.
+	Runner Runner
+	// Heartbeat is the interval between the agent's heartbeats.
+	Heartbeat time.Duration
+}
+
+// agentSession is an agent's connection to the server.
+type agentSession struct {
+	sendMu sync.Mutex
+	stream agent.RunnerService_ConnectClient
+
+	mu   sync.Mutex
+	jobs map[string]context.CancelFunc // cancel function of each running job
+}
+
+// Serve registers the agent with the server using the given token, and runs the jobs
+// it receives until the connection fails or the context is canceled. Jobs that are
+// running when Serve returns are canceled; the server reschedules them.
+func (a *Agent) Serve(ctx context.Context, client agent.RunnerServiceClient, token string) error {
+	if a.Capacity < 1 {
+		return errors.New("agent capacity must be positive")
+	}
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(ctx, agentAuthKey, "Bearer "+token))
+	defer cancel()
+	stream, err := client.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	s := &agentSession{stream: stream, jobs: make(map[string]context.CancelFunc)}
+	if err := s.send(&agent.AgentMessage{Message: &agent.AgentMessage_Register{Register: &agent.Register{
+		Name:     a.Name,
+		Labels:   a.Labels,
+		Capacity: uint32(a.Capacity),
+	}}}); err != nil {
+		return err
+	}
+	go a.heartbeats(ctx, s)
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch m := msg.GetMessage().(type) {
+		case *agent.ServerMessage_Job:
+			go a.run(ctx, s, m.Job)
+		case *agent.ServerMessage_Cancel:
+			s.cancel(m.Cancel.GetJobID())
+		}
+	}
+}
+
+// heartbeats sends the agent's heartbeats until the context is canceled.
+func (a *Agent) heartbeats(ctx context.Context, s *agentSession) {
+	interval := a.Heartbeat
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a failed send is detected by the receiving end of the stream
+			_ = s.send(&agent.AgentMessage{Message: &agent.AgentMessage_Heartbeat{Heartbeat: &agent.Heartbeat{
+				Running:  uint32(s.running()),
+				Capacity: uint32(a.Capacity),
+			}}})
+		}
+	}
+}
+
+// run runs the job on the agent's runner and sends its result to the server.
+func (a *Agent) run(ctx context.Context, s *agentSession, j *agent.Job) {
+	timeout := time.Duration(j.GetTimeout()) * time.Millisecond
+	if timeout <= 0 {
+		timeout = containerTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	s.start(j.GetJobID(), cancel)
+	defer s.cancel(j.GetJobID())
+
+	job := &Job{
+		Name:         j.GetName(),
+		Image:        j.GetImage(),
+		Commands:     j.GetCommands(),
+		ArtifactsDir: j.GetArtifactsDir(),
+		Credentials:  j.GetCredentials(),
+	}
+	var log string
+	job.CollectLog = func(all string) {
+		log = all
+	}
+	var artifacts []byte
+	// artifacts are only collected from the artifacts folder, not from other folders of the container
+	if isArtifactsDir(job.ArtifactsDir) {
+		job.CollectArtifacts = func(archive io.Reader) {
+			b, err := ioutil.ReadAll(io.LimitReader(archive, maxAgentArtifactsSize+1))
+			if err == nil && len(b) <= maxAgentArtifactsSize {
+				artifacts = b
+			}
+		}
+	}
+	out, err := a.Runner.Run(ctx, job)
+	if ctx.Err() == context.Canceled {
+		// the job was canceled by the server, or the agent is disconnecting
+		return
+	}
+	result := &agent.JobResult{JobID: j.GetJobID(), Output: out, Artifacts: artifacts}
+	if log != "" && log != out {
+		// the complete log is only sent if the output was truncated
+		if compressed, err := compressLog(log); err == nil && len(compressed) <= maxAgentLogSize {
+			result.Log = compressed
+		}
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.Timeout = errors.Is(err, context.DeadlineExceeded)
+	}
+	// a failed send is detected by the receiving end of the stream
+	_ = s.send(&agent.AgentMessage{Message: &agent.AgentMessage_Result{Result: result}})
+}
+
+// send sends the message to the server; gRPC streams do not support concurrent sends.
+func (s *agentSession) send(msg *agent.AgentMessage) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.stream.Send(msg)
+}
+
+// start records the cancel function of a running job.
+func (s *agentSession) start(jobID string, cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[jobID] = cancel
+}
+
+// cancel stops the given job, if running.
+func (s *agentSession) cancel(jobID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cancel, ok := s.jobs[jobID]; ok {
+		cancel()
+		delete(s.jobs, jobID)
+	}
+}
+
+// running returns the number of jobs the agent is running.
+func (s *agentSession) running() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.jobs)
+}
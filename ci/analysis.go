@@ -0,0 +1,213 @@
+package ci
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+const (
+	// analysisPrefix prefixes the line in the build log, printed after each
+	// analyzer has run, that holds the analyzer's name and exit status.
+	analysisPrefix = "QuickFeed analysis: "
+	// findingPrefix prefixes the lines in the build log that hold an
+	// analyzer's findings, one finding per line after the analyzer's name.
+	findingPrefix = "QuickFeed finding: "
+	// analysisOutput holds the output of the running analyzer inside the container.
+	analysisOutput = "/tmp/quickfeed-analysis"
+	// analysisTestPrefix prefixes the test name of the analyzers' score entries.
+	analysisTestPrefix = "analysis/"
+
+	defaultDeduction    = 1
+	defaultMaxDeduction = 10
+)
+
+// analyzerCommands maps the supported analyzers to the command that runs them.
+// The command must print the findings one per line, starting with the file name.
+var analyzerCommands = map[string]string{
+	"gofmt":       "gofmt -l .",
+	"govet":       "go vet ./...",
+	"staticcheck": "staticcheck ./...",
+	"golint":      "golint ./...",
+}
+
+// findingPattern matches findings that start with the name of a Go file, optionally followed by a position.
+var findingPattern = regexp.MustCompile(`^(\S+\.go)(:\d+(:\d+)?)?(:|$)`)
+
+// Analyzer describes a static analyzer whose findings count towards an assignment's score.
+type Analyzer struct {
+	Name string `json:"name"`
+	// Weight is the weight of the analyzer's score entry.
+	Weight int `json:"weight"`
+	// Deduction is the number of points deducted for each finding.
+	Deduction int `json:"deduction"`
+	// MaxDeduction is the maximum number of points deducted,
+	// i.e., the max score of the analyzer's score entry.
+	MaxDeduction int `json:"maxdeduction"`
+}
+
+// AnalysisResult holds the findings of one analyzer.
+type AnalysisResult struct {
+	Analyzer string   `json:"analyzer"`
+	Failed   bool     `json:"failed,omitempty"` // the analyzer could not be run
+	Findings []string `json:"findings,omitempty"`
+}
+
+// EncodeAnalyzers checks the given analyzers, sets default values for unset fields,
+// and returns the analyzers encoded for the assignment's analyzers field.
+func EncodeAnalyzers(analyzers []*Analyzer) (string, error) {
+	if len(analyzers) == 0 {
+		return "", nil
+	}
+	names := make(map[string]bool)
+	for _, analyzer := range analyzers {
+		if _, ok := analyzerCommands[analyzer.Name]; !ok {
+			return "", fmt.Errorf("unknown analyzer %q; must be one of %s", analyzer.Name, strings.Join(supportedAnalyzers(), ", "))
+		}
+		if names[analyzer.Name] {
+			return "", fmt.Errorf("analyzer %s configured more than once", analyzer.Name)
+		}
+		names[analyzer.Name] = true
+		if analyzer.Weight < 0 || analyzer.Deduction < 0 || analyzer.MaxDeduction < 0 {
+			return "", fmt.Errorf("analyzer %s: weight and deductions cannot be negative", analyzer.Name)
+		}
+		if analyzer.Weight == 0 {
+			analyzer.Weight = 1
+		}
+		if analyzer.Deduction == 0 {
+			analyzer.Deduction = defaultDeduction
+		}
+		if analyzer.MaxDeduction == 0 {
+			analyzer.MaxDeduction = defaultMaxDeduction
+		}
+	}
+	b, err := json.Marshal(analyzers)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Analyzers returns the analyzers configured for the given assignment.
+func Analyzers(assignment *pb.Assignment) ([]*Analyzer, error) {
+	if assignment.GetAnalyzers() == "" {
+		return nil, nil
+	}
+	var analyzers []*Analyzer
+	if err := json.Unmarshal([]byte(assignment.GetAnalyzers()), &analyzers); err != nil {
+		return nil, fmt.Errorf("invalid analyzers for assignment %s: %w", assignment.GetName(), err)
+	}
+	return analyzers, nil
+}
+
+func supportedAnalyzers() []string {
+	var names []string
+	for name := range analyzerCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// analysisCommands returns the shell commands that run the given analyzers
+// in the current directory and print their findings, marked with the given
+// nonce, for ExtractResult.
+func analysisCommands(analyzers []*Analyzer, nonce string) string {
+	var cmds []string
+	for _, analyzer := range analyzers {
+		cmds = append(cmds,
+			analyzerCommands[analyzer.Name]+" > "+analysisOutput+" 2>&1",
+			fmt.Sprintf(`printf "%s%s %%d\n" $?`, marker(analysisPrefix, nonce), analyzer.Name),
+			fmt.Sprintf(`sed 's/^/%s%s /' %s`, marker(findingPrefix, nonce), analyzer.Name, analysisOutput),
+		)
+	}
+	if len(cmds) > 0 {
+		cmds = append(cmds, "rm -f "+analysisOutput)
+	}
+	return strings.Join(cmds, "\n")
+}
+
+// analysisResults collects the analysis results from the given build log lines.
+// Only the first status line of each analyzer is used, and only findings for
+// files other than test files are kept. An analyzer fails if it exits with a
+// status other than 0 or 1, where 1 is used by most analyzers to signal findings.
+type analysisResults struct {
+	results []*AnalysisResult
+	byName  map[string]*AnalysisResult
+}
+
+// add records the given analysis status or finding line, if it is marked with
+// the job's nonce, and returns true if the line was recorded.
+func (a *analysisResults) add(line, nonce string) bool {
+	if a.byName == nil {
+		a.byName = make(map[string]*AnalysisResult)
+	}
+	if payload, ok := markedLine(line, analysisPrefix, nonce); ok {
+		fields := strings.Fields(payload)
+		if len(fields) != 2 {
+			return true
+		}
+		if _, ok := a.byName[fields[0]]; ok {
+			return true
+		}
+		status, err := strconv.Atoi(fields[1])
+		result := &AnalysisResult{Analyzer: fields[0], Failed: err != nil || status > 1}
+		a.byName[result.Analyzer] = result
+		a.results = append(a.results, result)
+		return true
+	}
+	payload, ok := markedLine(line, findingPrefix, nonce)
+	if !ok {
+		return false
+	}
+	parts := strings.SplitN(payload, " ", 2)
+	if len(parts) != 2 {
+		return true
+	}
+	result, ok := a.byName[parts[0]]
+	if !ok || result.Failed {
+		return true
+	}
+	finding := strings.TrimPrefix(strings.TrimSpace(parts[1]), "./")
+	match := findingPattern.FindStringSubmatch(finding)
+	if match == nil || strings.HasSuffix(match[1], "_test.go") {
+		return true
+	}
+	if result.Analyzer == "gofmt" {
+		finding += ": file is not formatted with gofmt"
+	}
+	result.Findings = append(result.Findings, finding)
+	return true
+}
+
+// AddAnalysisScores adds a score entry for each of the given analyzers to the result,
+// deducting points for the analyzer's findings. Analyzers that failed or did not
+// run get a zero score.
+func (r *Result) AddAnalysisScores(analyzers []*Analyzer) {
+	byName := make(map[string]*AnalysisResult)
+	if r.BuildInfo != nil {
+		for _, result := range r.BuildInfo.Analysis {
+			byName[result.Analyzer] = result
+		}
+	}
+	for _, analyzer := range analyzers {
+		sc := &score.Score{
+			TestName: analysisTestPrefix + analyzer.Name,
+			MaxScore: analyzer.MaxDeduction,
+			Weight:   analyzer.Weight,
+		}
+		if result, ok := byName[analyzer.Name]; ok && !result.Failed {
+			deduction := len(result.Findings) * analyzer.Deduction
+			if deduction < analyzer.MaxDeduction {
+				sc.Score = analyzer.MaxDeduction - deduction
+			}
+		}
+		r.Scores = append(r.Scores, sc)
+	}
+}
@@ -0,0 +1,147 @@
+package ci
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+func TestEncodeAnalyzers(t *testing.T) {
+	encoded, err := EncodeAnalyzers([]*Analyzer{{Name: "gofmt"}, {Name: "govet", Weight: 2, Deduction: 5, MaxDeduction: 20}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyzers, err := Analyzers(&pb.Assignment{Analyzers: encoded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Analyzer{
+		{Name: "gofmt", Weight: 1, Deduction: defaultDeduction, MaxDeduction: defaultMaxDeduction},
+		{Name: "govet", Weight: 2, Deduction: 5, MaxDeduction: 20},
+	}
+	if diff := cmp.Diff(want, analyzers); diff != "" {
+		t.Errorf("Analyzers() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, invalid := range [][]*Analyzer{
+		{{Name: "pylint"}},
+		{{Name: "gofmt"}, {Name: "gofmt"}},
+		{{Name: "govet", Deduction: -1}},
+	} {
+		if _, err := EncodeAnalyzers(invalid); err == nil {
+			t.Errorf("EncodeAnalyzers(%v): expected error", invalid)
+		}
+	}
+}
+
+func TestAnalysisScores(t *testing.T) {
+	out := strings.Join([]string{
+		"QuickFeed analysis: nonce gofmt 0",
+		"QuickFeed finding: nonce gofmt main.go",
+		"QuickFeed finding: nonce gofmt main_test.go",
+		"QuickFeed analysis: nonce govet 1",
+		"QuickFeed finding: nonce govet # lab1",
+		"QuickFeed finding: nonce govet ./main.go:10:2: unreachable code",
+		"QuickFeed finding: nonce govet ./util.go:3:1: result of fmt.Sprintf call not used",
+		"QuickFeed analysis: nonce staticcheck 127",
+		"QuickFeed finding: nonce staticcheck sh: staticcheck: not found",
+		"=== RUN   TestA",
+		// printed by student code without the nonce; kept in the log
+		"QuickFeed analysis: staticcheck 0",
+		"QuickFeed finding: gofmt util.go",
+	}, "\n")
+	result, err := ExtractResult(zap.NewNop().Sugar(), out, "secret", "nonce", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantAnalysis := []*AnalysisResult{
+		{Analyzer: "gofmt", Findings: []string{"main.go: file is not formatted with gofmt"}},
+		{Analyzer: "govet", Findings: []string{"main.go:10:2: unreachable code", "util.go:3:1: result of fmt.Sprintf call not used"}},
+		{Analyzer: "staticcheck", Failed: true},
+	}
+	if diff := cmp.Diff(wantAnalysis, result.BuildInfo.Analysis); diff != "" {
+		t.Errorf("Analysis mismatch (-want +got):\n%s", diff)
+	}
+	if want := "=== RUN   TestA\nQuickFeed analysis: staticcheck 0\nQuickFeed finding: gofmt util.go"; result.BuildInfo.BuildLog != want {
+		t.Errorf("BuildLog = %q, want %q", result.BuildInfo.BuildLog, want)
+	}
+
+	result.AddAnalysisScores([]*Analyzer{
+		{Name: "gofmt", Weight: 1, Deduction: 2, MaxDeduction: 10},
+		{Name: "govet", Weight: 2, Deduction: 6, MaxDeduction: 10},
+		{Name: "staticcheck", Weight: 1, Deduction: 1, MaxDeduction: 10},
+		{Name: "golint", Weight: 1, Deduction: 1, MaxDeduction: 10},
+	})
+	wantScores := []*score.Score{
+		{TestName: "analysis/gofmt", Score: 8, MaxScore: 10, Weight: 1},
+		{TestName: "analysis/govet", Score: 0, MaxScore: 10, Weight: 2},
+		{TestName: "analysis/staticcheck", Score: 0, MaxScore: 10, Weight: 1},
+		{TestName: "analysis/golint", Score: 0, MaxScore: 10, Weight: 1},
+	}
+	if diff := cmp.Diff(wantScores, result.Scores); diff != "" {
+		t.Errorf("Scores mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRunAnalysis(t *testing.T) {
+	if _, err := exec.LookPath("gofmt"); err != nil {
+		t.Skip("This test requires gofmt")
+	}
+	dir, err := ioutil.TempDir("", "analysis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"formatted.go":   "package lab\n",
+		"unformatted.go": "package  lab\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runner := Local{}
+	out, err := runner.Run(context.Background(), &Job{
+		Commands: []string{"cd " + dir, analysisCommands([]*Analyzer{{Name: "gofmt"}}, "nonce")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var analysis analysisResults
+	for _, line := range strings.Split(out, "\n") {
+		analysis.add(line, "nonce")
+	}
+	want := []*AnalysisResult{
+		{Analyzer: "gofmt", Findings: []string{"unformatted.go: file is not formatted with gofmt"}},
+	}
+	if diff := cmp.Diff(want, analysis.results); diff != "" {
+		t.Errorf("analysis results mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidateScriptAnalysis(t *testing.T) {
+	analyzers, err := EncodeAnalyzers([]*Analyzer{{Name: "gofmt"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{Name: "lab1", ScriptFile: "go.sh", Analyzers: analyzers}
+	assignment.ScriptTemplate = "#image/quickfeed:go\n{{ .Analysis }}\ngo test ./..."
+	if err := ValidateScript(&pb.Course{}, assignment); err != nil {
+		t.Errorf("ValidateScript() = %v, want nil", err)
+	}
+	assignment.ScriptTemplate = "#image/quickfeed:go\ngo test ./..."
+	if err := ValidateScript(&pb.Course{}, assignment); err == nil {
+		t.Error("ValidateScript() = nil, want error for template without analysis")
+	}
+}
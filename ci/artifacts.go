@@ -0,0 +1,202 @@
+package ci
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+const (
+	// DefaultArtifactsDir is the folder in the container from which build artifacts
+	// are collected, unless the assignment specifies another folder.
+	DefaultArtifactsDir = "/quickfeed/artifacts"
+	// stagingDir is the folder in the artifact store that holds the artifacts
+	// collected from a build until they are saved for the build's submission.
+	stagingDir = ".staging"
+)
+
+var (
+	// ErrArtifactQuota indicates that a build's artifacts exceed the store's quota.
+	ErrArtifactQuota = errors.New("artifacts exceed quota")
+	// ErrArtifactNotFound indicates that a submission has no artifact with the given name.
+	ErrArtifactNotFound = errors.New("artifact not found")
+)
+
+// ArtifactStore keeps the files collected from the builds of submissions in
+// the file system. Each submission's artifacts are kept in a separate folder,
+// and are replaced by the artifacts of the submission's next build.
+type ArtifactStore struct {
+	mu       sync.Mutex
+	root     string
+	maxSize  int64 // maximum total size of a build's artifacts, in bytes
+	maxFiles int   // maximum number of artifacts of a build
+}
+
+// NewArtifactStore returns an artifact store in the given folder, keeping
+// at most maxFiles artifacts of at most maxSize bytes in total per build.
+func NewArtifactStore(root string, maxSize int64, maxFiles int) (*ArtifactStore, error) {
+	if maxSize < 1 || maxFiles < 1 {
+		return nil, fmt.Errorf("invalid artifact quota: %d bytes, %d files", maxSize, maxFiles)
+	}
+	if err := os.MkdirAll(filepath.Join(root, stagingDir), 0700); err != nil {
+		return nil, err
+	}
+	return &ArtifactStore{root: root, maxSize: maxSize, maxFiles: maxFiles}, nil
+}
+
+// CheckArtifactsDir returns an error if the given artifacts folder of an assignment
+// is not empty, i.e., the default, or a clean relative path of a subfolder of the
+// default artifacts folder. Other folders of the container, such as the home folder,
+// may hold files that must not be served to students.
+func CheckArtifactsDir(dir string) error {
+	if dir != "" && (path.IsAbs(dir) || path.Clean(dir) != dir || dir == "." || dir == ".." || strings.HasPrefix(dir, "../")) {
+		return fmt.Errorf("artifacts: %q must be a relative folder path inside %s", dir, DefaultArtifactsDir)
+	}
+	return nil
+}
+
+// artifactsDir returns the folder in the container from which the assignment's artifacts are collected.
+func artifactsDir(assignment *pb.Assignment) string {
+	return path.Join(DefaultArtifactsDir, assignment.GetArtifactsDir())
+}
+
+// isArtifactsDir returns true if the given folder is the default artifacts folder, or one of its subfolders.
+func isArtifactsDir(dir string) bool {
+	return path.Clean(dir) == dir && (dir == DefaultArtifactsDir || strings.HasPrefix(dir, DefaultArtifactsDir+"/"))
+}
+
+// collect extracts the regular files in the given tar archive of an artifacts
+// folder into a new staging folder, and returns the staging folder. The archive's
+// top-level folder is removed from the file names. If the files exceed the store's
+// quota, the files that fit are kept and ErrArtifactQuota is returned.
+func (s *ArtifactStore) collect(archive io.Reader) (string, error) {
+	dir, err := ioutil.TempDir(filepath.Join(s.root, stagingDir), "build")
+	if err != nil {
+		return "", err
+	}
+	var size int64
+	var files int
+	tr := tar.NewReader(archive)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return dir, nil
+		}
+		if err != nil {
+			return dir, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			// folders are created as needed; links and devices are ignored
+			continue
+		}
+		name, ok := artifactName(hdr.Name)
+		if !ok {
+			continue
+		}
+		files++
+		size += hdr.Size
+		if files > s.maxFiles || size > s.maxSize {
+			return dir, ErrArtifactQuota
+		}
+		if err := writeArtifact(filepath.Join(dir, filepath.FromSlash(name)), tr); err != nil {
+			return dir, err
+		}
+	}
+}
+
+// artifactName returns the given archive entry's name without its top-level
+// folder. False is returned if nothing remains of the name.
+func artifactName(entry string) (string, bool) {
+	// cleaning a rooted name removes any attempt to escape the folder
+	parts := strings.SplitN(strings.TrimPrefix(path.Clean("/"+entry), "/"), "/", 2)
+	if len(parts) < 2 || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// writeArtifact writes the contents of the given reader to the given file.
+func writeArtifact(file string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// save replaces the artifacts of the given submission with the artifacts in the given staging folder.
+func (s *ArtifactStore) save(staged string, submissionID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dir := s.dir(submissionID)
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	return os.Rename(staged, dir)
+}
+
+// discard removes the given staging folder, unless it has been saved.
+func (s *ArtifactStore) discard(staged string) {
+	os.RemoveAll(staged)
+}
+
+// dir returns the folder holding the artifacts of the given submission.
+func (s *ArtifactStore) dir(submissionID uint64) string {
+	return filepath.Join(s.root, strconv.FormatUint(submissionID, 10))
+}
+
+// List returns the artifacts of the given submission, sorted by name.
+func (s *ArtifactStore) List(submissionID uint64) ([]*pb.Artifact, error) {
+	dir := s.dir(submissionID)
+	var artifacts []*pb.Artifact
+	err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// the submission has no artifacts
+				return nil
+			}
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		name, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, &pb.Artifact{Name: filepath.ToSlash(name), FileSize: info.Size()})
+		return nil
+	})
+	return artifacts, err
+}
+
+// Path returns the location in the file system of the given artifact of a submission.
+func (s *ArtifactStore) Path(submissionID uint64, name string) (string, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return "", ErrArtifactNotFound
+	}
+	file := filepath.Join(s.dir(submissionID), filepath.FromSlash(name))
+	info, err := os.Lstat(file)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", ErrArtifactNotFound
+	}
+	return file, nil
+}
@@ -0,0 +1,143 @@
+package ci
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+// artifactsArchive returns a tar archive of an artifacts folder holding the given files.
+func artifactsArchive(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	if err := tw.WriteHeader(&tar.Header{Name: "artifacts/", Mode: 0755, Typeflag: tar.TypeDir}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "artifacts/link", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink}); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &archive
+}
+
+func TestArtifactStore(t *testing.T) {
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	store, err := NewArtifactStore(root, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	staged, err := store.collect(artifactsArchive(t, map[string]string{
+		"artifacts/coverage.out":      "mode",
+		"artifacts/plots/../plot.png": "png",
+		"artifacts/../../escape.txt":  "",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.save(staged, 1); err != nil {
+		t.Fatal(err)
+	}
+	artifacts, err := store.List(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the escaping name loses its top-level folder and is ignored
+	want := []*pb.Artifact{
+		{Name: "coverage.out", FileSize: 4},
+		{Name: "plot.png", FileSize: 3},
+	}
+	if diff := cmp.Diff(want, artifacts); diff != "" {
+		t.Errorf("List() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := store.Path(1, "link"); err != ErrArtifactNotFound {
+		t.Errorf("Path(link) = %v, want %v", err, ErrArtifactNotFound)
+	}
+	if _, err := store.Path(1, "../1/coverage.out"); err != ErrArtifactNotFound {
+		t.Errorf("Path(../1/coverage.out) = %v, want %v", err, ErrArtifactNotFound)
+	}
+	file, err := store.Path(1, "coverage.out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, err := ioutil.ReadFile(file); err != nil || string(content) != "mode" {
+		t.Errorf("Path(coverage.out) content = %q, %v, want %q", content, err, "mode")
+	}
+
+	// the next build's artifacts replace the previous artifacts, within the quota
+	staged, err = store.collect(artifactsArchive(t, map[string]string{
+		"artifacts/out.txt": "0123456789+",
+	}))
+	if err != ErrArtifactQuota {
+		t.Errorf("collect() = %v, want %v", err, ErrArtifactQuota)
+	}
+	if err := store.save(staged, 1); err != nil {
+		t.Fatal(err)
+	}
+	if artifacts, err := store.List(1); err != nil || len(artifacts) != 0 {
+		t.Errorf("List() = %v, %v, want no artifacts", artifacts, err)
+	}
+	if artifacts, err := store.List(2); err != nil || len(artifacts) != 0 {
+		t.Errorf("List() for submission without artifacts = %v, %v, want no artifacts", artifacts, err)
+	}
+}
+
+func TestArtifactName(t *testing.T) {
+	tests := []struct {
+		entry, want string
+		ok          bool
+	}{
+		{"artifacts/report.txt", "report.txt", true},
+		{"artifacts/plots/fig.png", "plots/fig.png", true},
+		{"artifacts/../../etc/passwd", "passwd", true},
+		{"artifacts", "", false},
+		{"artifacts/", "", false},
+	}
+	for _, test := range tests {
+		got, ok := artifactName(test.entry)
+		if got != test.want || ok != test.ok {
+			t.Errorf("artifactName(%q) = %q, %t, want %q, %t", test.entry, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestCheckArtifactsDir(t *testing.T) {
+	for _, dir := range []string{"", "plots", "reports/html"} {
+		if err := CheckArtifactsDir(dir); err != nil {
+			t.Errorf("CheckArtifactsDir(%q) = %v, want <nil>", dir, err)
+		}
+		if got := artifactsDir(&pb.Assignment{ArtifactsDir: dir}); !isArtifactsDir(got) {
+			t.Errorf("artifactsDir(%q) = %q, want folder inside %s", dir, got, DefaultArtifactsDir)
+		}
+	}
+	for _, dir := range []string{"/", DefaultArtifactsDir, "/root", "/tmp/plots", ".", "..", "../../root", "plots/../..", "plots/"} {
+		if err := CheckArtifactsDir(dir); err == nil {
+			t.Errorf("CheckArtifactsDir(%q) = <nil>, want error", dir)
+		}
+	}
+	for _, dir := range []string{"/root", "/quickfeed/artifacts-other", "/quickfeed/artifacts/../../root"} {
+		if isArtifactsDir(dir) {
+			t.Errorf("isArtifactsDir(%q) = true, want false", dir)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package ci
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// benchmarkTestPrefix prefixes the test name of the benchmark score entries.
+const benchmarkTestPrefix = "benchmark/"
+
+// Benchmark describes how a benchmark run by the assignment's tests counts
+// towards the assignment's score. Each threshold that is set adds a point
+// to the benchmark's score entry, which is passed if the benchmark's
+// metric does not exceed the threshold.
+type Benchmark struct {
+	// Name is the name of the test that runs the benchmark.
+	Name string `json:"name"`
+	// MaxNsPerOp is the maximum nanoseconds per iteration; not scored if zero.
+	MaxNsPerOp int64 `json:"maxnsperop,omitempty"`
+	// MaxAllocsPerOp is the maximum allocations per iteration; not scored if zero.
+	MaxAllocsPerOp int64 `json:"maxallocsperop,omitempty"`
+	// Weight is the weight of the benchmark's score entry.
+	Weight int `json:"weight"`
+}
+
+// BenchmarkResult holds the metrics of a benchmark run by the assignment's tests.
+type BenchmarkResult struct {
+	Name        string `json:"name"`
+	NsPerOp     int64  `json:"nsperop"`
+	AllocsPerOp int64  `json:"allocsperop"`
+	BytesPerOp  int64  `json:"bytesperop"`
+}
+
+// EncodeBenchmarks checks the given benchmark thresholds, sets default values
+// for unset fields, and returns them encoded for the assignment's benchmarks field.
+func EncodeBenchmarks(benchmarks []*Benchmark) (string, error) {
+	if len(benchmarks) == 0 {
+		return "", nil
+	}
+	seen := make(map[string]bool)
+	for _, bm := range benchmarks {
+		if bm.Name == "" {
+			return "", fmt.Errorf("benchmarks: missing name")
+		}
+		if seen[bm.Name] {
+			return "", fmt.Errorf("benchmarks: duplicate benchmark %s", bm.Name)
+		}
+		seen[bm.Name] = true
+		if bm.MaxNsPerOp < 0 || bm.MaxAllocsPerOp < 0 || bm.Weight < 0 {
+			return "", fmt.Errorf("benchmarks: thresholds and weight of %s cannot be negative", bm.Name)
+		}
+		if bm.Weight == 0 {
+			bm.Weight = 1
+		}
+	}
+	b, err := json.Marshal(benchmarks)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Benchmarks returns the benchmark thresholds of the given assignment.
+func Benchmarks(assignment *pb.Assignment) ([]*Benchmark, error) {
+	if assignment.GetBenchmarks() == "" {
+		return nil, nil
+	}
+	var benchmarks []*Benchmark
+	if err := json.Unmarshal([]byte(assignment.GetBenchmarks()), &benchmarks); err != nil {
+		return nil, fmt.Errorf("invalid benchmarks for assignment %s: %w", assignment.GetName(), err)
+	}
+	return benchmarks, nil
+}
+
+// benchmarkResults collects the benchmarks from the build log lines.
+// The last result of each benchmark is kept.
+type benchmarkResults struct {
+	results []*BenchmarkResult
+	index   map[string]int
+}
+
+// add records the benchmark of the given line, if it holds the given secret.
+func (b *benchmarkResults) add(line, secret string) error {
+	bm, err := score.ParseBenchmark(line, secret)
+	if err != nil {
+		return err
+	}
+	result := &BenchmarkResult{
+		Name:        bm.Benchmark,
+		NsPerOp:     bm.NsPerOp,
+		AllocsPerOp: bm.AllocsPerOp,
+		BytesPerOp:  bm.BytesPerOp,
+	}
+	if i, ok := b.index[result.Name]; ok {
+		b.results[i] = result
+		return nil
+	}
+	if b.index == nil {
+		b.index = make(map[string]int)
+	}
+	b.index[result.Name] = len(b.results)
+	b.results = append(b.results, result)
+	return nil
+}
+
+// AddBenchmarkScores adds a score entry for each benchmark to the result.
+// A benchmark that was not run gets no points.
+func (r *Result) AddBenchmarkScores(benchmarks []*Benchmark) {
+	results := make(map[string]*BenchmarkResult)
+	if r.BuildInfo != nil {
+		for _, result := range r.BuildInfo.Benchmarks {
+			results[result.Name] = result
+		}
+	}
+	for _, bm := range benchmarks {
+		sc := &score.Score{
+			TestName: benchmarkTestPrefix + bm.Name,
+			Weight:   bm.Weight,
+		}
+		result := results[bm.Name]
+		if bm.MaxNsPerOp > 0 {
+			sc.MaxScore++
+			if result != nil && result.NsPerOp <= bm.MaxNsPerOp {
+				sc.Score++
+			}
+		}
+		if bm.MaxAllocsPerOp > 0 {
+			sc.MaxScore++
+			if result != nil && result.AllocsPerOp <= bm.MaxAllocsPerOp {
+				sc.Score++
+			}
+		}
+		if sc.MaxScore == 0 {
+			// the benchmark is only recorded for the leaderboard
+			continue
+		}
+		r.Scores = append(r.Scores, sc)
+	}
+}
@@ -0,0 +1,67 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+func TestEncodeBenchmarks(t *testing.T) {
+	benchmarks := []*Benchmark{{Name: "TestSort", MaxNsPerOp: 1000}}
+	if _, err := EncodeBenchmarks(benchmarks); err != nil {
+		t.Fatal(err)
+	}
+	if benchmarks[0].Weight != 1 {
+		t.Errorf("Weight = %d, want default weight 1", benchmarks[0].Weight)
+	}
+	for _, invalid := range [][]*Benchmark{
+		{{MaxNsPerOp: 1000}},
+		{{Name: "TestSort"}, {Name: "TestSort"}},
+		{{Name: "TestSort", MaxNsPerOp: -1}},
+		{{Name: "TestSort", Weight: -1}},
+	} {
+		if _, err := EncodeBenchmarks(invalid); err == nil {
+			t.Errorf("EncodeBenchmarks(%+v): expected error", invalid)
+		}
+	}
+}
+
+func TestBenchmarkScores(t *testing.T) {
+	out := strings.Join([]string{
+		"=== RUN   TestSort",
+		`{"Benchmark":"TestSort","Secret":"secret","N":100,"NsPerOp":2000,"AllocsPerOp":5,"BytesPerOp":80}`,
+		// the last result of a benchmark is recorded
+		`{"Benchmark":"TestSort","Secret":"secret","N":100,"NsPerOp":900,"AllocsPerOp":3,"BytesPerOp":48}`,
+		// printed by student code without the secret
+		`{"Benchmark":"TestSearch","Secret":"fake","N":100,"NsPerOp":1,"AllocsPerOp":0,"BytesPerOp":0}`,
+		"--- PASS: TestSort (1.20s)",
+	}, "\n")
+	result, err := ExtractResult(zap.NewNop().Sugar(), out, "secret", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*BenchmarkResult{{Name: "TestSort", NsPerOp: 900, AllocsPerOp: 3, BytesPerOp: 48}}
+	if diff := cmp.Diff(want, result.BuildInfo.Benchmarks); diff != "" {
+		t.Errorf("Benchmarks mismatch (-want +got):\n%s", diff)
+	}
+	if strings.Contains(result.BuildInfo.BuildLog, "secret") {
+		t.Errorf("BuildLog contains the secret: %s", result.BuildInfo.BuildLog)
+	}
+
+	result.AddBenchmarkScores([]*Benchmark{
+		{Name: "TestSort", MaxNsPerOp: 1000, MaxAllocsPerOp: 2, Weight: 2},
+		{Name: "TestSearch", MaxNsPerOp: 100, Weight: 1},
+		// recorded for the leaderboard only
+		{Name: "TestMerge", Weight: 1},
+	})
+	wantScores := []*score.Score{
+		{TestName: "benchmark/TestSort", Score: 1, MaxScore: 2, Weight: 2},
+		{TestName: "benchmark/TestSearch", Score: 0, MaxScore: 1, Weight: 1},
+	}
+	if diff := cmp.Diff(wantScores, result.Scores); diff != "" {
+		t.Errorf("Scores mismatch (-want +got):\n%s", diff)
+	}
+}
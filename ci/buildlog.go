@@ -0,0 +1,68 @@
+package ci
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBuildLogSize is the maximum size of the complete log of a build
+	// that is stored; the rest of the log is dropped.
+	maxBuildLogSize = 16 << 20 // bytes
+	// buildLogTruncated ends a complete log that exceeds the maximum build log size.
+	buildLogTruncated = "\n...\nlog truncated at 16 MB"
+)
+
+// compressLog returns the gzip compressed log, truncated to the maximum build log size.
+func compressLog(log string) ([]byte, error) {
+	if len(log) > maxBuildLogSize {
+		log = log[:maxBuildLogSize] + buildLogTruncated
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.WriteString(zw, log); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressLog returns the log compressed by gzip, such as the log of a build log record.
+func DecompressLog(data []byte) (string, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+	log, err := ioutil.ReadAll(io.LimitReader(zr, maxBuildLogSize+int64(len(buildLogTruncated))))
+	if err != nil {
+		return "", err
+	}
+	return string(log), nil
+}
+
+// recordBuildLog stores the complete log of the latest build of the given
+// submission, replacing the log of the previous build. The session secret
+// is hidden in the stored log.
+func recordBuildLog(logger *zap.SugaredLogger, db database.Database, submissionID uint64, log, secret string) {
+	if secret != "" {
+		log = strings.ReplaceAll(log, secret, "hidden")
+	}
+	compressed, err := compressLog(log)
+	if err != nil {
+		logger.Errorf("Failed to compress build log for submission %d: %w", submissionID, err)
+		return
+	}
+	if err := db.UpdateBuildLog(&pb.BuildLog{SubmissionID: submissionID, Log: compressed}); err != nil {
+		logger.Errorf("Failed to record build log for submission %d: %w", submissionID, err)
+	}
+}
@@ -0,0 +1,43 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompressLog(t *testing.T) {
+	tests := []struct {
+		name string
+		log  string
+		want string
+	}{
+		{"short log", "some output\nmore output", "some output\nmore output"},
+		{"long log", strings.Repeat("a", maxBuildLogSize+10), strings.Repeat("a", maxBuildLogSize) + buildLogTruncated},
+	}
+	for _, test := range tests {
+		compressed, err := compressLog(test.log)
+		if err != nil {
+			t.Fatal(err)
+		}
+		log, err := DecompressLog(compressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if log != test.want {
+			t.Errorf("%s: DecompressLog(compressLog()) gave %d bytes, want %d bytes", test.name, len(log), len(test.want))
+		}
+	}
+}
+
+func TestJobOutput(t *testing.T) {
+	all := strings.Repeat("line of output\n", 2*maxLogSize/15)
+	var log string
+	job := &Job{CollectLog: func(l string) { log = l }}
+	out := jobOutput(job, all)
+	if log != all {
+		t.Errorf("collected log has %d bytes, want %d bytes", len(log), len(all))
+	}
+	if len(out) >= len(all) {
+		t.Errorf("jobOutput() returned %d bytes, want output truncated to fewer than %d bytes", len(out), len(all))
+	}
+}
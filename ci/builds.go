@@ -0,0 +1,142 @@
+package ci
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Builds tracks the running builds of each assignment in each user or group
+// repository, so that builds superseded by a newer push can be canceled.
+// Builds also queues builds waiting for a worker, giving priority to builds
+// of assignments whose deadline is near, and postpones the builds of users
+// and groups that are over their build quota.
+type Builds struct {
+	mu      sync.Mutex
+	running map[buildKey]map[*build]bool
+	lanes   [numLanes]*lane
+	pushes  map[buildKey]time.Time // time of the latest push of each build key
+	seq     uint64
+	now     func() time.Time
+
+	quota   int
+	window  time.Duration
+	started map[buildKey][]time.Time // start times of the builds of each user or group within the window
+	pending map[buildKey]*pendingBuild
+}
+
+// buildKey identifies the builds of an assignment for a user or group.
+type buildKey struct {
+	assignmentID uint64
+	userID       uint64
+	groupID      uint64
+}
+
+// build is a running build.
+type build struct {
+	// pushTime is the time at which the push was received, or zero for rebuilds.
+	pushTime time.Time
+	cancel   context.CancelFunc
+}
+
+// NewBuilds returns a tracker of running builds that runs at most the given
+// number of builds of pushed commits, and of rebuilds, concurrently.
+// Zero means no limit.
+func NewBuilds(workers, rebuildWorkers int) *Builds {
+	b := &Builds{
+		running: make(map[buildKey]map[*build]bool),
+		pushes:  make(map[buildKey]time.Time),
+		now:     time.Now,
+		started: make(map[buildKey][]time.Time),
+		pending: make(map[buildKey]*pendingBuild),
+	}
+	b.lanes[PushLane] = &lane{workers: workers}
+	b.lanes[RebuildLane] = &lane{workers: rebuildWorkers}
+	return b
+}
+
+// newBuildKey returns the key of the builds of the run data's assignment and repository.
+func newBuildKey(rData *RunData) buildKey {
+	return buildKey{
+		assignmentID: rData.Assignment.GetID(),
+		userID:       rData.Repo.GetUserID(),
+		groupID:      rData.Repo.GetGroupID(),
+	}
+}
+
+// start registers a build for the run data, and returns the build's context and
+// a function to call when the build is done. Running builds of older pushes of
+// the same assignment and repository are canceled. If a build of a newer push
+// is running, the build is superseded and false is returned. Builds without a
+// push time, such as rebuilds, neither supersede nor are superseded.
+func (b *Builds) start(rData *RunData) (context.Context, func(), bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := newBuildKey(rData)
+	pushTime := rData.PushTime
+	if !pushTime.IsZero() {
+		for other := range b.running[key] {
+			if other.pushTime.After(pushTime) {
+				return nil, nil, false
+			}
+		}
+		for other := range b.running[key] {
+			if !other.pushTime.IsZero() {
+				other.cancel()
+				delete(b.running[key], other)
+			}
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	bld := &build{pushTime: pushTime, cancel: cancel}
+	if b.running[key] == nil {
+		b.running[key] = make(map[*build]bool)
+	}
+	b.running[key][bld] = true
+	return ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		cancel()
+		delete(b.running[key], bld)
+		if len(b.running[key]) == 0 {
+			delete(b.running, key)
+		}
+	}, true
+}
+
+// Cancel cancels the running and pending builds of the given assignment for the given
+// user or group, and returns the number of builds canceled.
+func (b *Builds) Cancel(assignmentID, userID, groupID uint64) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := buildKey{assignmentID: assignmentID, userID: userID, groupID: groupID}
+	canceled := len(b.running[key])
+	for bld := range b.running[key] {
+		bld.cancel()
+	}
+	delete(b.running, key)
+	if p := b.pending[key]; p != nil {
+		p.timer.Stop()
+		delete(b.pending, key)
+		canceled++
+	}
+	return canceled
+}
+
+// newerPush returns true if the given submission was built from a push that
+// was received after the given push time. Submissions without a push date,
+// and builds without a push time, are not ordered.
+func newerPush(submission *pb.Submission, pushTime time.Time) bool {
+	if pushTime.IsZero() || submission.GetBuildInfo() == "" {
+		return false
+	}
+	var buildInfo BuildInfo
+	if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err != nil || buildInfo.PushDate == "" {
+		return false
+	}
+	submissionPushTime, err := time.Parse(time.RFC3339Nano, buildInfo.PushDate)
+	return err == nil && submissionPushTime.After(pushTime)
+}
@@ -0,0 +1,94 @@
+package ci
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestBuildsSupersede(t *testing.T) {
+	builds := NewBuilds(0, 0)
+	push := func(pushTime time.Time) *RunData {
+		return &RunData{
+			Assignment: &pb.Assignment{ID: 1},
+			Repo:       &pb.Repository{UserID: 2},
+			PushTime:   pushTime,
+		}
+	}
+	now := time.Now()
+
+	ctx1, done1, ok := builds.start(push(now))
+	if !ok {
+		t.Fatal("start() of first push = false, want true")
+	}
+	defer done1()
+	rebuildCtx, doneRebuild, ok := builds.start(push(time.Time{}))
+	if !ok {
+		t.Fatal("start() of rebuild = false, want true")
+	}
+	defer doneRebuild()
+
+	// a newer push cancels the running push, but not the rebuild
+	ctx2, done2, ok := builds.start(push(now.Add(time.Minute)))
+	if !ok {
+		t.Fatal("start() of newer push = false, want true")
+	}
+	defer done2()
+	if ctx1.Err() == nil {
+		t.Error("build of older push not canceled")
+	}
+	if rebuildCtx.Err() != nil {
+		t.Error("rebuild canceled by push")
+	}
+
+	// an older push arriving late is superseded by the running build of a newer push
+	if _, _, ok := builds.start(push(now.Add(time.Second))); ok {
+		t.Error("start() of older push = true, want false")
+	}
+	if ctx2.Err() != nil {
+		t.Error("build of newer push canceled by older push")
+	}
+
+	// builds of other users are not affected
+	other := push(now.Add(time.Second))
+	other.Repo.UserID = 3
+	if _, doneOther, ok := builds.start(other); !ok {
+		t.Error("start() of other user's push = false, want true")
+	} else {
+		doneOther()
+	}
+
+	if canceled := builds.Cancel(1, 2, 0); canceled != 2 {
+		t.Errorf("Cancel() = %d, want 2", canceled)
+	}
+	if ctx2.Err() == nil || rebuildCtx.Err() == nil {
+		t.Error("Cancel() did not cancel running builds")
+	}
+	if canceled := builds.Cancel(1, 2, 0); canceled != 0 {
+		t.Errorf("Cancel() without running builds = %d, want 0", canceled)
+	}
+}
+
+func TestNewerCommit(t *testing.T) {
+	pushTime := time.Date(2020, 11, 11, 13, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		buildInfo string
+		pushTime  time.Time
+		want      bool
+	}{
+		{"NewerCommit", `{"pushdate":"2020-11-11T14:00:00Z"}`, pushTime, true},
+		{"OlderCommit", `{"pushdate":"2020-11-11T12:00:00Z"}`, pushTime, false},
+		{"NewerCommitOtherZone", `{"pushdate":"2020-11-11T14:30:00+01:00"}`, pushTime, true},
+		{"NoPushDate", `{"builddate":"2020-11-11T14:00:00"}`, pushTime, false},
+		{"Rebuild", `{"pushdate":"2020-11-11T14:00:00Z"}`, time.Time{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := newerPush(&pb.Submission{BuildInfo: test.buildInfo}, test.pushTime); got != test.want {
+				t.Errorf("newerPush(%s, %v) = %t, want %t", test.buildInfo, test.pushTime, got, test.want)
+			}
+		})
+	}
+}
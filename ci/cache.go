@@ -0,0 +1,173 @@
+package ci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/jinzhu/gorm"
+)
+
+// cacheKeyTimeout is the maximum time allowed to compute a result cache key.
+const cacheKeyTimeout = time.Minute
+
+// commitPattern matches full and abbreviated git commit hashes.
+var commitPattern = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// IsCommit returns true if the given string is a full or abbreviated git commit hash.
+func IsCommit(commit string) bool {
+	return commitPattern.MatchString(commit)
+}
+
+// resultCacheKey returns a key identifying the result of running the tests for
+// the assignment and commit in the given run data. The key covers everything
+// that determines the result: the student's assignment folder, the tests, the
+// script, and the assignment's settings for running and scoring the tests. Test
+// runs with the same key give the same result.
+func resultCacheKey(path string, info *AssignmentInfo, rData *RunData) (string, error) {
+	if !commitPattern.MatchString(rData.CommitID) {
+		return "", fmt.Errorf("invalid commit %q", rData.CommitID)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheKeyTimeout)
+	defer cancel()
+
+	tree, err := assignmentTree(ctx, info, rData.CommitID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tree of %s at commit %s: %w", info.AssignmentName, rData.CommitID, err)
+	}
+	testsCommit, err := resolveTestsCommit(ctx, info)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve tests commit: %w", err)
+	}
+	script, err := scriptDigest(path, info)
+	if err != nil {
+		return "", fmt.Errorf("failed to read script template: %w", err)
+	}
+	assignment := rData.Assignment
+	h := sha256.New()
+	for _, v := range []interface{}{
+		assignment.GetID(),
+		tree,
+		testsCommit,
+		script,
+		assignment.GetContainerTimeout(),
+		assignment.GetAnalyzers(),
+		assignment.GetCoverage(),
+		assignment.GetArtifactsDir(),
+		assignment.GetReruns(),
+		assignment.GetBenchmarks(),
+	} {
+		fmt.Fprintln(h, v)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// assignmentTree returns the hash of the tree of the assignment folder at the
+// given commit of the student repository. Only the commit itself is fetched.
+func assignmentTree(ctx context.Context, info *AssignmentInfo, commit string) (string, error) {
+	dir, err := ioutil.TempDir("", "quickfeed-cache")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	out, err := RunCommands(ctx,
+		"set -e",
+		"cd "+dir,
+		"git init --quiet",
+		"git fetch --quiet --depth 1 "+authURL(info.GetURL, info.CreatorAccessToken)+" "+commit,
+		"git rev-parse FETCH_HEAD:"+info.AssignmentName,
+	)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// resolveTestsCommit returns the commit of the tests repository to be used
+// for the assignment, i.e., its pinned commit or the latest commit.
+func resolveTestsCommit(ctx context.Context, info *AssignmentInfo) (string, error) {
+	if info.TestsCommit != "" {
+		// commits cannot change; no need to ask the remote
+		return info.TestsCommit, nil
+	}
+	out, err := RunCommands(ctx, "git ls-remote "+authURL(info.TestURL, info.CreatorAccessToken)+" HEAD")
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("HEAD not found in %s", info.TestURL)
+	}
+	return fields[0], nil
+}
+
+// scriptDigest returns the digest of the script template and course image used for the assignment.
+func scriptDigest(path string, info *AssignmentInfo) (string, error) {
+	script := info.ScriptTemplate
+	if script == "" {
+		b, err := ioutil.ReadFile(filepath.Join(path, info.Script))
+		if err != nil {
+			return "", err
+		}
+		script = string(b)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(script+"\n"+info.CourseImage))), nil
+}
+
+// authURL returns the given https clone URL with the access token embedded.
+func authURL(cloneURL, token string) string {
+	if token == "" || !strings.HasPrefix(cloneURL, "https://") {
+		return cloneURL
+	}
+	return strings.Replace(cloneURL, "https://", "https://"+token+":x-oauth-basic@", 1)
+}
+
+// cachedResult returns the result of the latest submission for the assignment
+// and user or group in the given run data, if that submission was built with
+// the given cache key. Otherwise, nil is returned. The returned result has a
+// new build ID, build date and push date, as if the tests were run again, and
+// is no longer pending a build.
+func cachedResult(db database.Database, rData *RunData, key string) (*Result, error) {
+	submission, err := db.GetSubmission(&pb.Submission{
+		AssignmentID: rData.Assignment.GetID(),
+		UserID:       rData.Repo.GetUserID(),
+		GroupID:      rData.Repo.GetGroupID(),
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := &Result{}
+	if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &result.BuildInfo); err != nil || result.BuildInfo == nil {
+		// submissions without build info, e.g., manually graded, are not cached
+		return nil, nil
+	}
+	if result.BuildInfo.CacheKey != key {
+		return nil, nil
+	}
+	if err := json.Unmarshal([]byte(submission.GetScoreObjects()), &result.Scores); err != nil {
+		return nil, err
+	}
+	result.BuildInfo.BuildID = atomic.AddInt64(globalBuildID, 1)
+	result.BuildInfo.BuildDate = time.Now().Format(layout)
+	if !rData.PushTime.IsZero() {
+		result.BuildInfo.PushDate = rData.PushTime.Format(time.RFC3339Nano)
+	}
+	result.BuildInfo.Pending = false
+	result.BuildInfo.PendingCommit = ""
+	result.BuildInfo.PendingLog = ""
+	return result, nil
+}
@@ -2,6 +2,9 @@ package ci
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strings"
 )
 
 // Job describes how to execute a CI job.
@@ -11,7 +14,25 @@ type Job struct {
 	// Image names the image to use to run the job.
 	Image string
 	// Commands is a list of shell commands to run as part of the job.
+	// The commands of the job's stages, if any, are included.
 	Commands []string
+	// Stages lists the named stages declared by the job's script template.
+	Stages []*Stage
+	// ArtifactsDir is the folder in which the job leaves files to be kept as build artifacts.
+	ArtifactsDir string
+	// CollectArtifacts, if set, is called with a tar archive of the job's artifacts
+	// folder after the job has completed. It is not called if the folder is missing.
+	CollectArtifacts func(archive io.Reader)
+	// CollectLog, if set, is called with the job's complete output
+	// before the output is truncated to the maximum log size.
+	CollectLog func(log string)
+	// Credentials, if set, is passed to the job's commands on their standard input,
+	// so that it is neither in the command line nor in the environment of the job's
+	// processes, where the job's later commands could read it.
+	Credentials string
+	// Labels lists the labels that a runner agent must have to run the job.
+	// Runners that do not dispatch jobs to agents ignore the labels.
+	Labels []string
 }
 
 // Runner contains methods for running user provided code in isolation.
@@ -19,3 +40,26 @@ type Runner interface {
 	// Run should synchronously execute the described job and return the output.
 	Run(context.Context, *Job) (string, error)
 }
+
+// ContainerRunner is a runner that runs jobs in containers on the local host.
+type ContainerRunner interface {
+	Runner
+	ImageBuilder
+	io.Closer
+}
+
+// NewContainerCI returns a runner using the given container engine, docker or podman.
+func NewContainerCI(engine string) (ContainerRunner, error) {
+	switch engine {
+	case "docker":
+		return NewDockerCI()
+	case "podman":
+		return NewPodmanCI()
+	}
+	return nil, fmt.Errorf("unknown container engine: %s", engine)
+}
+
+// Quote returns the given string quoted for use as a single word in a shell command.
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
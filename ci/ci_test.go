@@ -0,0 +1,22 @@
+package ci
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	words := []string{"plain", "with space", "it's", "$(id); `id`", "--flag"}
+	commands := make([]string, len(words))
+	for i, word := range words {
+		commands[i] = "printf '%s\\n' " + Quote(word)
+	}
+	out, err := (&Local{}).Run(context.Background(), &Job{Commands: commands})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.TrimSpace(out), strings.Join(words, "\n"); got != want {
+		t.Errorf("quoted words printed as %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+package ci
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+const (
+	// coveragePrefix prefixes the lines in the build log, printed by the coverage
+	// commands, that start the coverage report and hold the exit status of the
+	// student's tests and the total coverage.
+	coveragePrefix = "QuickFeed coverage: "
+	// coverageBegin follows coveragePrefix on the line that starts the coverage report.
+	coverageBegin = "begin"
+	// coverageReportPrefix prefixes the lines of the coverage report in the build log.
+	coverageReportPrefix = "QuickFeed coverage report: "
+	// coverageReport is the name of the coverage report artifacts, with .txt for the
+	// per function coverage and .html for the annotated source code.
+	coverageReport = "coverage"
+	// coverageTestPrefix prefixes the test name of the coverage score entries.
+	coverageTestPrefix = "coverage/"
+)
+
+// Coverage describes how the coverage of the student's own tests counts towards
+// an assignment's score. Each threshold adds a score entry that is passed if the
+// coverage is at least the threshold percentage.
+type Coverage struct {
+	Thresholds []int `json:"thresholds"`
+	// Weight is the weight of each threshold's score entry.
+	Weight int `json:"weight"`
+}
+
+// CoverageResult holds the coverage of the student's own tests.
+type CoverageResult struct {
+	TestsPassed bool    `json:"testspassed"`
+	Percent     float64 `json:"percent"`
+	Report      string  `json:"report,omitempty"` // per function coverage
+}
+
+// EncodeCoverage checks the given coverage configuration, sets default values
+// for unset fields, and returns it encoded for the assignment's coverage field.
+func EncodeCoverage(coverage *Coverage) (string, error) {
+	if coverage == nil {
+		return "", nil
+	}
+	if len(coverage.Thresholds) == 0 {
+		return "", fmt.Errorf("coverage: no thresholds")
+	}
+	for i, threshold := range coverage.Thresholds {
+		if threshold < 1 || threshold > 100 {
+			return "", fmt.Errorf("coverage: threshold %d must be between 1 and 100", threshold)
+		}
+		if i > 0 && threshold <= coverage.Thresholds[i-1] {
+			return "", fmt.Errorf("coverage: thresholds must be increasing")
+		}
+	}
+	if coverage.Weight < 0 {
+		return "", fmt.Errorf("coverage: weight cannot be negative")
+	}
+	if coverage.Weight == 0 {
+		coverage.Weight = 1
+	}
+	b, err := json.Marshal(coverage)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// AssignmentCoverage returns the coverage configuration of the given assignment,
+// or nil if the student's tests are not graded.
+func AssignmentCoverage(assignment *pb.Assignment) (*Coverage, error) {
+	if assignment.GetCoverage() == "" {
+		return nil, nil
+	}
+	var coverage Coverage
+	if err := json.Unmarshal([]byte(assignment.GetCoverage()), &coverage); err != nil {
+		return nil, fmt.Errorf("invalid coverage for assignment %s: %w", assignment.GetName(), err)
+	}
+	return &coverage, nil
+}
+
+// coverageCommands returns the shell commands that run the student's tests in the
+// current directory and print the coverage, marked with the given nonce, for
+// ExtractResult. The tests' output is indented, so that it cannot be mistaken for
+// the coverage lines. The coverage profile is kept in a new temporary folder, and
+// the coverage reports are saved as artifacts in the given artifacts folder.
+func coverageCommands(coverage *Coverage, artifactsDir, nonce string) string {
+	if coverage == nil {
+		return ""
+	}
+	return strings.Join([]string{
+		"QUICKFEED_COVERAGE=$(mktemp -d)",
+		"go test -coverprofile=$QUICKFEED_COVERAGE/profile ./... > $QUICKFEED_COVERAGE/tests 2>&1",
+		"QUICKFEED_COVERAGE_STATUS=$?",
+		"sed 's/^/    /' $QUICKFEED_COVERAGE/tests",
+		fmt.Sprintf(`printf "%s%s\n"`, marker(coveragePrefix, nonce), coverageBegin),
+		"go tool cover -func=$QUICKFEED_COVERAGE/profile > $QUICKFEED_COVERAGE/report 2>&1",
+		fmt.Sprintf(`sed 's/^/%s/' $QUICKFEED_COVERAGE/report`, marker(coverageReportPrefix, nonce)),
+		fmt.Sprintf(`printf "%s%%d %%s\n" $QUICKFEED_COVERAGE_STATUS "$(tail -n 1 $QUICKFEED_COVERAGE/report | awk '{print $NF}')"`, marker(coveragePrefix, nonce)),
+		fmt.Sprintf("mkdir -p %[1]s && cp $QUICKFEED_COVERAGE/report %[1]s/%[2]s.txt", Quote(artifactsDir), coverageReport),
+		fmt.Sprintf("go tool cover -html=$QUICKFEED_COVERAGE/profile -o %s/%s.html > /dev/null 2>&1", Quote(artifactsDir), coverageReport),
+		"rm -rf $QUICKFEED_COVERAGE",
+	}, "\n")
+}
+
+// coverageResults collects the coverage result from the build log lines.
+// The lines printed before the last coverage report are ignored.
+type coverageResults struct {
+	result *CoverageResult
+	report []string
+}
+
+// add records the given coverage or coverage report line, if it is marked
+// with the job's nonce, and returns true if the line was recorded.
+func (c *coverageResults) add(line, nonce string) bool {
+	if payload, ok := markedLine(line, coverageReportPrefix, nonce); ok {
+		c.report = append(c.report, payload)
+		return true
+	}
+	payload, ok := markedLine(line, coveragePrefix, nonce)
+	if !ok {
+		return false
+	}
+	fields := strings.Fields(payload)
+	if len(fields) == 1 && fields[0] == coverageBegin {
+		c.report = nil
+		return true
+	}
+	result := &CoverageResult{Report: strings.Join(c.report, "\n")}
+	if len(fields) == 2 {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(fields[1], "%"), 64)
+		result.TestsPassed = fields[0] == "0"
+		if err == nil && result.TestsPassed {
+			result.Percent = percent
+		}
+	}
+	c.result = result
+	c.report = nil
+	return true
+}
+
+// AddCoverageScores adds a score entry for each coverage threshold to the result.
+// The thresholds are passed only if the student's tests passed.
+func (r *Result) AddCoverageScores(coverage *Coverage) {
+	if coverage == nil {
+		return
+	}
+	var percent float64
+	if r.BuildInfo != nil && r.BuildInfo.Coverage != nil {
+		percent = r.BuildInfo.Coverage.Percent
+	}
+	for _, threshold := range coverage.Thresholds {
+		sc := &score.Score{
+			TestName: fmt.Sprintf("%s%d%%", coverageTestPrefix, threshold),
+			MaxScore: 1,
+			Weight:   coverage.Weight,
+		}
+		if percent >= float64(threshold) {
+			sc.Score = 1
+		}
+		r.Scores = append(r.Scores, sc)
+	}
+}
@@ -0,0 +1,126 @@
+package ci
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+func TestEncodeCoverage(t *testing.T) {
+	coverage := &Coverage{Thresholds: []int{50, 80}}
+	if _, err := EncodeCoverage(coverage); err != nil {
+		t.Fatal(err)
+	}
+	if coverage.Weight != 1 {
+		t.Errorf("Weight = %d, want default weight 1", coverage.Weight)
+	}
+	for _, invalid := range []*Coverage{
+		{},
+		{Thresholds: []int{0}},
+		{Thresholds: []int{101}},
+		{Thresholds: []int{80, 50}},
+		{Thresholds: []int{50}, Weight: -1},
+	} {
+		if _, err := EncodeCoverage(invalid); err == nil {
+			t.Errorf("EncodeCoverage(%+v): expected error", invalid)
+		}
+	}
+}
+
+func TestCoverageScores(t *testing.T) {
+	out := strings.Join([]string{
+		"=== RUN   TestA",
+		"    ok  lab1  0.002s  coverage: 62.5% of statements",
+		"QuickFeed coverage: nonce begin",
+		"QuickFeed coverage report: nonce lab1/lab.go:3:	Add		100.0%",
+		"QuickFeed coverage report: nonce total:	(statements)	62.5%",
+		"QuickFeed coverage: nonce 0 62.5%",
+		// printed by student code without the nonce
+		"QuickFeed coverage: 0 100.0%",
+		"QuickFeed coverage report: fake",
+	}, "\n")
+	result, err := ExtractResult(zap.NewNop().Sugar(), out, "secret", "nonce", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CoverageResult{
+		TestsPassed: true,
+		Percent:     62.5,
+		Report:      "lab1/lab.go:3:	Add		100.0%\ntotal:	(statements)	62.5%",
+	}
+	if diff := cmp.Diff(want, result.BuildInfo.Coverage); diff != "" {
+		t.Errorf("Coverage mismatch (-want +got):\n%s", diff)
+	}
+
+	result.AddCoverageScores(&Coverage{Thresholds: []int{50, 80}, Weight: 2})
+	wantScores := []*score.Score{
+		{TestName: "coverage/50%", Score: 1, MaxScore: 1, Weight: 2},
+		{TestName: "coverage/80%", Score: 0, MaxScore: 1, Weight: 2},
+	}
+	if diff := cmp.Diff(wantScores, result.Scores); diff != "" {
+		t.Errorf("Scores mismatch (-want +got):\n%s", diff)
+	}
+
+	// coverage does not count if the student's tests fail
+	result, err = ExtractResult(zap.NewNop().Sugar(), "QuickFeed coverage: nonce begin\nQuickFeed coverage: nonce 1 62.5%", "secret", "nonce", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&CoverageResult{}, result.BuildInfo.Coverage); diff != "" {
+		t.Errorf("Coverage mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRunCoverage(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("This test requires go")
+	}
+	dir, err := ioutil.TempDir("", "coverage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"go.mod":      "module lab1\n",
+		"lab.go":      "package lab\n\nfunc Add(a, b int) int { return a + b }\n\nfunc Sub(a, b int) int { return a - b }\n",
+		"lab_test.go": "package lab\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {\n\tif Add(1, 2) != 3 {\n\t\tt.Error(\"wrong sum\")\n\t}\n}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	artifacts := filepath.Join(dir, "artifacts")
+	runner := Local{}
+	out, err := runner.Run(context.Background(), &Job{
+		Commands: []string{"cd " + dir, coverageCommands(&Coverage{Thresholds: []int{50}}, artifacts, "nonce")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := ExtractResult(zap.NewNop().Sugar(), out, "secret", "nonce", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coverage := result.BuildInfo.Coverage
+	if coverage == nil || !coverage.TestsPassed || coverage.Percent != 50 {
+		t.Fatalf("Coverage = %+v, want 50%% with passing tests\n%s", coverage, out)
+	}
+	if !strings.Contains(coverage.Report, "Sub") {
+		t.Errorf("Report = %q, want per function coverage", coverage.Report)
+	}
+	for _, name := range []string{"coverage.txt", "coverage.html"} {
+		if _, err := os.Stat(filepath.Join(artifacts, name)); err != nil {
+			t.Errorf("coverage report artifact %s not saved: %v", name, err)
+		}
+	}
+}
@@ -24,6 +24,9 @@ var (
 	lastSegmentSize  = 1_000     // bytes
 )
 
+// timeoutMessage is returned to the user, to be shown in the results log, when a job times out.
+const timeoutMessage = "Container timeout. Please check for infinite loops or other slowness."
+
 // Docker is an implementation of the CI interface using Docker.
 type Docker struct {
 	client *client.Client
@@ -54,6 +57,11 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		return d.client.ContainerCreate(ctx, &container.Config{
 			Image: job.Image,
 			Cmd:   []string{"/bin/bash", "-c", strings.Join(job.Commands, "\n")},
+			// the job's credentials are passed on the standard input, which is
+			// closed once they have been written
+			OpenStdin:   job.Credentials != "",
+			StdinOnce:   job.Credentials != "",
+			AttachStdin: job.Credentials != "",
 		}, nil, nil, job.Name)
 	}
 
@@ -69,39 +77,71 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		}
 	}
 
+	var stdin *types.HijackedResponse
+	if job.Credentials != "" {
+		attached, err := d.client.ContainerAttach(ctx, resp.ID, types.ContainerAttachOptions{Stream: true, Stdin: true})
+		if err != nil {
+			return "", err
+		}
+		stdin = &attached
+		defer stdin.Close()
+	}
+
 	if err := d.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{}); err != nil {
 		return "", err
 	}
+	if stdin != nil {
+		// closing the standard input ends the credentials read by the job's commands
+		if _, err := io.WriteString(stdin.Conn, job.Credentials); err != nil {
+			return "", err
+		}
+		if err := stdin.CloseWrite(); err != nil {
+			return "", err
+		}
+	}
 
 	// wait until the container stops or context times out.
 	_, err = d.client.ContainerWait(ctx, resp.ID)
 	if err != nil {
-		if !errors.Is(err, context.DeadlineExceeded) {
+		if ctx.Err() == nil {
 			return "", err
 		}
 
-		// stop runaway container whose deadline was exceeded
+		// stop runaway container whose deadline was exceeded, or whose build was canceled
 		timeout := time.Duration(1 * time.Second)
 		stopErr := d.client.ContainerStop(context.Background(), resp.ID, &timeout)
 		if stopErr != nil {
 			return "", stopErr
 		}
 
+		// extract the logs of a timed out container before removing it below
+		var stdout bytes.Buffer
+		if errors.Is(err, context.DeadlineExceeded) {
+			if logErr := d.containerLogs(context.Background(), resp.ID, &stdout); logErr != nil {
+				return "", logErr
+			}
+		}
+
 		// remove the docker container (when stopped due to timeout) to prevent too many open files
 		rmErr := d.client.ContainerRemove(context.Background(), resp.ID, types.ContainerRemoveOptions{})
 		if rmErr != nil {
 			return "", rmErr
 		}
 
+		if !errors.Is(err, context.DeadlineExceeded) {
+			return "", err
+		}
 		// return message to user to be shown in the results log
-		return "Container timeout. Please check for infinite loops or other slowness.", err
+		return timeoutOutput(job, stdout.String()), err
 	}
 
-	// extract the logs before removing the container below
-	logReader, err := d.client.ContainerLogs(ctx, resp.ID, types.ContainerLogsOptions{
-		ShowStdout: true,
-	})
-	if err != nil {
+	// collect the artifacts and extract the logs before removing the container below
+	if job.CollectArtifacts != nil && job.ArtifactsDir != "" {
+		d.collectArtifacts(ctx, resp.ID, job)
+	}
+
+	var stdout bytes.Buffer
+	if err := d.containerLogs(ctx, resp.ID, &stdout); err != nil {
 		return "", err
 	}
 
@@ -110,45 +150,84 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return jobOutput(job, stdout.String()), nil
+}
 
-	var stdout bytes.Buffer
-	if _, err := stdcopy.StdCopy(&stdout, ioutil.Discard, logReader); err != nil {
-		return "", err
+// containerLogs writes the standard output of the given container to stdout.
+func (d *Docker) containerLogs(ctx context.Context, containerID string, stdout io.Writer) error {
+	logReader, err := d.client.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+	})
+	if err != nil {
+		return err
 	}
-	if stdout.Len() > maxLogSize+lastSegmentSize {
-		// converting to string here;
-		// could be done more efficiently using stdout.Truncate(maxLogSize)
-		// but then we wouldn't get the last part
-		all := stdout.String()
-		// find the last full line to keep before the truncate point
-		startMiddleSegment := strings.LastIndex(all[0:maxLogSize], "\n") + 1
-		// find the last full line to truncate and scan for score lines, before the last segment to output
-		startLastSegment := strings.LastIndex(all[0:len(all)-lastSegmentSize], "\n") + 1
-
-		middleSegment := all[startMiddleSegment:startLastSegment]
-		// score lines will normally replace this string, unless too much output
-		scoreLines := "too much output data to scan (skipping; fix your code)"
-		// only scan if middle segment is less than maxToScan
-		if len(middleSegment) < maxToScan {
-			// find score lines in the middle segment that otherwise gets truncated
-			scoreLines = findScoreLines(middleSegment)
-		}
-		return all[0:startMiddleSegment] + scoreLines + `
+	defer logReader.Close()
+	_, err = stdcopy.StdCopy(stdout, ioutil.Discard, logReader)
+	return err
+}
+
+// collectArtifacts passes a tar archive of the job's artifacts folder in the
+// given container to the job's CollectArtifacts function. Nothing is collected
+// if the folder cannot be copied, e.g., because the job did not create it.
+func (d *Docker) collectArtifacts(ctx context.Context, containerID string, job *Job) {
+	archive, _, err := d.client.CopyFromContainer(ctx, containerID, job.ArtifactsDir)
+	if err != nil {
+		return
+	}
+	defer archive.Close()
+	job.CollectArtifacts(archive)
+}
+
+// jobOutput passes the job's complete output to the job's CollectLog function,
+// if set, and returns the output truncated to the maximum log size.
+func jobOutput(job *Job, all string) string {
+	if job.CollectLog != nil {
+		job.CollectLog(all)
+	}
+	return truncateOutput(all)
+}
+
+// timeoutOutput passes the job's complete output until it timed out, followed by the
+// timeout message, to the job's CollectLog function, if set, and returns the timeout
+// message to be shown to the user in the results log.
+func timeoutOutput(job *Job, all string) string {
+	jobOutput(job, all+timeoutMessage)
+	return timeoutMessage
+}
+
+// truncateOutput returns the given output of a job, truncated to the maximum log
+// size if necessary. Score lines in the truncated part of the output are kept.
+func truncateOutput(all string) string {
+	if len(all) <= maxLogSize+lastSegmentSize {
+		return all
+	}
+	// find the last full line to keep before the truncate point
+	startMiddleSegment := strings.LastIndex(all[0:maxLogSize], "\n") + 1
+	// find the last full line to truncate and scan for score lines, before the last segment to output
+	startLastSegment := strings.LastIndex(all[0:len(all)-lastSegmentSize], "\n") + 1
+
+	middleSegment := all[startMiddleSegment:startLastSegment]
+	// score lines will normally replace this string, unless too much output
+	scoreLines := "too much output data to scan (skipping; fix your code)"
+	// only scan if middle segment is less than maxToScan
+	if len(middleSegment) < maxToScan {
+		// find score lines in the middle segment that otherwise gets truncated
+		scoreLines = findScoreLines(middleSegment)
+	}
+	return all[0:startMiddleSegment] + scoreLines + `
 
 		...
 		truncated output
 		...
 
-		` + all[startLastSegment:], nil
-	}
-	return stdout.String(), nil
+		` + all[startLastSegment:]
 }
 
 func findScoreLines(lines string) string {
 	scoreLines := make([]string, 0)
 	for _, line := range strings.Split(lines, "\n") {
-		// check if line has expected JSON score string
-		if score.HasPrefix(line) {
+		// check if line has expected JSON score or benchmark string
+		if score.HasPrefix(line) || score.HasBenchmarkPrefix(line) {
 			scoreLines = append(scoreLines, line)
 		}
 	}
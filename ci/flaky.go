@@ -0,0 +1,185 @@
+package ci
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// MaxReruns is the maximum number of times an assignment's tests may be rerun.
+const MaxReruns = 10
+
+// rerunTests runs the failed tests again, up to the assignment's number of reruns,
+// as long as some of the result's tests fail. The static analyzers and the coverage
+// of the student's tests are not rerun. Each test of the result keeps its best score
+// across the runs, and the build information records the number of reruns and the
+// tests whose outcome differed between the runs.
+// The scores of each run, starting with the given result's, are returned.
+func rerunTests(ctx context.Context, logger *zap.SugaredLogger, runner Runner, info *AssignmentInfo, rData *RunData, result *Result) [][]*score.Score {
+	runs := [][]*score.Score{append([]*score.Score(nil), result.Scores...)}
+	// the artifacts of the first run are kept
+	rerunData := *rData
+	rerunData.Artifacts = nil
+	rerunInfo := *info
+	rerunInfo.Analysis = ""
+	rerunInfo.Coverage = ""
+	for i := uint32(0); i < rData.Assignment.GetReruns() && result.failedTests(); i++ {
+		logger.Debugf("Rerunning failed tests for %s", rData.JobOwner)
+		rerunInfo.FailedTests = testsPattern(result.failedTestNames())
+		ed, err := runTests(ctx, scriptPath, runner, &rerunInfo, &rerunData)
+		if err != nil {
+			logger.Errorf("Failed to rerun tests: %w", err)
+			break
+		}
+		rerun, err := extractResult(logger, ed, &rerunInfo, rData.Assignment)
+		if err != nil {
+			logger.Errorf("Failed to extract results from log: %w", err)
+			break
+		}
+		runs = append(runs, rerun.Scores)
+		result.keepBestScores(rerun.Scores)
+	}
+	result.BuildInfo.Reruns = len(runs) - 1
+	result.BuildInfo.Flaky = flakyTests(runs)
+	return runs
+}
+
+// isTest returns true if the given score entry is the score of a test, rather than
+// of the static analyzers, the coverage of the student's tests, or a benchmark.
+func isTest(sc *score.Score) bool {
+	return !strings.HasPrefix(sc.TestName, analysisTestPrefix) && !strings.HasPrefix(sc.TestName, coverageTestPrefix) &&
+		!strings.HasPrefix(sc.TestName, benchmarkTestPrefix)
+}
+
+// passed returns true if the given test got its max score.
+func passed(sc *score.Score) bool {
+	return sc.Score >= sc.MaxScore
+}
+
+// failedTests returns true if any of the result's tests failed.
+func (r Result) failedTests() bool {
+	return len(r.failedTestNames()) > 0
+}
+
+// failedTestNames returns the names of the result's failed tests.
+func (r Result) failedTestNames() []string {
+	var names []string
+	for _, sc := range r.Scores {
+		if isTest(sc) && !passed(sc) {
+			names = append(names, sc.TestName)
+		}
+	}
+	return names
+}
+
+// testsPattern returns the shell quoted regular expression that matches the
+// top-level tests of the given test names, as used by go test's -run flag.
+func testsPattern(names []string) string {
+	var tests []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		// subtests are run by running their top-level test
+		test := regexp.QuoteMeta(strings.SplitN(name, "/", 2)[0])
+		if !seen[test] {
+			seen[test] = true
+			tests = append(tests, test)
+		}
+	}
+	return Quote("^(" + strings.Join(tests, "|") + ")$")
+}
+
+// keepBestScores merges the given test scores into the result's scores by
+// test name, keeping the higher score of each test. Tests that are missing
+// from the result are added.
+func (r *Result) keepBestScores(scores []*score.Score) {
+	index := make(map[string]int)
+	for i, sc := range r.Scores {
+		if isTest(sc) {
+			index[sc.TestName] = i
+		}
+	}
+	for _, sc := range scores {
+		if !isTest(sc) {
+			continue
+		}
+		i, ok := index[sc.TestName]
+		switch {
+		case !ok:
+			index[sc.TestName] = len(r.Scores)
+			r.Scores = append(r.Scores, sc)
+		case sc.Score > r.Scores[i].Score:
+			r.Scores[i] = sc
+		}
+	}
+}
+
+// flakyTests returns the names of the tests that both passed and failed in the given runs.
+func flakyTests(runs [][]*score.Score) []string {
+	var flaky []string
+	for _, outcome := range testOutcomes(0, "", runs) {
+		if outcome.GetPasses() > 0 && outcome.GetPasses() < outcome.GetRuns() {
+			flaky = append(flaky, outcome.GetTestName())
+		}
+	}
+	return flaky
+}
+
+// testOutcomes returns the number of runs and passes of each test in the given
+// runs of the assignment's tests on the given commit, in order of first appearance.
+func testOutcomes(assignmentID uint64, commit string, runs [][]*score.Score) []*pb.TestOutcome {
+	var outcomes []*pb.TestOutcome
+	byName := make(map[string]*pb.TestOutcome)
+	for _, scores := range runs {
+		for _, sc := range scores {
+			if !isTest(sc) {
+				continue
+			}
+			outcome, ok := byName[sc.TestName]
+			if !ok {
+				outcome = &pb.TestOutcome{AssignmentID: assignmentID, CommitHash: commit, TestName: sc.TestName}
+				byName[sc.TestName] = outcome
+				outcomes = append(outcomes, outcome)
+			}
+			outcome.Runs++
+			if passed(sc) {
+				outcome.Passes++
+			}
+		}
+	}
+	return outcomes
+}
+
+// recordTestOutcomes records the outcome of each test in the given runs on the run data's commit.
+func recordTestOutcomes(logger *zap.SugaredLogger, db database.Database, rData *RunData, runs [][]*score.Score) {
+	outcomes := testOutcomes(rData.Assignment.GetID(), rData.CommitID, runs)
+	if err := db.RecordTestOutcomes(outcomes); err != nil {
+		logger.Errorf("Failed to record test outcomes: %w", err)
+	}
+}
+
+// TestStats returns the pass rate of each test in the given outcomes, and on how many commits
+// the test both passed and failed, which indicates that the test is flaky.
+func TestStats(outcomes []*pb.TestOutcome) []*pb.TestStat {
+	var stats []*pb.TestStat
+	byName := make(map[string]*pb.TestStat)
+	for _, outcome := range outcomes {
+		stat, ok := byName[outcome.GetTestName()]
+		if !ok {
+			stat = &pb.TestStat{TestName: outcome.GetTestName()}
+			byName[outcome.GetTestName()] = stat
+			stats = append(stats, stat)
+		}
+		stat.Runs += outcome.GetRuns()
+		stat.Passes += outcome.GetPasses()
+		stat.Commits++
+		if outcome.GetPasses() > 0 && outcome.GetPasses() < outcome.GetRuns() {
+			stat.FlakyCommits++
+		}
+	}
+	return stats
+}
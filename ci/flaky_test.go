@@ -0,0 +1,200 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+// flakyRunner is a runner that outputs the given scores of TestA and TestB in successive runs,
+// or only of the failed tests, when rerunning these.
+type flakyRunner struct {
+	scores [][2]int
+	runs   int
+	reruns []string
+}
+
+func (r *flakyRunner) Run(_ context.Context, job *Job) (string, error) {
+	// the secret is the last word of the job's script, following the failed tests, if any
+	commands := strings.Fields(job.Commands[len(job.Commands)-1])
+	secret := commands[len(commands)-1]
+	failed := ""
+	if len(commands) > 2 {
+		failed = commands[1]
+		r.reruns = append(r.reruns, failed)
+	}
+	scores := r.scores[r.runs%len(r.scores)]
+	r.runs++
+	var out []string
+	for i, name := range []string{"TestA", "TestB"} {
+		if failed == "" || strings.Contains(failed, name) {
+			out = append(out, fmt.Sprintf(`{"Secret":"%s","TestName":"%s","Score":%d,"MaxScore":10,"Weight":1}`, secret, name, scores[i]))
+		}
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+func TestRerunTests(t *testing.T) {
+	tests := []struct {
+		name       string
+		reruns     uint32
+		scores     [][2]int
+		wantRuns   int
+		wantScores [2]int
+		wantFlaky  []string
+		wantReruns []string
+		wantStats  []*pb.TestOutcome
+	}{
+		{
+			name:       "NoReruns",
+			scores:     [][2]int{{10, 5}, {10, 10}},
+			wantRuns:   1,
+			wantScores: [2]int{10, 5},
+			wantStats: []*pb.TestOutcome{
+				{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 1, Passes: 1},
+				{AssignmentID: 1, CommitHash: "abc", TestName: "TestB", Runs: 1, Passes: 0},
+			},
+		},
+		{
+			name:   "PassOnRerun",
+			reruns: 3,
+			// only the failed TestB is rerun, so no more reruns are needed after the second run
+			scores:     [][2]int{{10, 5}, {5, 10}, {0, 0}},
+			wantRuns:   2,
+			wantScores: [2]int{10, 10},
+			wantFlaky:  []string{"TestB"},
+			wantReruns: []string{"'^(TestB)$'"},
+			wantStats: []*pb.TestOutcome{
+				{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 1, Passes: 1},
+				{AssignmentID: 1, CommitHash: "abc", TestName: "TestB", Runs: 2, Passes: 1},
+			},
+		},
+		{
+			name:       "AlwaysFailing",
+			reruns:     2,
+			scores:     [][2]int{{10, 5}, {10, 7}, {10, 0}},
+			wantRuns:   3,
+			wantScores: [2]int{10, 7},
+			wantReruns: []string{"'^(TestB)$'", "'^(TestB)$'"},
+			wantStats: []*pb.TestOutcome{
+				{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 1, Passes: 1},
+				{AssignmentID: 1, CommitHash: "abc", TestName: "TestB", Runs: 3, Passes: 0},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := &flakyRunner{scores: test.scores}
+			info := &AssignmentInfo{
+				AssignmentName: "lab1",
+				Script:         "go.sh",
+				ScriptTemplate: "#image/quickfeed:go\necho {{ .FailedTests }} {{ .RandomSecret }}",
+				RandomSecret:   randomSecret(),
+			}
+			rData := &RunData{
+				Course:     &pb.Course{Code: "DAT320"},
+				Assignment: &pb.Assignment{ID: 1, Name: "lab1", Reruns: test.reruns},
+				Repo:       &pb.Repository{},
+				CommitID:   "abc",
+				JobOwner:   "muggles",
+			}
+			logger := zap.NewNop().Sugar()
+			ed, err := runTests(context.Background(), scriptPath, runner, info, rData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := extractResult(logger, ed, info, rData.Assignment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			runs := rerunTests(context.Background(), logger, runner, info, rData, result)
+
+			if len(runs) != test.wantRuns || runner.runs != test.wantRuns {
+				t.Errorf("rerunTests() ran %d times (%d runs returned), want %d", runner.runs, len(runs), test.wantRuns)
+			}
+			if result.BuildInfo.Reruns != test.wantRuns-1 {
+				t.Errorf("rerunTests() BuildInfo.Reruns = %d, want %d", result.BuildInfo.Reruns, test.wantRuns-1)
+			}
+			gotScores := [2]int{result.Scores[0].Score, result.Scores[1].Score}
+			if gotScores != test.wantScores {
+				t.Errorf("rerunTests() scores = %v, want %v", gotScores, test.wantScores)
+			}
+			if diff := cmp.Diff(test.wantReruns, runner.reruns); diff != "" {
+				t.Errorf("rerunTests() failed tests mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantFlaky, result.BuildInfo.Flaky); diff != "" {
+				t.Errorf("rerunTests() BuildInfo.Flaky mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantStats, testOutcomes(1, "abc", runs)); diff != "" {
+				t.Errorf("testOutcomes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeepBestScores(t *testing.T) {
+	result := &Result{Scores: []*score.Score{
+		{TestName: "TestA", Score: 5, MaxScore: 10},
+		{TestName: "TestB", Score: 10, MaxScore: 10},
+		{TestName: analysisTestPrefix + "gofmt", Score: 0, MaxScore: 10},
+	}}
+	result.keepBestScores([]*score.Score{
+		{TestName: "TestB", Score: 0, MaxScore: 10},
+		{TestName: "TestC", Score: 10, MaxScore: 10},
+		{TestName: "TestA", Score: 10, MaxScore: 10},
+		{TestName: analysisTestPrefix + "gofmt", Score: 10, MaxScore: 10},
+	})
+	want := []*score.Score{
+		{TestName: "TestA", Score: 10, MaxScore: 10},
+		{TestName: "TestB", Score: 10, MaxScore: 10},
+		{TestName: analysisTestPrefix + "gofmt", Score: 0, MaxScore: 10},
+		// tests missing from the first run are kept
+		{TestName: "TestC", Score: 10, MaxScore: 10},
+	}
+	if diff := cmp.Diff(want, result.Scores); diff != "" {
+		t.Errorf("keepBestScores() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTestsPattern(t *testing.T) {
+	got := testsPattern([]string{"TestA", "TestB/sub", "TestB/other", "Test.C"})
+	if want := `'^(TestA|TestB|Test\.C)$'`; got != want {
+		t.Errorf("testsPattern() = %s, want %s", got, want)
+	}
+}
+
+func TestTestStats(t *testing.T) {
+	outcomes := []*pb.TestOutcome{
+		{TestName: "TestA", CommitHash: "abc", Runs: 3, Passes: 3},
+		{TestName: "TestA", CommitHash: "def", Runs: 2, Passes: 1},
+		{TestName: "TestB", CommitHash: "abc", Runs: 3, Passes: 0},
+	}
+	want := []*pb.TestStat{
+		{TestName: "TestA", Runs: 5, Passes: 4, Commits: 2, FlakyCommits: 1},
+		{TestName: "TestB", Runs: 3, Passes: 0, Commits: 1, FlakyCommits: 0},
+	}
+	if diff := cmp.Diff(want, TestStats(outcomes)); diff != "" {
+		t.Errorf("TestStats() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTestOutcomesIgnoreAnalysisAndCoverage(t *testing.T) {
+	scores := []*score.Score{
+		{TestName: "TestA", Score: 10, MaxScore: 10},
+		{TestName: analysisTestPrefix + "gofmt", Score: 0, MaxScore: 10},
+		{TestName: coverageTestPrefix + "50%", Score: 0, MaxScore: 1},
+	}
+	want := []*pb.TestOutcome{{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 1, Passes: 1}}
+	if diff := cmp.Diff(want, testOutcomes(1, "abc", [][]*score.Score{scores})); diff != "" {
+		t.Errorf("testOutcomes() mismatch (-want +got):\n%s", diff)
+	}
+	if (Result{Scores: scores}).failedTests() {
+		t.Error("failedTests() = true, want false; analysis and coverage entries are not tests")
+	}
+}
@@ -0,0 +1,228 @@
+package ci
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+)
+
+const (
+	// courseLabel labels course images with the course ID.
+	courseLabel = "quickfeed.course"
+	// dockerfileLabel labels course images with the digest of their Dockerfile.
+	dockerfileLabel = "quickfeed.dockerfile"
+	// imageBuildTimeout is the maximum time allowed to build a course image.
+	imageBuildTimeout = 20 * time.Minute
+)
+
+// ImageBuilder is implemented by runners that can build docker images
+// from Dockerfiles provided by courses.
+type ImageBuilder interface {
+	// BuildImage builds an image with the given tag and labels
+	// from the Dockerfile and build context in the given directory.
+	BuildImage(ctx context.Context, dir, tag string, labels map[string]string) error
+	// FindImage returns the tag of an image with the given labels,
+	// or the empty string if there is no such image.
+	FindImage(ctx context.Context, labels map[string]string) (string, error)
+	// RemoveImages removes all images with the given labels, except the image
+	// with the given tag and images used by containers, e.g., of running jobs.
+	RemoveImages(ctx context.Context, labels map[string]string, keep string) error
+}
+
+// CourseImage returns the name of the course's image built from the given tests commit.
+func CourseImage(course *pb.Course, testsCommit string) string {
+	if len(testsCommit) > 12 {
+		testsCommit = testsCommit[:12]
+	}
+	return fmt.Sprintf("quickfeed/course-%d:%s", course.GetID(), testsCommit)
+}
+
+// UpdateCourseImage builds the course's image from the Dockerfile in the
+// scripts folder of the given tests repository clone, unless an image has
+// already been built from the same Dockerfile. The image is tagged with
+// the course ID and the tests commit, and the course's older images are
+// removed, unless they are still in use. The name of the course's image is returned, or the empty string
+// if the tests repository has no Dockerfile.
+func UpdateCourseImage(ctx context.Context, builder ImageBuilder, course *pb.Course, testsDir, testsCommit string) (string, error) {
+	dir := filepath.Join(testsDir, CourseScriptDir)
+	dockerfile, err := ioutil.ReadFile(filepath.Join(dir, "Dockerfile"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, imageBuildTimeout)
+	defer cancel()
+
+	courseLabels := map[string]string{courseLabel: strconv.FormatUint(course.GetID(), 10)}
+	labels := map[string]string{
+		courseLabel:     courseLabels[courseLabel],
+		dockerfileLabel: fmt.Sprintf("%x", sha256.Sum256(dockerfile)),
+	}
+	image, err := builder.FindImage(ctx, labels)
+	if err != nil {
+		return "", err
+	}
+	if image == "" {
+		image = CourseImage(course, testsCommit)
+		if err := builder.BuildImage(ctx, dir, image, labels); err != nil {
+			return "", fmt.Errorf("failed to build image %s: %w", image, err)
+		}
+	}
+	if err := builder.RemoveImages(ctx, courseLabels, image); err != nil {
+		return image, fmt.Errorf("failed to remove old images: %w", err)
+	}
+	return image, nil
+}
+
+// BuildImage implements the ImageBuilder interface.
+func (d *Docker) BuildImage(ctx context.Context, dir, tag string, labels map[string]string) error {
+	buildContext, err := tarDir(dir)
+	if err != nil {
+		return err
+	}
+	resp, err := d.client.ImageBuild(ctx, buildContext, types.ImageBuildOptions{
+		Tags:        []string{tag},
+		Labels:      labels,
+		Remove:      true,
+		ForceRemove: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// the build output is a stream of JSON messages; failures are reported in the error field
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var msg struct {
+			Error string `json:"error"`
+		}
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if msg.Error != "" {
+			return fmt.Errorf("%s", msg.Error)
+		}
+	}
+}
+
+// FindImage implements the ImageBuilder interface.
+func (d *Docker) FindImage(ctx context.Context, labels map[string]string) (string, error) {
+	images, err := d.client.ImageList(ctx, types.ImageListOptions{Filters: labelFilters(labels)})
+	if err != nil {
+		return "", err
+	}
+	for _, image := range images {
+		if len(image.RepoTags) > 0 {
+			return image.RepoTags[0], nil
+		}
+	}
+	return "", nil
+}
+
+// RemoveImages implements the ImageBuilder interface.
+func (d *Docker) RemoveImages(ctx context.Context, labels map[string]string, keep string) error {
+	images, err := d.client.ImageList(ctx, types.ImageListOptions{Filters: labelFilters(labels)})
+	if err != nil {
+		return err
+	}
+	for _, image := range images {
+		if contains(image.RepoTags, keep) {
+			continue
+		}
+		// images in use are removed by a later update
+		containers, err := d.client.ContainerList(ctx, types.ContainerListOptions{All: true, Filters: filterArgs("ancestor", image.ID)})
+		if err != nil {
+			return err
+		}
+		if len(containers) > 0 {
+			continue
+		}
+		if _, err := d.client.ImageRemove(ctx, image.ID, types.ImageRemoveOptions{PruneChildren: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func filterArgs(key, value string) filters.Args {
+	args := filters.NewArgs()
+	args.Add(key, value)
+	return args
+}
+
+func labelFilters(labels map[string]string) filters.Args {
+	args := filters.NewArgs()
+	for key, value := range labels {
+		args.Add("label", key+"="+value)
+	}
+	return args
+}
+
+func contains(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// tarDir returns a tar archive of the files in the given directory,
+// to be used as the build context for a docker image.
+func tarDir(dir string) (io.Reader, error) {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
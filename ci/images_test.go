@@ -0,0 +1,155 @@
+package ci
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeBuilder records the images built and removed.
+type fakeBuilder struct {
+	images  map[string]map[string]string // tag -> labels
+	builds  []string
+	removed []string
+}
+
+func (f *fakeBuilder) BuildImage(_ context.Context, dir, tag string, labels map[string]string) error {
+	if _, err := os.Stat(filepath.Join(dir, "Dockerfile")); err != nil {
+		return err
+	}
+	f.images[tag] = labels
+	f.builds = append(f.builds, tag)
+	return nil
+}
+
+func (f *fakeBuilder) FindImage(_ context.Context, labels map[string]string) (string, error) {
+	for tag, imageLabels := range f.images {
+		if matchLabels(imageLabels, labels) {
+			return tag, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *fakeBuilder) RemoveImages(_ context.Context, labels map[string]string, keep string) error {
+	for tag, imageLabels := range f.images {
+		if tag != keep && matchLabels(imageLabels, labels) {
+			delete(f.images, tag)
+			f.removed = append(f.removed, tag)
+		}
+	}
+	return nil
+}
+
+func matchLabels(imageLabels, labels map[string]string) bool {
+	for key, value := range labels {
+		if imageLabels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func TestUpdateCourseImage(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", "tests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+
+	builder := &fakeBuilder{images: make(map[string]map[string]string)}
+	course := &pb.Course{ID: 1}
+
+	// no Dockerfile in the tests repository
+	image, err := UpdateCourseImage(context.Background(), builder, course, testsDir, "aaaaaaaaaaaaaaaaaaaa")
+	if err != nil || image != "" {
+		t.Fatalf("UpdateCourseImage() = (%q, %v), want (\"\", nil)", image, err)
+	}
+
+	if err := os.Mkdir(filepath.Join(testsDir, CourseScriptDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	dockerfile := filepath.Join(testsDir, CourseScriptDir, "Dockerfile")
+	if err := ioutil.WriteFile(dockerfile, []byte("FROM golang:1.15-alpine\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	image, err = UpdateCourseImage(context.Background(), builder, course, testsDir, "aaaaaaaaaaaaaaaaaaaa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "quickfeed/course-1:aaaaaaaaaaaa"; image != want {
+		t.Errorf("UpdateCourseImage() = %q, want %q", image, want)
+	}
+
+	// unchanged Dockerfile; the image is not rebuilt
+	image, err = UpdateCourseImage(context.Background(), builder, course, testsDir, "bbbbbbbbbbbbbbbbbbbb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "quickfeed/course-1:aaaaaaaaaaaa"; image != want {
+		t.Errorf("UpdateCourseImage() = %q, want %q", image, want)
+	}
+
+	// changed Dockerfile; the image is rebuilt and the old image removed
+	if err := ioutil.WriteFile(dockerfile, []byte("FROM golang:1.16-alpine\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	image, err = UpdateCourseImage(context.Background(), builder, course, testsDir, "cccccccccccccccccccc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "quickfeed/course-1:cccccccccccc"; image != want {
+		t.Errorf("UpdateCourseImage() = %q, want %q", image, want)
+	}
+	if diff := cmp.Diff([]string{"quickfeed/course-1:aaaaaaaaaaaa", "quickfeed/course-1:cccccccccccc"}, builder.builds); diff != "" {
+		t.Errorf("builds mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"quickfeed/course-1:aaaaaaaaaaaa"}, builder.removed); diff != "" {
+		t.Errorf("removed images mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTarDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "context")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "bin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Dockerfile", filepath.Join("bin", "setup.sh")} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, err := tarDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, header.Name)
+	}
+	sort.Strings(names)
+	if diff := cmp.Diff([]string{"Dockerfile", "bin", "bin/setup.sh"}, names); diff != "" {
+		t.Errorf("tarDir() mismatch (-want +got):\n%s", diff)
+	}
+}
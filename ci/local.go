@@ -0,0 +1,160 @@
+package ci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// localEnv lists the environment variables passed from the server's
+// environment to local jobs; other variables, such as secrets, are removed.
+// The jobs' HOME is their temporary directory, so that they cannot read the
+// server's configuration files.
+var localEnv = []string{
+	"PATH", "USER", "LANG", "LC_ALL", "TZ", "TMPDIR",
+	"GOROOT", "GOPATH", "GOCACHE", "GOMODCACHE", "GOPROXY",
+}
+
+// maxLocalOutput is the maximum number of bytes of a local job's output kept
+// in memory. Output beyond this limit is dropped before the output is truncated.
+var maxLocalOutput = maxLogSize + maxToScan + lastSegmentSize
+
+// localOutputDelay is the time to wait for the output of processes that a local job
+// leaves running outside its process group, after the job has exited or been killed.
+var localOutputDelay = time.Second
+
+// Local is an implementation of the CI interface executing code locally.
+// Each job runs in its own temporary directory, with a scrubbed environment.
+// Since the job's code is not isolated from the host, Local is meant for
+// development and for running QuickFeed's own commands.
+type Local struct {
+	// Env lists additional environment variables for the jobs, in the form key=value.
+	Env []string
+}
+
+// Run implements the CI interface. This method blocks until the job has been
+// completed or an error occurs, e.g., the context times out. The combined
+// standard output and error of the job is returned. As with the container
+// runners, the job's commands failing is not an error.
+// The job's processes are killed when the context is done.
+func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
+	out, err := l.run(ctx, job)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return out, nil
+	}
+	return out, err
+}
+
+// RunCommands runs QuickFeed's own commands as a local job, and returns their
+// combined standard output and error. Unlike Local.Run, an error is returned
+// if the commands fail.
+func RunCommands(ctx context.Context, commands ...string) (string, error) {
+	return (&Local{}).run(ctx, &Job{Commands: commands})
+}
+
+// run runs the job, and returns its output and the error of its commands, if any.
+func (l *Local) run(ctx context.Context, job *Job) (string, error) {
+	dir, err := ioutil.TempDir("", "quickfeed-local")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	// the job's output is read from a pipe, which is closed when the job is done,
+	// even if processes started by the job keep it open
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	cmd := exec.Command(localShell, "-c", strings.Join(job.Commands, "\n"))
+	cmd.Dir = dir
+	cmd.Env = l.environ(dir)
+	cmd.Stdin = strings.NewReader(job.Credentials)
+	cmd.Stdout = w
+	cmd.Stderr = w
+	setProcessGroup(cmd)
+	err = cmd.Start()
+	w.Close()
+	if err != nil {
+		return "", err
+	}
+	out := &limitedBuffer{max: maxLocalOutput}
+	copied := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(out, r)
+		close(copied)
+	}()
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		killProcessGroup(cmd)
+		<-done
+		err = ctx.Err()
+	}
+	select {
+	case <-copied:
+	case <-time.After(localOutputDelay):
+		r.Close()
+		<-copied
+	}
+	if err == context.DeadlineExceeded {
+		// return message to user to be shown in the results log
+		return timeoutOutput(job, out.String()), err
+	}
+	if err == context.Canceled {
+		return "", err
+	}
+	return jobOutput(job, out.String()), err
+}
+
+// environ returns the environment of the local jobs run in the given directory.
+func (l *Local) environ(dir string) []string {
+	env := []string{"HOME=" + dir}
+	for _, key := range localEnv {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	// the Go caches would otherwise be placed in the temporary HOME, and not reused
+	if _, ok := os.LookupEnv("GOPATH"); !ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			env = append(env, "GOPATH="+filepath.Join(home, "go"))
+		}
+	}
+	if _, ok := os.LookupEnv("GOCACHE"); !ok {
+		if cache, err := os.UserCacheDir(); err == nil {
+			env = append(env, "GOCACHE="+filepath.Join(cache, "go-build"))
+		}
+	}
+	return append(env, l.Env...)
+}
+
+// limitedBuffer is a buffer that drops data written beyond its maximum size.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+// Write implements the io.Writer interface; the whole of p is always reported as written.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.Len(); room < len(p) {
+		if room > 0 {
+			b.Buffer.Write(p[:room])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -3,22 +3,20 @@
 package ci
 
 import (
-	"context"
 	"os/exec"
-	"strings"
+	"syscall"
 )
 
-// Local is an implementation of the CI interface executing code locally.
-type Local struct{}
+// localShell is the shell that runs the commands of local jobs.
+const localShell = "/bin/sh"
 
-// Run implements the CI interface. This method blocks until the job has been
-// completed or an error occurs, e.g., the context times out.
-func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	// TODO: Execute tests in something like ioutil.TempDir(os.TempDir(), "local-ci").
-	cmd := exec.Command("/bin/sh", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
+// setProcessGroup makes the command the leader of a new process group,
+// so that the processes it starts can be killed together.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kills the command's process group.
+func killProcessGroup(cmd *exec.Cmd) {
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
 }
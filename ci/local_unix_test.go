@@ -2,7 +2,12 @@ package ci_test
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/autograde/quickfeed/ci"
 )
@@ -25,3 +30,138 @@ func TestLocal(t *testing.T) {
 		t.Errorf("have %#v want %#v", out, wantOut)
 	}
 }
+
+func TestLocalFailure(t *testing.T) {
+	commands := []string{`echo "building"`, `echo "compile error" >&2`, "exit 2"}
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{Commands: commands})
+	// the job's commands failing is not an error, as with the container runners
+	if err != nil {
+		t.Errorf("Run() = %v, want <nil>", err)
+	}
+	// both standard output and error are returned
+	if want := "building\ncompile error\n"; out != want {
+		t.Errorf("Run() = %q, want %q", out, want)
+	}
+
+	out, err = ci.RunCommands(context.Background(), commands...)
+	if err == nil {
+		t.Error("RunCommands() = <nil>, want exit status error")
+	}
+	if want := "building\ncompile error\n"; out != want {
+		t.Errorf("RunCommands() = %q, want %q", out, want)
+	}
+}
+
+func TestLocalWorkDirAndEnv(t *testing.T) {
+	os.Setenv("QUICKFEED_TEST_SECRET", "secret")
+	defer os.Unsetenv("QUICKFEED_TEST_SECRET")
+
+	local := ci.Local{Env: []string{"LAB=lab1"}}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{"pwd", `echo "secret=$QUICKFEED_TEST_SECRET lab=$LAB"`, `echo "$HOME"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Run() = %q, want three lines", out)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines[0] == wd || !strings.Contains(filepath.Base(lines[0]), "quickfeed-local") {
+		t.Errorf("Run() working directory = %q, want temporary directory", lines[0])
+	}
+	if _, err := os.Stat(lines[0]); !os.IsNotExist(err) {
+		t.Errorf("Run() working directory %q not removed: %v", lines[0], err)
+	}
+	if want := "secret= lab=lab1"; lines[1] != want {
+		t.Errorf("Run() environment = %q, want %q", lines[1], want)
+	}
+	// the job's home is its temporary directory, not the server's home
+	if lines[2] != lines[0] {
+		t.Errorf("Run() HOME = %q, want %q", lines[2], lines[0])
+	}
+}
+
+func TestLocalCredentials(t *testing.T) {
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{
+			"cat > credentials",
+			`git -c include.path="$PWD/credentials" config --get http.extraheader`,
+			"rm credentials",
+			// the credentials are read only once
+			"cat",
+		},
+		Credentials: "[http]\n\textraheader = Authorization: basic secret\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Authorization: basic secret\n"; out != want {
+		t.Errorf("Run() = %q, want %q", out, want)
+	}
+}
+
+func TestLocalTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	marker := filepath.Join(dir, "marker")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	local := ci.Local{}
+	// the background process is killed together with the shell
+	out, err := local.Run(ctx, &ci.Job{
+		Commands: []string{"(sleep 1; touch " + marker + ") &", "sleep 10"},
+	})
+	if err != context.DeadlineExceeded || !strings.HasPrefix(out, "Container timeout") {
+		t.Errorf("Run() = %q, %v, want timeout message, %v", out, err, context.DeadlineExceeded)
+	}
+	time.Sleep(1500 * time.Millisecond)
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Errorf("background process of timed out job not killed: %v", err)
+	}
+}
+
+func TestLocalDetachedProcess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	local := ci.Local{}
+	// a process outside the job's process group keeps the job's output open
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		out, err := local.Run(ctx, &ci.Job{
+			Commands: []string{"echo started", "setsid sleep 10 &", "sleep 10"},
+		})
+		if err != context.DeadlineExceeded || !strings.HasPrefix(out, "Container timeout") {
+			t.Errorf("Run() = %q, %v, want timeout message, %v", out, err, context.DeadlineExceeded)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the timeout")
+	}
+}
+
+func TestLocalOutputLimit(t *testing.T) {
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{"yes quickfeed | head -n 1000000"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) > 100_000 || !strings.Contains(out, "truncated output") {
+		t.Errorf("Run() returned %d bytes, want truncated output", len(out))
+	}
+}
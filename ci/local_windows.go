@@ -1,21 +1,16 @@
 package ci
 
 import (
-	"context"
 	"os/exec"
-	"strings"
 )
 
-// Local is an implementation of the CI interface executing code locally.
-type Local struct{}
+// localShell is the shell that runs the commands of local jobs.
+const localShell = "bash"
 
-// Run implements the CI interface. This method blocks until the job has been
-// completed or an error occurs, e.g., the context times out.
-func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	cmd := exec.Command("bash", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
+// setProcessGroup does nothing; Windows has no process groups to kill.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills the command's process; processes started by it are not killed.
+func killProcessGroup(cmd *exec.Cmd) {
+	_ = cmd.Process.Kill()
 }
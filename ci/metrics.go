@@ -0,0 +1,16 @@
+package ci
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// BuildQueueLengthMetric records the number of builds waiting for a worker by lane and priority
+	BuildQueueLengthMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ag_build_queue_length",
+	}, []string{"lane", "priority"})
+
+	// BuildQueueWaitMetric records the time builds waited for a worker by lane and priority
+	BuildQueueWaitMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ag_build_queue_wait_seconds",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"lane", "priority"})
+)
@@ -2,7 +2,12 @@ package ci
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"text/template"
@@ -10,37 +15,234 @@ import (
 	pb "github.com/autograde/quickfeed/ag"
 )
 
+// CourseScriptDir is the folder in a course's tests repository that may hold
+// script templates to use instead of the built-in script templates.
+const CourseScriptDir = "scripts"
+
+const (
+	// assignmentsDir is the folder of the job's container holding the student's repository.
+	assignmentsDir = "/quickfeed/assignments"
+	// testsDir is the folder of the job's container holding the course's tests repository.
+	testsDir = "/quickfeed/tests"
+)
+
 // AssignmentInfo holds metadata needed to fetch student code
 // and the test repository for an assignment.
 type AssignmentInfo struct {
 	AssignmentName     string
 	Script             string
+	ScriptTemplate     string
 	CreatorAccessToken string
 	GetURL             string
 	TestURL            string
+	TestsCommit        string
+	CourseImage        string
+	Analysis           string
+	Coverage           string
+	ArtifactsDir       string
 	RandomSecret       string
+	// FailedTests is the shell quoted regular expression matching the failed
+	// tests when rerunning these; it is empty when running all the tests.
+	FailedTests string
+	// nonce marks the build log lines printed by the job's own commands.
+	nonce string
 }
 
-func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) *AssignmentInfo {
-	script := assignment.GetScriptFile()
-	if strings.Count(script, ".") < 1 {
-		script = script + ".sh"
-	}
+// courseScriptInfo holds the metadata available to script templates
+// provided by a course's tests repository. Unlike the built-in script
+// templates, these templates cannot access the course creator's access token.
+type courseScriptInfo struct {
+	AssignmentName string
+	GetURL         string
+	TestURL        string
+	TestsCommit    string
+	CourseImage    string
+	Analysis       string
+	Coverage       string
+	ArtifactsDir   string
+	RandomSecret   string
+	FailedTests    string
+}
 
+// newAssignmentInfo returns the metadata needed to run the tests of the given assignment.
+// An error is returned if the assignment's analyzers or coverage cannot be decoded.
+func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) (*AssignmentInfo, error) {
+	analyzers, err := Analyzers(assignment)
+	if err != nil {
+		return nil, err
+	}
+	coverage, err := AssignmentCoverage(assignment)
+	if err != nil {
+		return nil, err
+	}
+	nonce := randomSecret()
 	return &AssignmentInfo{
 		AssignmentName:     assignment.GetName(),
-		Script:             script,
+		Script:             scriptName(assignment),
+		ScriptTemplate:     assignment.GetScriptTemplate(),
 		CreatorAccessToken: course.GetAccessToken(),
 		GetURL:             cloneURL,
 		TestURL:            testURL,
+		TestsCommit:        assignment.GetTestsCommit(),
+		CourseImage:        course.GetDockerImage(),
+		Analysis:           analysisCommands(analyzers, nonce),
+		Coverage:           coverageCommands(coverage, artifactsDir(assignment), nonce),
+		ArtifactsDir:       artifactsDir(assignment),
 		RandomSecret:       randomSecret(),
+		nonce:              nonce,
+	}, nil
+}
+
+// CloneCommands returns the commands that clone the student's repository and the
+// course's tests repository, at the pinned tests commit, if any, and print the
+// tests commit. The clone commands read the git configuration holding the access
+// token, see Credentials, from their standard input into a temporary file, which
+// is removed before the course's script runs. Thus, the access token is neither
+// in the job's commands nor stored in git's configuration, where the tests and
+// the student's code could read it.
+func (info *AssignmentInfo) CloneCommands() string {
+	git := "git"
+	var commands []string
+	if info.CreatorAccessToken != "" {
+		git += ` -c include.path="$QUICKFEED_CREDENTIALS"`
+		commands = append(commands,
+			"QUICKFEED_CREDENTIALS=$(mktemp)",
+			`cat > "$QUICKFEED_CREDENTIALS"`,
+		)
+	}
+	commands = append(commands,
+		git+" clone --quiet "+Quote(info.GetURL)+" "+assignmentsDir,
+		git+" clone --quiet "+Quote(info.TestURL)+" "+testsDir,
+	)
+	if info.CreatorAccessToken != "" {
+		commands = append(commands, `rm -f "$QUICKFEED_CREDENTIALS"`, "unset QUICKFEED_CREDENTIALS")
+	}
+	if info.TestsCommit != "" {
+		commands = append(commands, "git -C "+testsDir+" checkout --quiet "+Quote(info.TestsCommit))
+	}
+	commands = append(commands, `printf "`+marker(testsCommitPrefix, info.nonce)+`%s\n" "$(git -C `+testsDir+` rev-parse HEAD)"`)
+	return strings.Join(commands, "\n")
+}
+
+// Credentials returns the git configuration that gives the clone commands
+// access to the course's repositories with the access token, or the empty
+// string if the course has no access token.
+func (info *AssignmentInfo) Credentials() string {
+	if info.CreatorAccessToken == "" {
+		return ""
+	}
+	credentials := base64.StdEncoding.EncodeToString([]byte(info.CreatorAccessToken + ":x-oauth-basic"))
+	return "[http]\n\textraheader = Authorization: basic " + credentials + "\n"
+}
+
+// scriptName returns the file name of the assignment's script template.
+func scriptName(assignment *pb.Assignment) string {
+	script := assignment.GetScriptFile()
+	if strings.Count(script, ".") < 1 {
+		script = script + ".sh"
+	}
+	return script
+}
+
+// LoadCourseScripts sets the script template of each assignment whose script
+// file is found in the scripts folder of the given tests repository clone.
+// Assignments that pin a commit of the tests repository use the script
+// templates at that commit.
+// An error is returned if any of the loaded script templates are invalid.
+func LoadCourseScripts(testsDir string, assignments []*pb.Assignment) error {
+	for _, assignment := range assignments {
+		if assignment.GetSkipTests() {
+			continue
+		}
+		content, err := readCourseScript(testsDir, assignment)
+		if err != nil {
+			return fmt.Errorf("assignment %s: %w", assignment.GetName(), err)
+		}
+		if content == "" {
+			// use the built-in script template
+			assignment.ScriptTemplate = ""
+			continue
+		}
+		info := &AssignmentInfo{AssignmentName: assignment.GetName(), Script: scriptName(assignment), ScriptTemplate: content}
+		if _, err := parseCourseScript(info); err != nil {
+			return fmt.Errorf("assignment %s: %w", assignment.GetName(), err)
+		}
+		assignment.ScriptTemplate = content
+	}
+	return nil
+}
+
+// readCourseScript returns the assignment's script template from the scripts
+// folder of the given tests repository clone, at the assignment's pinned commit,
+// if any. An empty string is returned if the tests repository has no such template.
+func readCourseScript(testsDir string, assignment *pb.Assignment) (string, error) {
+	script := filepath.Join(CourseScriptDir, scriptName(assignment))
+	commit := assignment.GetTestsCommit()
+	if commit == "" {
+		content, err := ioutil.ReadFile(filepath.Join(testsDir, script))
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return string(content), err
 	}
+	script = filepath.ToSlash(script)
+	out, err := exec.Command("git", "-C", testsDir, "ls-tree", "--name-only", commit, "--", script).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s at commit %s: %w", script, commit, err)
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return "", nil
+	}
+	content, err := exec.Command("git", "-C", testsDir, "show", commit+":"+script).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s at commit %s: %w", script, commit, err)
+	}
+	return string(content), nil
+}
+
+// LoadCourseScript sets the script template of the given assignment to the course's
+// script template at the assignment's pinned commit of the tests repository, which is
+// cloned from the given URL. The built-in script template is used if the tests
+// repository has no script template at that commit.
+func LoadCourseScript(ctx context.Context, course *pb.Course, assignment *pb.Assignment, testURL string) error {
+	cloneDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+	clone := exec.CommandContext(ctx, "git", "clone", "--quiet", "--no-checkout", authURL(testURL, course.GetAccessToken()), cloneDir)
+	if err := clone.Run(); err != nil {
+		return fmt.Errorf("failed to clone %s: %w", testURL, err)
+	}
+	return LoadCourseScripts(cloneDir, []*pb.Assignment{assignment})
+}
+
+// ResolveTestsCommit returns the full hash of the commit that the given tag,
+// branch or commit refers to in the given clone of the tests repository.
+func ResolveTestsCommit(testsDir, ref string) (string, error) {
+	if !IsCommit(ref) && !pb.IsValidBranchName(ref) {
+		return "", fmt.Errorf("invalid tests commit %q", ref)
+	}
+	out, err := exec.Command("git", "-C", testsDir, "rev-parse", "--verify", "--quiet", ref+"^{commit}").Output()
+	if err != nil {
+		return "", fmt.Errorf("tests commit %q not found: %w", ref, err)
+	}
+	return strings.TrimSpace(string(out)), nil
 }
 
 // parseScriptTemplate returns a job describing the docker image to use and
 // the commands of the job. The job is extracted from a script template file
 // provided as input along with assignment metadata for the template.
+// If the assignment info holds a script template from the course's
+// tests repository, that template is used instead of the template file.
+// The tests commit, if any, must be a commit hash.
 func parseScriptTemplate(scriptPath string, info *AssignmentInfo) (*Job, error) {
+	if info.TestsCommit != "" && !IsCommit(info.TestsCommit) {
+		return nil, fmt.Errorf("invalid tests commit %q", info.TestsCommit)
+	}
+	if info.ScriptTemplate != "" {
+		return parseCourseScript(info)
+	}
 	tmplFile := filepath.Join(scriptPath, info.Script)
 	t, err := template.ParseFiles(tmplFile)
 	if err != nil {
@@ -50,7 +252,50 @@ func parseScriptTemplate(scriptPath string, info *AssignmentInfo) (*Job, error)
 	if err := t.Execute(buffer, info); err != nil {
 		return nil, err
 	}
-	s := strings.Split(buffer.String(), "\n")
+	return newJob(buffer.String(), tmplFile, info.nonce)
+}
+
+// parseCourseScript returns a job from the course provided script template
+// in the given assignment info. The template is executed with a restricted
+// set of metadata, and the job's commands are prefixed with commands that
+// clone the student's repository and the tests repository, so that the
+// course's access token is never available to the script. The access token
+// is passed to the clone commands as the job's credentials.
+func parseCourseScript(info *AssignmentInfo) (*Job, error) {
+	t, err := template.New(info.Script).Option("missingkey=error").Parse(info.ScriptTemplate)
+	if err != nil {
+		return nil, err
+	}
+	buffer := new(bytes.Buffer)
+	if err := t.Execute(buffer, &courseScriptInfo{
+		AssignmentName: info.AssignmentName,
+		GetURL:         info.GetURL,
+		TestURL:        info.TestURL,
+		TestsCommit:    info.TestsCommit,
+		CourseImage:    info.CourseImage,
+		Analysis:       info.Analysis,
+		Coverage:       info.Coverage,
+		ArtifactsDir:   info.ArtifactsDir,
+		RandomSecret:   info.RandomSecret,
+		FailedTests:    info.FailedTests,
+	}); err != nil {
+		return nil, err
+	}
+	job, err := newJob(buffer.String(), filepath.Join(CourseScriptDir, info.Script), info.nonce)
+	if err != nil {
+		return nil, err
+	}
+	job.Commands = append(strings.Split(info.CloneCommands(), "\n"), job.Commands...)
+	job.Credentials = info.Credentials()
+	return job, nil
+}
+
+// newJob returns a job from the given executed script template. The first
+// line of the script must specify the docker image to use. The script may
+// declare named stages; the commands before the first stage are run first.
+// The lines printed after each stage are marked with the given nonce.
+func newJob(script, tmplFile, nonce string) (*Job, error) {
+	s := strings.Split(script, "\n")
 	if len(s) < 2 {
 		return nil, fmt.Errorf("no script template in %s", tmplFile)
 	}
@@ -58,5 +303,14 @@ func parseScriptTemplate(scriptPath string, info *AssignmentInfo) (*Job, error)
 	if len(parts) < 2 {
 		return nil, fmt.Errorf("no docker image specified in script template %s", tmplFile)
 	}
-	return &Job{Image: parts[1], Commands: s[1:]}, nil
+	commands, stages, err := parseStages(s[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w in script template %s", err, tmplFile)
+	}
+	if len(stages) > 0 {
+		// export variables set before the first stage to the stages' scripts
+		commands = append([]string{"set -a"}, commands...)
+		commands = append(commands, stageCommands(stages, nonce)...)
+	}
+	return &Job{Image: parts[1], Commands: commands, Stages: stages}, nil
 }
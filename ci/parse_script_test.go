@@ -3,9 +3,17 @@ package ci
 import (
 	"crypto/rand"
 	"crypto/sha1"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestParseScript(t *testing.T) {
@@ -62,3 +70,153 @@ func TestParseScript(t *testing.T) {
 		fmt.Println(j.Image)
 	}
 }
+
+func TestParseScriptWithTestsCommit(t *testing.T) {
+	info := &AssignmentInfo{
+		AssignmentName:     "lab2",
+		Script:             "go.sh",
+		CreatorAccessToken: "secret",
+		GetURL:             getURL,
+		TestURL:            testURL,
+		RandomSecret:       "secret",
+	}
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+	for _, testsCommit := range []string{"", commit} {
+		info.TestsCommit = testsCommit
+		j, err := parseScriptTemplate("scripts", info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script := strings.Join(j.Commands, "\n")
+		checkout := "checkout --quiet '" + commit + "'"
+		if got := strings.Contains(script, checkout); got != (testsCommit != "") {
+			t.Errorf("TestsCommit=%q: script contains %q = %t", testsCommit, checkout, got)
+		}
+		if !strings.Contains(script, testsCommitPrefix) {
+			t.Errorf("TestsCommit=%q: script does not print the tests commit", testsCommit)
+		}
+	}
+	// tags and branches are resolved to commits when the assignments are parsed
+	for _, testsCommit := range []string{"v1.0", commit + "; rm -rf /"} {
+		info.TestsCommit = testsCommit
+		if _, err := parseScriptTemplate("scripts", info); err == nil {
+			t.Errorf("parseScriptTemplate() with TestsCommit=%q: got nil, want error", testsCommit)
+		}
+	}
+}
+
+func TestLoadCourseScriptsWithTestsCommit(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", "tests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+	if out, err := exec.Command("git", "init", "--quiet", testsDir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	script := filepath.Join(CourseScriptDir, "rust.sh")
+	pinned := "#image/rust:1.45\ncargo test\n"
+	first := gitCommit(t, testsDir, map[string]string{script: pinned})
+	if out, err := exec.Command("git", "-C", testsDir, "tag", "v1.0").CombinedOutput(); err != nil {
+		t.Fatalf("git tag: %v: %s", err, out)
+	}
+	gitCommit(t, testsDir, map[string]string{script: "#image/rust:latest\ncargo test\n"})
+
+	commit, err := ResolveTestsCommit(testsDir, "v1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit != first {
+		t.Errorf("ResolveTestsCommit(v1.0) = %q, want %q", commit, first)
+	}
+	for _, ref := range []string{"v2.0", "--help", "v1.0; rm -rf /"} {
+		if _, err := ResolveTestsCommit(testsDir, ref); err == nil {
+			t.Errorf("ResolveTestsCommit(%q): got nil, want error", ref)
+		}
+	}
+
+	// the script template at the pinned commit is used
+	lab1 := &pb.Assignment{Name: "lab1", ScriptFile: "rust", TestsCommit: commit}
+	lab2 := &pb.Assignment{Name: "lab2", ScriptFile: "go", TestsCommit: commit, ScriptTemplate: pinned}
+	if err := LoadCourseScripts(testsDir, []*pb.Assignment{lab1, lab2}); err != nil {
+		t.Fatal(err)
+	}
+	if lab1.GetScriptTemplate() != pinned {
+		t.Errorf("lab1 script template = %q, want %q", lab1.GetScriptTemplate(), pinned)
+	}
+	if lab2.GetScriptTemplate() != "" {
+		t.Errorf("lab2 script template = %q, want built-in script", lab2.GetScriptTemplate())
+	}
+}
+
+func TestLoadCourseScripts(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", "tests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+	if err := os.Mkdir(filepath.Join(testsDir, CourseScriptDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	scripts := map[string]string{
+		"rust.sh":   "#image/rust:latest\ncd /quickfeed/assignments/{{ .AssignmentName }}\n",
+		"secret.sh": "#image/rust:latest\necho {{ .CreatorAccessToken }}\n",
+	}
+	for name, content := range scripts {
+		if err := ioutil.WriteFile(filepath.Join(testsDir, CourseScriptDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lab1 := &pb.Assignment{Name: "lab1", ScriptFile: "rust"}
+	lab2 := &pb.Assignment{Name: "lab2", ScriptFile: "go.sh"}
+	if err := LoadCourseScripts(testsDir, []*pb.Assignment{lab1, lab2}); err != nil {
+		t.Fatal(err)
+	}
+	if lab1.GetScriptTemplate() != scripts["rust.sh"] {
+		t.Errorf("lab1 script template = %q, want %q", lab1.GetScriptTemplate(), scripts["rust.sh"])
+	}
+	if lab2.GetScriptTemplate() != "" {
+		t.Errorf("lab2 script template = %q, want built-in script", lab2.GetScriptTemplate())
+	}
+
+	info, err := newAssignmentInfo(&pb.Course{}, lab1, getURL, testURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info.CreatorAccessToken = "token"
+	j, err := parseScriptTemplate("scripts", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.Image != "rust:latest" {
+		t.Errorf("image = %q, want %q", j.Image, "rust:latest")
+	}
+	// the repositories are cloned before the script runs, without storing the access token
+	clone := strings.Split(info.CloneCommands(), "\n")
+	if diff := cmp.Diff(clone, j.Commands[:len(clone)]); diff != "" {
+		t.Errorf("parseScriptTemplate() commands mismatch (-want +got):\n%s", diff)
+	}
+	if !strings.Contains(clone[2], "clone --quiet '"+getURL+"' /quickfeed/assignments") {
+		t.Errorf("third command %q does not clone the student's repository", clone[2])
+	}
+	if want := "cd /quickfeed/assignments/lab1"; j.Commands[len(clone)] != want {
+		t.Errorf("first script command = %q, want %q", j.Commands[len(clone)], want)
+	}
+	// the access token is only passed to the clone commands as the job's credentials
+	credentials := base64.StdEncoding.EncodeToString([]byte("token:x-oauth-basic"))
+	if !strings.Contains(j.Credentials, credentials) {
+		t.Errorf("job credentials %q do not hold the access token", j.Credentials)
+	}
+	for _, command := range j.Commands {
+		if strings.Contains(command, "token") || strings.Contains(command, credentials) || strings.Contains(command, "git config") {
+			t.Errorf("command %q exposes the access token", command)
+		}
+	}
+
+	// course scripts cannot access the course creator's access token
+	lab3 := &pb.Assignment{Name: "lab3", ScriptFile: "secret"}
+	if err := LoadCourseScripts(testsDir, []*pb.Assignment{lab3}); err == nil {
+		t.Error("LoadCourseScripts() with access to the access token: got nil, want error")
+	}
+}
@@ -0,0 +1,205 @@
+package ci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+// podmanErrorCode is the exit status of podman commands that fail
+// for reasons other than the exit status of the container's command.
+const podmanErrorCode = 125
+
+// Podman is an implementation of the CI interface using Podman, which runs
+// containers without a root daemon when invoked by an unprivileged user.
+type Podman struct {
+	binary string
+}
+
+// NewPodmanCI returns a runner to run CI tests with the podman command found in the PATH.
+func NewPodmanCI() (*Podman, error) {
+	binary, err := exec.LookPath("podman")
+	if err != nil {
+		return nil, fmt.Errorf("podman not found: %w", err)
+	}
+	return &Podman{binary: binary}, nil
+}
+
+// Close implements the io.Closer interface; there is no connection to close.
+func (p *Podman) Close() error {
+	return nil
+}
+
+// Run implements the CI interface. This method blocks until the job has been
+// completed or an error occurs, e.g., the context times out.
+func (p *Podman) Run(ctx context.Context, job *Job) (string, error) {
+	// the job's credentials are passed on the standard input
+	var interactive []string
+	if job.Credentials != "" {
+		interactive = []string{"--interactive"}
+	}
+	// the image is pulled by podman if not found locally
+	args := append(append([]string{"create"}, interactive...), "--name", job.Name, job.Image,
+		"/bin/bash", "-c", strings.Join(job.Commands, "\n"))
+	if _, err := p.output(ctx, args...); err != nil {
+		return "", err
+	}
+	// remove the container when finished to prevent too many open files;
+	// this also stops a runaway container whose deadline was exceeded
+	defer p.remove(job.Name)
+
+	var stdout bytes.Buffer
+	start := exec.CommandContext(ctx, p.binary, append(append([]string{"start", "--attach"}, interactive...), job.Name)...)
+	start.Stdin = strings.NewReader(job.Credentials)
+	start.Stdout = &stdout
+	if err := start.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			// return message to user to be shown in the results log
+			return timeoutOutput(job, stdout.String()), ctx.Err()
+		}
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		// the job's commands failing is not an error, as with docker
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() == podmanErrorCode {
+			return "", fmt.Errorf("failed to start container %s: %w", job.Name, err)
+		}
+	}
+
+	if job.CollectArtifacts != nil && job.ArtifactsDir != "" {
+		p.collectArtifacts(ctx, job)
+	}
+	return jobOutput(job, stdout.String()), nil
+}
+
+// collectArtifacts passes a tar archive of the job's artifacts folder in the
+// job's container to the job's CollectArtifacts function. Nothing is collected
+// if the folder cannot be copied, e.g., because the job did not create it.
+func (p *Podman) collectArtifacts(ctx context.Context, job *Job) {
+	archive, err := p.output(ctx, "cp", job.Name+":"+job.ArtifactsDir, "-")
+	if err != nil {
+		return
+	}
+	job.CollectArtifacts(bytes.NewReader(archive))
+}
+
+// remove removes the given container, stopping it if necessary.
+func (p *Podman) remove(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	_, _ = p.output(ctx, "rm", "--force", "--time", "1", name)
+}
+
+// BuildImage implements the ImageBuilder interface.
+func (p *Podman) BuildImage(ctx context.Context, dir, tag string, labels map[string]string) error {
+	args := append([]string{"build", "--force-rm", "--tag", tag}, labelArgs("--label", labels)...)
+	_, err := p.output(ctx, append(args, dir)...)
+	return err
+}
+
+// FindImage implements the ImageBuilder interface.
+func (p *Podman) FindImage(ctx context.Context, labels map[string]string) (string, error) {
+	images, err := p.images(ctx, labels)
+	if err != nil {
+		return "", err
+	}
+	for _, image := range images {
+		if len(image.tags) > 0 {
+			return image.tags[0], nil
+		}
+	}
+	return "", nil
+}
+
+// RemoveImages implements the ImageBuilder interface.
+func (p *Podman) RemoveImages(ctx context.Context, labels map[string]string, keep string) error {
+	images, err := p.images(ctx, labels)
+	if err != nil {
+		return err
+	}
+	for _, image := range images {
+		// podman names images without a registry after the localhost registry
+		if contains(image.tags, keep) || contains(image.tags, "localhost/"+keep) {
+			continue
+		}
+		// images in use are removed by a later update
+		containers, err := p.output(ctx, "ps", "--all", "--quiet", "--filter", "ancestor="+image.id)
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(containers)) > 0 {
+			continue
+		}
+		if _, err := p.output(ctx, "rmi", image.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// podmanImage is an image listed by podman.
+type podmanImage struct {
+	id   string
+	tags []string
+}
+
+// images returns the images with the given labels, in the order listed by podman.
+func (p *Podman) images(ctx context.Context, labels map[string]string) ([]*podmanImage, error) {
+	args := append([]string{"images", "--format", "{{.ID}} {{.Repository}}:{{.Tag}}"}, labelArgs("--filter=label", labels)...)
+	out, err := p.output(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	var images []*podmanImage
+	byID := make(map[string]*podmanImage)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		image, ok := byID[fields[0]]
+		if !ok {
+			image = &podmanImage{id: fields[0]}
+			byID[image.id] = image
+			images = append(images, image)
+		}
+		// untagged images are listed as <none>:<none>
+		if !strings.Contains(fields[1], "<none>") {
+			image.tags = append(image.tags, fields[1])
+		}
+	}
+	return images, nil
+}
+
+// output runs podman with the given arguments and returns its standard output.
+// The error includes podman's standard error output.
+func (p *Podman) output(ctx context.Context, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.binary, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("podman %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// labelArgs returns the given labels as command line arguments with the given flag, ordered by key.
+func labelArgs(flag string, labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	args := make([]string, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, flag+"="+key+"="+labels[key])
+	}
+	return args
+}
@@ -0,0 +1,139 @@
+// +build darwin linux
+
+package ci
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakePodman is a podman command that logs its arguments, and lets jobs print
+// their commands and fail, unless the job's commands sleep. Image bbb is in use.
+const fakePodman = `#!/bin/sh
+echo "$@" >> "$(dirname "$0")/podman.log"
+case "$1" in
+create) if echo "$@" | grep -q sleep; then touch "$(dirname "$0")/sleep"; fi ;;
+start)
+	if [ -f "$(dirname "$0")/sleep" ]; then echo "sleeping"; exec sleep 10; fi
+	echo "hello world"; echo "warning" >&2; exit 1 ;;
+cp) echo "no such file or directory" >&2; exit 125 ;;
+images)
+	echo "aaa localhost/quickfeed/course-1:abc"
+	echo "aaa localhost/quickfeed/course-1:latest"
+	echo "bbb localhost/quickfeed/course-1:old"
+	echo "ccc <none>:<none>" ;;
+ps) if [ "$5" = "ancestor=bbb" ]; then echo "container-using-bbb"; fi ;;
+esac
+`
+
+// newFakePodman returns a runner using a fake podman command,
+// and a function returning the commands logged by the fake command.
+func newFakePodman(t *testing.T) (*Podman, func() []string) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	binary := filepath.Join(dir, "podman")
+	if err := ioutil.WriteFile(binary, []byte(fakePodman), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return &Podman{binary: binary}, func() []string {
+		log, err := ioutil.ReadFile(filepath.Join(dir, "podman.log"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Split(strings.TrimSpace(string(log)), "\n")
+	}
+}
+
+func TestPodmanRun(t *testing.T) {
+	podman, commands := newFakePodman(t)
+	collected := false
+	out, err := podman.Run(context.Background(), &Job{
+		Name:             "lab1-meling",
+		Image:            "quickfeed:go",
+		Commands:         []string{"go test ./..."},
+		ArtifactsDir:     DefaultArtifactsDir,
+		CollectArtifacts: func(_ io.Reader) { collected = true },
+	})
+	// the job's commands failing is not an error
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "hello world\n"; out != want {
+		t.Errorf("Run() = %q, want %q", out, want)
+	}
+	if collected {
+		t.Error("Run() collected artifacts from a missing folder")
+	}
+	want := []string{
+		"create --name lab1-meling quickfeed:go /bin/bash -c go test ./...",
+		"start --attach lab1-meling",
+		"cp lab1-meling:/quickfeed/artifacts -",
+		"rm --force --time 1 lab1-meling",
+	}
+	if diff := cmp.Diff(want, commands()); diff != "" {
+		t.Errorf("Run() podman commands mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodmanTimeout(t *testing.T) {
+	podman, commands := newFakePodman(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	var log string
+	out, err := podman.Run(ctx, &Job{Name: "lab1-meling", Image: "quickfeed:go", Commands: []string{"sleep 10"},
+		CollectLog: func(all string) { log = all }})
+	if err != context.DeadlineExceeded || out != timeoutMessage {
+		t.Errorf("Run() = %q, %v, want %q, %v", out, err, timeoutMessage, context.DeadlineExceeded)
+	}
+	// the complete log holds the output until the timeout
+	if want := "sleeping\n" + timeoutMessage; log != want {
+		t.Errorf("Run() complete log = %q, want %q", log, want)
+	}
+	// the runaway container is removed
+	if got := commands(); got[len(got)-1] != "rm --force --time 1 lab1-meling" {
+		t.Errorf("Run() podman commands = %v, want container removed", got)
+	}
+}
+
+func TestPodmanImages(t *testing.T) {
+	podman, commands := newFakePodman(t)
+	ctx := context.Background()
+	labels := map[string]string{courseLabel: "1", dockerfileLabel: "abc"}
+	if err := podman.BuildImage(ctx, "/tests/scripts", "quickfeed/course-1:abc", labels); err != nil {
+		t.Fatal(err)
+	}
+	image, err := podman.FindImage(ctx, labels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "localhost/quickfeed/course-1:abc"; image != want {
+		t.Errorf("FindImage() = %q, want %q", image, want)
+	}
+	if err := podman.RemoveImages(ctx, map[string]string{courseLabel: "1"}, "quickfeed/course-1:abc"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"build --force-rm --tag quickfeed/course-1:abc --label=quickfeed.course=1 --label=quickfeed.dockerfile=abc /tests/scripts",
+		"images --format {{.ID}} {{.Repository}}:{{.Tag}} --filter=label=quickfeed.course=1 --filter=label=quickfeed.dockerfile=abc",
+		"images --format {{.ID}} {{.Repository}}:{{.Tag}} --filter=label=quickfeed.course=1",
+		// the kept image's other tags are not removed, nor are images in use
+		"ps --all --quiet --filter ancestor=bbb",
+		"ps --all --quiet --filter ancestor=ccc",
+		"rmi ccc",
+	}
+	if diff := cmp.Diff(want, commands()); diff != "" {
+		t.Errorf("podman commands mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,91 @@
+package ci
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/jinzhu/gorm"
+)
+
+// MissingPrerequisites returns the names of the prerequisites of the given
+// assignment that have not yet been approved for the submitting user or group.
+// For a group submission, an individual prerequisite must be approved
+// for every member of the group. For an individual submission, a group
+// prerequisite must be approved for the user's group.
+func MissingPrerequisites(db database.Database, assignment *pb.Assignment, userID, groupID uint64) ([]string, error) {
+	names := assignment.PrerequisiteNames()
+	if len(names) == 0 {
+		return nil, nil
+	}
+	courseAssignments, err := db.GetAssignmentsByCourse(assignment.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*pb.Assignment)
+	for _, a := range courseAssignments {
+		byName[a.GetName()] = a
+	}
+
+	var missing []string
+	for _, name := range names {
+		prerequisite, ok := byName[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		approved, err := prerequisiteApproved(db, prerequisite, userID, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if !approved {
+			missing = append(missing, name)
+		}
+	}
+	return missing, nil
+}
+
+// prerequisiteApproved returns true if the latest submission for the given
+// prerequisite has been approved for the submitting user or group.
+func prerequisiteApproved(db database.Database, prerequisite *pb.Assignment, userID, groupID uint64) (bool, error) {
+	switch {
+	case prerequisite.GetIsGroupLab() && groupID == 0:
+		enrollment, err := db.GetEnrollmentByCourseAndUser(prerequisite.GetCourseID(), userID)
+		if err != nil {
+			return false, err
+		}
+		if enrollment.GetGroupID() == 0 {
+			return false, nil
+		}
+		return isApproved(db, prerequisite.GetID(), 0, enrollment.GetGroupID())
+
+	case !prerequisite.GetIsGroupLab() && groupID > 0:
+		group, err := db.GetGroup(groupID)
+		if err != nil {
+			return false, err
+		}
+		for _, user := range group.GetUsers() {
+			approved, err := isApproved(db, prerequisite.GetID(), user.GetID(), 0)
+			if err != nil || !approved {
+				return false, err
+			}
+		}
+		return len(group.GetUsers()) > 0, nil
+	}
+	return isApproved(db, prerequisite.GetID(), userID, groupID)
+}
+
+// isApproved returns true if the latest submission for the given assignment
+// and user or group has been approved.
+func isApproved(db database.Database, assignmentID, userID, groupID uint64) (bool, error) {
+	submission, err := db.GetSubmission(&pb.Submission{
+		AssignmentID: assignmentID,
+		UserID:       userID,
+		GroupID:      groupID,
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return submission.GetStatus() == pb.Submission_APPROVED, nil
+}
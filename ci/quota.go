@@ -0,0 +1,159 @@
+package ci
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+)
+
+// pendingBuild is a build of a push over its user's or group's quota,
+// waiting to be run when the quota allows.
+type pendingBuild struct {
+	pushTime time.Time
+	timer    *time.Timer
+}
+
+// SetQuota limits the builds of pushed commits of each user or group to the given
+// number of builds within the given window. Pushes over the quota are built when
+// the quota allows. Zero means no limit.
+func (b *Builds) SetQuota(builds int, window time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.quota = builds
+	b.window = window
+}
+
+// quotaKey returns the key of the builds counted against the quota of the run data's user or group.
+func quotaKey(rData *RunData) buildKey {
+	return buildKey{userID: rData.Repo.GetUserID(), groupID: rData.Repo.GetGroupID()}
+}
+
+// admit returns true if the build of the run data's push is within its user's or group's
+// quota, and counts the build against the quota. Otherwise, it returns the time at which
+// the quota allows the build. Builds of older pushes of the same assignment and repository
+// that are pending are dropped when a build is admitted. Rebuilds are always admitted.
+func (b *Builds) admit(rData *RunData) (time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.quota <= 0 || laneOf(rData) == RebuildLane {
+		return time.Time{}, true
+	}
+	now := b.now()
+	for key, started := range b.started {
+		if now.Sub(started[len(started)-1]) >= b.window {
+			delete(b.started, key)
+		}
+	}
+	key := quotaKey(rData)
+	var recent []time.Time
+	for _, started := range b.started[key] {
+		if now.Sub(started) < b.window {
+			recent = append(recent, started)
+		}
+	}
+	if len(recent) >= b.quota {
+		b.started[key] = recent
+		// the builds are recorded in the order they started
+		return recent[len(recent)-b.quota].Add(b.window), false
+	}
+	b.started[key] = append(recent, now)
+
+	pendingKey := newBuildKey(rData)
+	if p := b.pending[pendingKey]; p != nil && !p.pushTime.After(rData.PushTime) {
+		p.timer.Stop()
+		delete(b.pending, pendingKey)
+	}
+	return time.Time{}, true
+}
+
+// postpone runs the given build of the run data's push at the given time, replacing
+// the pending build of an older push of the same assignment and repository, if any.
+// It returns false if the build of a newer push is pending; the build is then dropped.
+func (b *Builds) postpone(rData *RunData, at time.Time, build func()) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := newBuildKey(rData)
+	if p := b.pending[key]; p != nil {
+		if p.pushTime.After(rData.PushTime) {
+			return false
+		}
+		p.timer.Stop()
+	}
+	p := &pendingBuild{pushTime: rData.PushTime}
+	p.timer = time.AfterFunc(at.Sub(b.now()), func() {
+		b.mu.Lock()
+		if b.pending[key] != p {
+			// the build was replaced or canceled
+			b.mu.Unlock()
+			return
+		}
+		delete(b.pending, key)
+		b.mu.Unlock()
+		build()
+	})
+	b.pending[key] = p
+	return true
+}
+
+// pendingLog returns the build log of the run data's push, explaining that it is built at the given time.
+func (b *Builds) pendingLog(rData *RunData, at time.Time) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return fmt.Sprintf("Build pending: at most %d builds are run within %v for each student or group.\n"+
+		"Commit %s will be built at %s.", b.quota, b.window, rData.CommitID, at.Format("2006-01-02 15:04:05"))
+}
+
+// recordPending marks the latest submission of the run data's assignment and repository
+// as pending a build of the pushed commit, with the given message telling when it is built.
+// The submission keeps the commit, scores and build information of its last build until
+// the pending build has run.
+func recordPending(logger *zap.SugaredLogger, db database.Database, rData *RunData, pendingLog string) {
+	submissionQuery := &pb.Submission{
+		AssignmentID: rData.Assignment.GetID(),
+		UserID:       rData.Repo.GetUserID(),
+		GroupID:      rData.Repo.GetGroupID(),
+	}
+	newest, err := db.GetSubmission(submissionQuery)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logger.Errorf("Failed to get submission data from database: %w", err)
+		return
+	}
+	if newerPush(newest, rData.PushTime) {
+		return
+	}
+	var buildInfo BuildInfo
+	if newest.GetBuildInfo() != "" {
+		if err := json.Unmarshal([]byte(newest.GetBuildInfo()), &buildInfo); err != nil {
+			logger.Errorf("Failed to unmarshal build info: %w", err)
+			return
+		}
+	}
+	// the push date of the last build is kept, so that the results of
+	// a running build of an older push may still be recorded
+	buildInfo.Pending = true
+	buildInfo.PendingCommit = rData.CommitID
+	buildInfo.PendingLog = pendingLog
+	b, err := json.Marshal(&buildInfo)
+	if err != nil {
+		logger.Errorf("Failed to marshal build info: %w", err)
+		return
+	}
+	submission := &pb.Submission{
+		AssignmentID: rData.Assignment.GetID(),
+		BuildInfo:    string(b),
+		CommitHash:   newest.GetCommitHash(),
+		Score:        newest.GetScore(),
+		ScoreObjects: newest.GetScoreObjects(),
+		UserID:       rData.Repo.GetUserID(),
+		GroupID:      rData.Repo.GetGroupID(),
+		Status:       newest.GetStatus(),
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		logger.Errorf("Failed to add submission to database: %w", err)
+	}
+}
@@ -0,0 +1,236 @@
+package ci
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"go.uber.org/zap"
+)
+
+func TestBuildsQuota(t *testing.T) {
+	now := time.Now()
+	builds := NewBuilds(0, 0)
+	builds.now = func() time.Time { return now }
+	builds.SetQuota(2, time.Hour)
+	push := func(assignmentID, userID uint64) *RunData {
+		return &RunData{
+			Assignment: &pb.Assignment{ID: assignmentID},
+			Repo:       &pb.Repository{UserID: userID},
+			PushTime:   now,
+		}
+	}
+
+	if _, ok := builds.admit(push(1, 1)); !ok {
+		t.Error("admit() of first push = false, want true")
+	}
+	now = now.Add(10 * time.Minute)
+	if _, ok := builds.admit(push(2, 1)); !ok {
+		t.Error("admit() of second push = false, want true")
+	}
+	now = now.Add(10 * time.Minute)
+	// the quota is shared by all assignments of a user
+	at, ok := builds.admit(push(1, 1))
+	if ok {
+		t.Error("admit() of third push = true, want false")
+	}
+	if want := now.Add(40 * time.Minute); !at.Equal(want) {
+		t.Errorf("admit() of third push = %v, want %v", at, want)
+	}
+	if _, ok := builds.admit(push(1, 2)); !ok {
+		t.Error("admit() of other user's push = false, want true")
+	}
+	rebuild := push(1, 1)
+	rebuild.PushTime = time.Time{}
+	if _, ok := builds.admit(rebuild); !ok {
+		t.Error("admit() of rebuild = false, want true")
+	}
+
+	// the quota allows a build when the first build has left the window
+	now = now.Add(40 * time.Minute)
+	if _, ok := builds.admit(push(1, 1)); !ok {
+		t.Error("admit() of push after window = false, want true")
+	}
+}
+
+func TestBuildsPostpone(t *testing.T) {
+	builds := NewBuilds(0, 0)
+	push := func(pushTime time.Time) *RunData {
+		return &RunData{
+			Assignment: &pb.Assignment{ID: 1},
+			Repo:       &pb.Repository{UserID: 1},
+			PushTime:   pushTime,
+		}
+	}
+	now := time.Now()
+	built := make(chan time.Time, 3)
+	postpone := func(pushTime time.Time, after time.Duration) bool {
+		return builds.postpone(push(pushTime), time.Now().Add(after), func() { built <- pushTime })
+	}
+
+	if !postpone(now, 50*time.Millisecond) {
+		t.Error("postpone() of first push = false, want true")
+	}
+	// a newer push replaces the pending build of an older push
+	if !postpone(now.Add(time.Minute), 50*time.Millisecond) {
+		t.Error("postpone() of newer push = false, want true")
+	}
+	if postpone(now, 50*time.Millisecond) {
+		t.Error("postpone() of older push = true, want false")
+	}
+	if got := <-built; !got.Equal(now.Add(time.Minute)) {
+		t.Errorf("built push of %v, want %v", got, now.Add(time.Minute))
+	}
+
+	// a canceled pending build is not run
+	postpone(now.Add(2*time.Minute), 50*time.Millisecond)
+	if canceled := builds.Cancel(1, 1, 0); canceled != 1 {
+		t.Errorf("Cancel() = %d, want 1", canceled)
+	}
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case got := <-built:
+		t.Errorf("built push of %v, want no build", got)
+	default:
+	}
+}
+
+func TestRecordPending(t *testing.T) {
+	f, err := ioutil.TempFile("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	db, err := database.NewGormDB("sqlite3", f.Name(), database.NewGormLogger(database.BuildLogger()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var user pb.User
+	if err := db.CreateUserFromRemoteIdentity(&user, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{}
+	if err := db.CreateCourse(user.GetID(), course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.GetID(), Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	graded := &pb.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       user.GetID(),
+		CommitHash:   "abc",
+		Score:        80,
+		ScoreObjects: `[{"TestName":"TestA","Score":8,"MaxScore":10,"Weight":1}]`,
+		BuildInfo:    `{"builddate":"2020-11-20T12:00:00","buildlog":"PASS","pushdate":"2020-11-20T11:59:00Z"}`,
+	}
+	if err := db.CreateSubmission(graded); err != nil {
+		t.Fatal(err)
+	}
+
+	rData := &RunData{
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: user.GetID()},
+		CommitID:   "def",
+		PushTime:   time.Date(2020, 11, 20, 13, 0, 0, 0, time.UTC),
+	}
+	recordPending(zap.NewNop().Sugar(), db, rData, "Build pending")
+
+	got, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.GetID(), UserID: user.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the last graded result is kept
+	if got.GetCommitHash() != graded.GetCommitHash() || got.GetScore() != graded.GetScore() || got.GetScoreObjects() != graded.GetScoreObjects() {
+		t.Errorf("recordPending() submission = %v, want graded result of %v", got, graded)
+	}
+	var buildInfo BuildInfo
+	if err := json.Unmarshal([]byte(got.GetBuildInfo()), &buildInfo); err != nil {
+		t.Fatal(err)
+	}
+	want := BuildInfo{
+		BuildDate:     "2020-11-20T12:00:00",
+		BuildLog:      "PASS",
+		PushDate:      "2020-11-20T11:59:00Z",
+		Pending:       true,
+		PendingCommit: "def",
+		PendingLog:    "Build pending",
+	}
+	if diff := cmp.Diff(want, buildInfo); diff != "" {
+		t.Errorf("recordPending() build info mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCachedResultAfterPending(t *testing.T) {
+	f, err := ioutil.TempFile("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	db, err := database.NewGormDB("sqlite3", f.Name(), database.NewGormLogger(database.BuildLogger()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var user pb.User
+	if err := db.CreateUserFromRemoteIdentity(&user, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{}
+	if err := db.CreateCourse(user.GetID(), course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.GetID(), Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	graded := &pb.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       user.GetID(),
+		CommitHash:   "abc",
+		Score:        80,
+		ScoreObjects: `[{"TestName":"TestA","Score":8,"MaxScore":10,"Weight":1}]`,
+		BuildInfo:    `{"builddate":"2020-11-20T12:00:00","buildlog":"PASS","pushdate":"2020-11-20T11:59:00Z","cachekey":"key"}`,
+	}
+	if err := db.CreateSubmission(graded); err != nil {
+		t.Fatal(err)
+	}
+
+	// a push over the quota, that is built later with the same cache key
+	rData := &RunData{
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: user.GetID()},
+		CommitID:   "def",
+		PushTime:   time.Date(2020, 11, 20, 13, 0, 0, 0, time.UTC),
+	}
+	recordPending(zap.NewNop().Sugar(), db, rData, "Build pending")
+
+	result, err := cachedResult(db, rData, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result == nil {
+		t.Fatal("cachedResult() = nil, want result of the graded submission")
+	}
+	if result.BuildInfo.Pending || result.BuildInfo.PendingCommit != "" || result.BuildInfo.PendingLog != "" {
+		t.Errorf("cachedResult() build info = %+v, want no pending build", result.BuildInfo)
+	}
+	if want := "2020-11-20T13:00:00Z"; result.BuildInfo.PushDate != want {
+		t.Errorf("cachedResult() push date = %q, want %q", result.BuildInfo.PushDate, want)
+	}
+	if result.BuildInfo.BuildLog != "PASS" {
+		t.Errorf("cachedResult() build log = %q, want %q", result.BuildInfo.BuildLog, "PASS")
+	}
+}
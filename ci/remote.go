@@ -0,0 +1,436 @@
+package ci
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/autograde/quickfeed/ci/agent"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultHeartbeatTimeout is the time after an agent's last message
+// at which the agent is considered lost and its jobs are rescheduled.
+const DefaultHeartbeatTimeout = 30 * time.Second
+
+// agentAuthKey is the metadata key of the token that authenticates runner agents.
+const agentAuthKey = "authorization"
+
+// Remote is an implementation of the CI interface that dispatches jobs to
+// runner agents connected over gRPC. A job is run by the least loaded agent
+// that has all the job's labels and free capacity. If the agent is lost
+// before the job has completed, the job is rescheduled on another agent.
+type Remote struct {
+	token            string
+	heartbeatTimeout time.Duration
+	jobIDs           int64
+	// insecure allows agents to connect without TLS; only used in tests.
+	insecure bool
+
+	mu      sync.Mutex
+	agents  map[*remoteAgent]bool
+	changed chan struct{} // closed when an agent has been registered or has gained free capacity
+	done    chan struct{}
+}
+
+// remoteAgent is a runner agent connected to the server.
+type remoteAgent struct {
+	name     string
+	labels   map[string]bool
+	capacity int
+	// running is the number of jobs that the agent reported running in its last heartbeat;
+	// it may exceed the number of dispatched jobs if the agent missed a job's cancellation.
+	running  int
+	lastSeen time.Time
+	// results holds the result channel of each job dispatched to the agent
+	results map[string]chan *agent.JobResult
+	send    chan *agent.ServerMessage
+	lost    chan struct{} // closed when the agent has been unregistered
+}
+
+// AgentStatus describes a runner agent connected to the server.
+type AgentStatus struct {
+	Name     string
+	Labels   []string
+	Capacity int
+	Running  int
+	LastSeen time.Time
+}
+
+// NewRemote returns a runner that dispatches jobs to runner agents authenticated
+// by the given token. Agents that have not been heard from within the heartbeat
+// timeout are considered lost.
+func NewRemote(token string, heartbeatTimeout time.Duration) (*Remote, error) {
+	if token == "" {
+		return nil, errors.New("runner agent token is required")
+	}
+	if heartbeatTimeout <= 0 {
+		heartbeatTimeout = DefaultHeartbeatTimeout
+	}
+	r := &Remote{
+		token:            token,
+		heartbeatTimeout: heartbeatTimeout,
+		agents:           make(map[*remoteAgent]bool),
+		changed:          make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+	go r.monitor()
+	return r, nil
+}
+
+// Close unregisters all agents and stops monitoring their heartbeats.
+func (r *Remote) Close() error {
+	close(r.done)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for a := range r.agents {
+		r.remove(a)
+	}
+	return nil
+}
+
+// Agents returns the status of the connected agents, ordered by name.
+func (r *Remote) Agents() []*AgentStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	agents := make([]*AgentStatus, 0, len(r.agents))
+	for a := range r.agents {
+		labels := make([]string, 0, len(a.labels))
+		for label := range a.labels {
+			labels = append(labels, label)
+		}
+		sort.Strings(labels)
+		agents = append(agents, &AgentStatus{
+			Name:     a.name,
+			Labels:   labels,
+			Capacity: a.capacity,
+			Running:  a.load(),
+			LastSeen: a.lastSeen,
+		})
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].Name < agents[j].Name })
+	return agents
+}
+
+// Run implements the CI interface. This method blocks until an agent has
+// completed the job, or the context is done. A job whose agent is lost is
+// rescheduled on another agent.
+func (r *Remote) Run(ctx context.Context, job *Job) (string, error) {
+	jobID := strconv.FormatInt(atomic.AddInt64(&r.jobIDs, 1), 10)
+	for {
+		a, results, err := r.dispatch(ctx, jobID, job)
+		if err != nil {
+			return "", err
+		}
+		select {
+		case result := <-results:
+			r.release(a, jobID)
+			return complete(job, result)
+		case <-a.lost:
+			r.release(a, jobID)
+		case <-ctx.Done():
+			r.release(a, jobID)
+			r.cancel(a, jobID)
+			if ctx.Err() == context.DeadlineExceeded {
+				return timeoutMessage, ctx.Err()
+			}
+			return "", ctx.Err()
+		}
+	}
+}
+
+// dispatch sends the job to an agent that can run it, waiting for such an agent
+// until the context is done. It returns the agent and the channel on which the
+// agent's result is delivered.
+func (r *Remote) dispatch(ctx context.Context, jobID string, job *Job) (*remoteAgent, chan *agent.JobResult, error) {
+	for {
+		r.mu.Lock()
+		if a := r.pick(job.Labels); a != nil {
+			results := make(chan *agent.JobResult, 1)
+			a.results[jobID] = results
+			r.mu.Unlock()
+			msg := &agent.ServerMessage{Message: &agent.ServerMessage_Job{Job: remoteJob(ctx, jobID, job)}}
+			select {
+			case a.send <- msg:
+				return a, results, nil
+			case <-a.lost:
+				r.release(a, jobID)
+				continue
+			case <-ctx.Done():
+				r.release(a, jobID)
+				return nil, nil, ctx.Err()
+			}
+		}
+		changed := r.changed
+		r.mu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("no runner agent with labels %v available: %w", job.Labels, ctx.Err())
+		}
+	}
+}
+
+// pick returns the least loaded agent with free capacity and all the given labels,
+// or nil if there is no such agent. The lock must be held.
+func (r *Remote) pick(labels []string) *remoteAgent {
+	var best *remoteAgent
+	for a := range r.agents {
+		if !a.hasLabels(labels) || a.load() >= a.capacity {
+			continue
+		}
+		// compare the fractions of used capacity without division
+		if best == nil || a.load()*best.capacity < best.load()*a.capacity ||
+			(a.load()*best.capacity == best.load()*a.capacity && a.name < best.name) {
+			best = a
+		}
+	}
+	return best
+}
+
+// release frees the agent's capacity used by the given job.
+func (r *Remote) release(a *remoteAgent, jobID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(a.results, jobID)
+	r.notify()
+}
+
+// cancel tells the agent to stop the given job, unless the agent is busy or lost.
+func (r *Remote) cancel(a *remoteAgent, jobID string) {
+	msg := &agent.ServerMessage{Message: &agent.ServerMessage_Cancel{Cancel: &agent.CancelJob{JobID: jobID}}}
+	select {
+	case a.send <- msg:
+	case <-a.lost:
+	default:
+	}
+}
+
+// notify wakes up jobs waiting for an agent. The lock must be held.
+func (r *Remote) notify() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// remove unregisters the agent, if registered. The lock must be held.
+func (r *Remote) remove(a *remoteAgent) {
+	if r.agents[a] {
+		delete(r.agents, a)
+		close(a.lost)
+	}
+}
+
+// monitor unregisters agents that have not been heard from within the heartbeat timeout.
+func (r *Remote) monitor() {
+	ticker := time.NewTicker(r.heartbeatTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.done:
+			return
+		case now := <-ticker.C:
+			r.mu.Lock()
+			for a := range r.agents {
+				if now.Sub(a.lastSeen) > r.heartbeatTimeout {
+					r.remove(a)
+				}
+			}
+			r.mu.Unlock()
+		}
+	}
+}
+
+// Connect implements the RunnerService interface. An agent connects by sending
+// its registration, after which it receives jobs until the stream is closed or
+// the agent is lost. Agents must connect over TLS, since the jobs' credentials
+// carry the course's access token.
+func (r *Remote) Connect(stream agent.RunnerService_ConnectServer) error {
+	if !r.insecure && !isTLS(stream.Context()) {
+		return status.Error(codes.PermissionDenied, "runner agents must connect over TLS")
+	}
+	if err := r.authenticate(stream.Context()); err != nil {
+		return err
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	reg := msg.GetRegister()
+	if reg == nil || reg.GetName() == "" || reg.GetCapacity() == 0 {
+		return status.Error(codes.InvalidArgument, "agent must register with a name and capacity")
+	}
+	a := r.register(reg)
+	defer func() {
+		r.mu.Lock()
+		r.remove(a)
+		r.mu.Unlock()
+	}()
+
+	errc := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				errc <- err
+				return
+			}
+			r.handle(a, msg)
+		}
+	}()
+	for {
+		select {
+		case msg := <-a.send:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case err := <-errc:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-a.lost:
+			return status.Errorf(codes.Unavailable, "agent %s lost", a.name)
+		}
+	}
+}
+
+// isTLS returns true if the context's peer is connected over TLS.
+func isTLS(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	_, ok = p.AuthInfo.(credentials.TLSInfo)
+	return ok
+}
+
+// authenticate checks that the context carries the runner agent token.
+func (r *Remote) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	want := []byte("Bearer " + r.token)
+	for _, token := range md.Get(agentAuthKey) {
+		if subtle.ConstantTimeCompare([]byte(token), want) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid runner agent token")
+}
+
+// register adds an agent as described by its registration.
+func (r *Remote) register(reg *agent.Register) *remoteAgent {
+	a := &remoteAgent{
+		name:     reg.GetName(),
+		labels:   make(map[string]bool),
+		capacity: int(reg.GetCapacity()),
+		lastSeen: time.Now(),
+		results:  make(map[string]chan *agent.JobResult),
+		send:     make(chan *agent.ServerMessage, reg.GetCapacity()),
+		lost:     make(chan struct{}),
+	}
+	for _, label := range reg.GetLabels() {
+		a.labels[label] = true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.agents[a] = true
+	r.notify()
+	return a
+}
+
+// handle records a heartbeat or a job result received from the agent.
+func (r *Remote) handle(a *remoteAgent, msg *agent.AgentMessage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a.lastSeen = time.Now()
+	switch m := msg.GetMessage().(type) {
+	case *agent.AgentMessage_Heartbeat:
+		free := a.capacity - a.load()
+		if capacity := int(m.Heartbeat.GetCapacity()); capacity > 0 {
+			a.capacity = capacity
+		}
+		a.running = int(m.Heartbeat.GetRunning())
+		if a.capacity-a.load() > free {
+			r.notify()
+		}
+	case *agent.AgentMessage_Result:
+		// the job is no longer running on the agent
+		if a.running > 0 {
+			a.running--
+		}
+		if results, ok := a.results[m.Result.GetJobID()]; ok {
+			select {
+			case results <- m.Result:
+			default:
+				// ignore duplicate results
+			}
+		}
+	}
+}
+
+// load returns the number of jobs the agent is running: the jobs dispatched to it,
+// or the jobs it reported running, if more. The lock must be held.
+func (a *remoteAgent) load() int {
+	if a.running > len(a.results) {
+		return a.running
+	}
+	return len(a.results)
+}
+
+// hasLabels returns true if the agent has all the given labels.
+func (a *remoteAgent) hasLabels(labels []string) bool {
+	for _, label := range labels {
+		if !a.labels[label] {
+			return false
+		}
+	}
+	return true
+}
+
+// remoteJob returns the job message sent to an agent. The job's timeout
+// is the time remaining until the context's deadline, if any.
+func remoteJob(ctx context.Context, jobID string, job *Job) *agent.Job {
+	j := &agent.Job{
+		JobID:        jobID,
+		Name:         job.Name,
+		Image:        job.Image,
+		Commands:     job.Commands,
+		ArtifactsDir: job.ArtifactsDir,
+		Credentials:  job.Credentials,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		j.Timeout = time.Until(deadline).Milliseconds()
+	}
+	return j
+}
+
+// complete returns the output and error of the job's result,
+// and passes the result's artifacts and complete log to the job.
+func complete(job *Job, result *agent.JobResult) (string, error) {
+	if len(result.GetArtifacts()) > 0 && job.CollectArtifacts != nil {
+		job.CollectArtifacts(bytes.NewReader(result.GetArtifacts()))
+	}
+	if len(result.GetLog()) > 0 && job.CollectLog != nil {
+		if log, err := DecompressLog(result.GetLog()); err == nil {
+			job.CollectLog(log)
+		}
+	}
+	switch {
+	case result.GetTimeout():
+		return result.GetOutput(), context.DeadlineExceeded
+	case result.GetError() != "":
+		return result.GetOutput(), errors.New(result.GetError())
+	}
+	return result.GetOutput(), nil
+}
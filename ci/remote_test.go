@@ -0,0 +1,265 @@
+package ci
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/autograde/quickfeed/ci/agent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// agentRunner is a runner that outputs the agent's name and the job's commands,
+// and leaves the job's name as its artifacts. Its complete log also includes the
+// job's image, as if the output was truncated. If started is set, the runner
+// signals that the job has started, and blocks until the job is canceled or
+// times out, like the Docker runner.
+type agentRunner struct {
+	name    string
+	started chan string
+}
+
+func (r agentRunner) Run(ctx context.Context, job *Job) (string, error) {
+	if r.started != nil {
+		r.started <- job.Name
+		<-ctx.Done()
+		if ctx.Err() == context.DeadlineExceeded {
+			return timeoutMessage, ctx.Err()
+		}
+		return "", ctx.Err()
+	}
+	if job.CollectArtifacts != nil {
+		job.CollectArtifacts(strings.NewReader(job.Name))
+	}
+	out := r.name + ": " + strings.Join(job.Commands, ";")
+	if job.CollectLog != nil {
+		job.CollectLog(job.Image + "\n" + out)
+	}
+	return out, nil
+}
+
+// startRemote starts a runner service on an in-memory listener, and returns the
+// remote runner and a client connection to the service.
+func startRemote(t *testing.T) (*Remote, agent.RunnerServiceClient) {
+	t.Helper()
+	remote, err := NewRemote("secret", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the in-memory listener does not use TLS
+	remote.insecure = true
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	agent.RegisterRunnerServiceServer(server, remote)
+	go server.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+		remote.Close()
+	})
+	return remote, agent.NewRunnerServiceClient(conn)
+}
+
+// serveAgent connects the agent to the runner service until the returned function is called.
+func serveAgent(t *testing.T, remote *Remote, client agent.RunnerServiceClient, a *Agent) func() {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	go a.Serve(ctx, client, "secret")
+	for i := 0; !hasAgent(remote, a.Name); i++ {
+		if i == 100 {
+			t.Fatalf("agent %s not registered", a.Name)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return cancel
+}
+
+func hasAgent(remote *Remote, name string) bool {
+	for _, a := range remote.Agents() {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRemoteRunLabels(t *testing.T) {
+	remote, client := startRemote(t)
+	defer serveAgent(t, remote, client, &Agent{Name: "a", Capacity: 1, Runner: agentRunner{name: "a"}})()
+	defer serveAgent(t, remote, client, &Agent{Name: "b", Labels: []string{"java", "large-mem"}, Capacity: 1, Runner: agentRunner{name: "b"}})()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var artifacts []byte
+	var log string
+	job := &Job{
+		Name:         "lab1-meling",
+		Image:        "quickfeed:java",
+		Commands:     []string{"make", "java"},
+		ArtifactsDir: DefaultArtifactsDir,
+		Labels:       []string{"java"},
+		CollectArtifacts: func(archive io.Reader) {
+			artifacts, _ = ioutil.ReadAll(archive)
+		},
+		CollectLog: func(all string) {
+			log = all
+		},
+	}
+	out, err := remote.Run(ctx, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "b: make;java"; out != want {
+		t.Errorf("Run() = %q, want %q", out, want)
+	}
+	if !bytes.Equal(artifacts, []byte(job.Name)) {
+		t.Errorf("Run() artifacts = %q, want %q", artifacts, job.Name)
+	}
+	if want := "quickfeed:java\nb: make;java"; log != want {
+		t.Errorf("Run() complete log = %q, want %q", log, want)
+	}
+
+	// no agent has the label; the job fails without blaming the job's commands
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	out, err = remote.Run(ctx, &Job{Name: "lab1-meling", Labels: []string{"gpu"}})
+	if err == nil || out == timeoutMessage {
+		t.Errorf("Run() without matching agent = %q, %v, want error", out, err)
+	}
+}
+
+func TestRemoteReschedule(t *testing.T) {
+	remote, client := startRemote(t)
+	started := make(chan string, 1)
+	stopA := serveAgent(t, remote, client, &Agent{Name: "a", Capacity: 1, Runner: agentRunner{name: "a", started: started}})
+	defer stopA()
+
+	type result struct {
+		out string
+		err error
+	}
+	results := make(chan result, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		out, err := remote.Run(ctx, &Job{Name: "lab1-meling", Commands: []string{"go test"}})
+		results <- result{out, err}
+	}()
+	<-started
+	// agent a is busy, so another job must wait for free capacity
+	if agents := remote.Agents(); len(agents) != 1 || agents[0].Running != 1 {
+		t.Errorf("Agents() = %v, want agent a running 1 job", agents)
+	}
+
+	defer serveAgent(t, remote, client, &Agent{Name: "b", Capacity: 1, Runner: agentRunner{name: "b"}})()
+	// agent a disappears; its job is rescheduled on agent b
+	stopA()
+	r := <-results
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	if want := "b: go test"; r.out != want {
+		t.Errorf("Run() = %q, want %q", r.out, want)
+	}
+}
+
+func TestRemoteTimeout(t *testing.T) {
+	remote, client := startRemote(t)
+	started := make(chan string, 1)
+	defer serveAgent(t, remote, client, &Agent{Name: "a", Capacity: 1, Runner: agentRunner{name: "a", started: started}})()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	out, err := remote.Run(ctx, &Job{Name: "lab1-meling"})
+	if err != context.DeadlineExceeded || out != timeoutMessage {
+		t.Errorf("Run() = %q, %v, want %q, %v", out, err, timeoutMessage, context.DeadlineExceeded)
+	}
+	if agents := remote.Agents(); len(agents) != 1 || agents[0].Running != 0 {
+		t.Errorf("Agents() = %v, want agent a with free capacity", agents)
+	}
+}
+
+func TestRemoteAuthentication(t *testing.T) {
+	remote, client := startRemote(t)
+	a := &Agent{Name: "a", Capacity: 1, Runner: agentRunner{name: "a"}}
+	err := a.Serve(context.Background(), client, "guess")
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Serve() = %v, want %v", err, codes.Unauthenticated)
+	}
+	if len(remote.Agents()) != 0 {
+		t.Errorf("Agents() = %v, want no agents", remote.Agents())
+	}
+	if _, err := NewRemote("", time.Second); err == nil {
+		t.Error("NewRemote() without token = <nil>, want error")
+	}
+}
+
+func TestRemoteRequiresTLS(t *testing.T) {
+	remote, client := startRemote(t)
+	remote.insecure = false
+	a := &Agent{Name: "a", Capacity: 1, Runner: agentRunner{name: "a"}}
+	err := a.Serve(context.Background(), client, "secret")
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Serve() without TLS = %v, want %v", err, codes.PermissionDenied)
+	}
+	if len(remote.Agents()) != 0 {
+		t.Errorf("Agents() = %v, want no agents", remote.Agents())
+	}
+}
+
+func TestRemoteHeartbeatRunning(t *testing.T) {
+	remote, err := NewRemote("secret", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remote.Close()
+	a := remote.register(&agent.Register{Name: "a", Capacity: 1})
+	heartbeat := func(running uint32) {
+		remote.handle(a, &agent.AgentMessage{Message: &agent.AgentMessage_Heartbeat{Heartbeat: &agent.Heartbeat{Running: running, Capacity: 1}}})
+	}
+
+	// the agent still runs a job that the server has given up on
+	heartbeat(1)
+	if got := remote.pick(nil); got != nil {
+		t.Errorf("pick() = %s, want no agent with free capacity", got.name)
+	}
+	if agents := remote.Agents(); len(agents) != 1 || agents[0].Running != 1 {
+		t.Errorf("Agents() = %v, want agent a running 1 job", agents)
+	}
+	// the job's result frees the agent's capacity
+	remote.handle(a, &agent.AgentMessage{Message: &agent.AgentMessage_Result{Result: &agent.JobResult{JobID: "1"}}})
+	if got := remote.pick(nil); got != a {
+		t.Errorf("pick() = %v, want agent a", got)
+	}
+	heartbeat(1)
+	heartbeat(0)
+	if got := remote.pick(nil); got != a {
+		t.Errorf("pick() = %v, want agent a", got)
+	}
+}
+
+func TestRemoteHeartbeatTimeout(t *testing.T) {
+	remote, client := startRemote(t)
+	// the agent's heartbeats are less frequent than the server's heartbeat timeout
+	defer serveAgent(t, remote, client, &Agent{Name: "a", Capacity: 1, Runner: agentRunner{name: "a"}, Heartbeat: time.Minute})()
+	for i := 0; hasAgent(remote, "a"); i++ {
+		if i == 300 {
+			t.Fatal("agent a not unregistered after heartbeat timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
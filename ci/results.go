@@ -2,6 +2,8 @@ package ci
 
 import (
 	"encoding/json"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -20,19 +22,89 @@ type Result struct {
 
 // BuildInfo holds build data for one test execution for an assignment.
 type BuildInfo struct {
-	BuildID   int64  `json:"buildid"`
-	BuildDate string `json:"builddate"`
-	BuildLog  string `json:"buildlog"`
-	ExecTime  int64  `json:"execTime"`
+	BuildID     int64              `json:"buildid"`
+	BuildDate   string             `json:"builddate"`
+	BuildLog    string             `json:"buildlog"`
+	ExecTime    int64              `json:"execTime"`
+	TestsCommit string             `json:"testscommit"`
+	CacheKey    string             `json:"cachekey,omitempty"`
+	Stages      []*StageResult     `json:"stages,omitempty"`
+	Analysis    []*AnalysisResult  `json:"analysis,omitempty"`
+	Coverage    *CoverageResult    `json:"coverage,omitempty"`
+	Benchmarks  []*BenchmarkResult `json:"benchmarks,omitempty"`
+	Reruns      int                `json:"reruns,omitempty"`
+	Flaky       []string           `json:"flaky,omitempty"`    // tests that both passed and failed in this build's runs
+	PushDate    string             `json:"pushdate,omitempty"` // time at which the push was received, in RFC 3339 format
+	// Pending is set when a newer push is built later, as its user or group is over
+	// the build quota; the other fields describe the last build until then.
+	Pending       bool   `json:"pending,omitempty"`
+	PendingCommit string `json:"pendingcommit,omitempty"` // commit of the pending push
+	PendingLog    string `json:"pendinglog,omitempty"`    // message telling when the pending push is built
 }
 
+// testsCommitPrefix prefixes the line in the build log, printed before the
+// script runs, that holds the commit of the tests repository used for the build.
+const testsCommitPrefix = "QuickFeed tests commit: "
+
+// testEndPattern matches the line printed by go test -v when a test ends,
+// e.g., "--- FAIL: TestA (0.12s)", capturing the test's name and duration.
+var testEndPattern = regexp.MustCompile(`^\s*--- (?:PASS|FAIL|SKIP): (\S+) \(([0-9.]+)s\)`)
+
 var globalBuildID = new(int64)
 
 // ExtractResult returns a result struct for the given log.
-func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.Duration) (*Result, error) {
+// The duration of a test that did not report its duration is
+// taken from the go test -v output, if present. Stage, tests commit,
+// analysis and coverage lines are only recorded if marked with the job's nonce.
+func ExtractResult(logger *zap.SugaredLogger, out, secret, nonce string, execTime time.Duration) (*Result, error) {
 	var filteredLog []string
+	var testsCommit string
+	var stages []*StageResult
+	seenStages := make(map[string]bool)
+	var analysis analysisResults
+	var coverage coverageResults
+	var benchmarks benchmarkResults
 	scores := make([]*score.Score, 0)
+	durations := make(map[string]int64)
 	for _, line := range strings.Split(out, "\n") {
+		if m := testEndPattern.FindStringSubmatch(line); m != nil {
+			if seconds, err := strconv.ParseFloat(m[2], 64); err == nil {
+				durations[m[1]] = int64(seconds * 1000)
+			}
+		}
+		// static analysis findings are recorded separately from the log
+		if analysis.add(line, nonce) {
+			continue
+		}
+		// the coverage of the student's own tests is recorded separately from the log
+		if coverage.add(line, nonce) {
+			continue
+		}
+		// benchmarks are recorded separately from the log
+		if score.HasBenchmarkPrefix(line) {
+			if err := benchmarks.add(line, secret); err != nil {
+				logger.Error("ci.ExtractResults", zap.Error(err))
+			}
+			continue
+		}
+		// the line for each stage is printed by the job after the stage ends
+		if payload, ok := markedLine(line, stagePrefix, nonce); ok {
+			stage, err := parseStageLine(payload)
+			if err != nil {
+				logger.Error("ci.ExtractResults", zap.Error(err))
+				continue
+			}
+			if !seenStages[stage.Name] {
+				seenStages[stage.Name] = true
+				stages = append(stages, stage)
+			}
+			continue
+		}
+		// the tests commit line is printed before the script runs
+		if payload, ok := markedLine(line, testsCommitPrefix, nonce); ok {
+			testsCommit = strings.TrimSpace(payload)
+			continue
+		}
 		// check if line has expected JSON score string
 		if score.HasPrefix(line) {
 			sc, err := score.Parse(line, secret)
@@ -50,6 +122,11 @@ func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.
 		}
 	}
 	scores = filter(scores)
+	for _, sc := range scores {
+		if sc.Duration == 0 {
+			sc.Duration = durations[sc.TestName]
+		}
+	}
 	logger.Debug("ci.ExtractResults",
 		zap.Any("scores", log.IndentJson(scores)),
 		zap.Any("filteredLog", log.IndentJson(filteredLog)),
@@ -57,14 +134,36 @@ func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.
 	return &Result{
 		Scores: scores,
 		BuildInfo: &BuildInfo{
-			BuildID:   atomic.AddInt64(globalBuildID, 1),
-			BuildDate: time.Now().Format("2006-01-02T15:04:05"),
-			BuildLog:  strings.Join(filteredLog, "\n"),
-			ExecTime:  execTime.Milliseconds(),
+			BuildID:     atomic.AddInt64(globalBuildID, 1),
+			BuildDate:   time.Now().Format("2006-01-02T15:04:05"),
+			BuildLog:    strings.Join(filteredLog, "\n"),
+			ExecTime:    execTime.Milliseconds(),
+			TestsCommit: testsCommit,
+			Stages:      stages,
+			Analysis:    analysis.results,
+			Coverage:    coverage.result,
+			Benchmarks:  benchmarks.results,
 		},
 	}, nil
 }
 
+// markedLine returns the rest of the given build log line, if the line starts with
+// the given prefix and the job's nonce. Only the job's own commands know the nonce,
+// so that the student's code cannot print lines that are mistaken for these.
+func markedLine(line, prefix, nonce string) (string, bool) {
+	prefix = marker(prefix, nonce)
+	if nonce == "" || !strings.HasPrefix(line, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(line, prefix), true
+}
+
+// marker returns the prefix of build log lines printed by the job's own commands,
+// to be recognized by markedLine.
+func marker(prefix, nonce string) string {
+	return prefix + nonce + " "
+}
+
 // filter returns a slice of scores, exactly one per TestName.
 // The input score slice may contain one or two entries per TestName.
 // If more than two entries are found for a given TestName, we return a 0 score for that test.
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha1"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -25,6 +27,17 @@ type RunData struct {
 	Repo       *pb.Repository
 	CommitID   string
 	JobOwner   string
+	// NoCache forces the tests to run, even if a cached result is available.
+	NoCache bool
+	// Artifacts, if set, stores the build artifacts collected from the test run.
+	Artifacts *ArtifactStore
+	// PushTime is the time at which the server received the push; it is zero for rebuilds.
+	// Builds are ordered by their push time, rather than by the commit's timestamp, which
+	// is set by the student.
+	PushTime time.Time
+	// Builds, if set, tracks the running builds, so that builds superseded
+	// by a newer push can be canceled, and queues the builds by priority.
+	Builds *Builds
 }
 
 // String returns a string representation of the run data structure
@@ -32,41 +45,152 @@ func (r RunData) String(secret string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", r.Course.GetCode(), r.Assignment.GetName(), r.JobOwner, secret)
 }
 
-// RunTests runs the assignment specified in the provided RunData structure.
+// RunTests runs the tests of the assignment in the provided RunData structure
+// and records the results as a new submission.
+//
+// A build waits for a worker, in order of priority, and is skipped or canceled
+// when a newer push of the same repository supersedes it. A push over its
+// user's or group's build quota is recorded as pending, and built when the
+// quota allows. Tests are not run until the assignment's prerequisites are
+// approved, unless the assignment's prerequisite policy only blocks approval.
+//
+// If the latest submission was built from the same assignment folder, tests and
+// script, its result is reused, unless the run data asks for a fresh run.
+// Otherwise, failing tests are rerun up to the assignment's number of reruns,
+// keeping the best score of each test, and the outcome of each run is recorded.
+// The submission keeps the complete build log and artifacts of the run that
+// produced its result.
 func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) {
-	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+	if rData.Assignment.GetPrerequisitePolicy() == pb.Assignment_BLOCK_TESTS {
+		missing, err := MissingPrerequisites(db, rData.Assignment, rData.Repo.GetUserID(), rData.Repo.GetGroupID())
+		if err != nil {
+			logger.Errorf("Failed to check prerequisites: %w", err)
+			return
+		}
+		if len(missing) > 0 {
+			logger.Debugf("Not running tests for %s: prerequisites %v not approved", rData.JobOwner, missing)
+			return
+		}
+	}
+	if rData.Builds != nil {
+		if at, ok := rData.Builds.admit(rData); !ok {
+			if rData.Builds.postpone(rData, at, func() { RunTests(logger, db, runner, rData) }) {
+				logger.Debugf("Build for %s over quota; pending until %v", rData.JobOwner, at)
+				recordPending(logger, db, rData, rData.Builds.pendingLog(rData, at))
+			}
+			return
+		}
+	}
+	ctx := context.Background()
+	if rData.Builds != nil {
+		buildCtx, done, ok := rData.Builds.start(rData)
+		if !ok {
+			logger.Debugf("Not running tests for %s: superseded by a newer push", rData.JobOwner)
+			return
+		}
+		defer done()
+		ctx = buildCtx
+	}
+	info, err := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+	if err != nil {
+		logger.Errorf("Failed to run tests for %s: %v", rData.JobOwner, err)
+		return
+	}
+	key, err := resultCacheKey(scriptPath, info, rData)
+	if err != nil {
+		logger.Debugf("Not caching results for %s: %v", rData.JobOwner, err)
+	}
+	if key != "" && !rData.NoCache {
+		result, err := cachedResult(db, rData, key)
+		if err != nil {
+			logger.Errorf("Failed to get cached results: %w", err)
+		}
+		if result != nil {
+			logger.Debugf("Reusing cached results for %s", rData.JobOwner)
+			recordResults(logger, db, rData, result)
+			return
+		}
+	}
+
+	if rData.Builds != nil {
+		release, err := rData.Builds.acquire(ctx, rData)
+		if err != nil {
+			logger.Debugf("Build for %s canceled while queued", rData.JobOwner)
+			return
+		}
+		defer release()
+	}
+
 	logger.Debugf("Running tests for %s", rData.JobOwner)
-	ed, err := runTests(scriptPath, runner, info, rData)
+	ed, err := runTests(ctx, scriptPath, runner, info, rData)
+	if errors.Is(err, context.Canceled) {
+		logger.Debugf("Build for %s canceled", rData.JobOwner)
+		if ed != nil && ed.artifacts != "" {
+			rData.Artifacts.discard(ed.artifacts)
+		}
+		return
+	}
 	if err != nil {
 		logger.Errorf("Failed to run tests: %w", err)
 		if ed == nil {
 			return
 		}
-		// we only get here if err was a timeout, so that we can log 'out' to the user
+		// the runners do not report failing commands as errors, so we only get here
+		// if err was a timeout, so that we can log 'out' to the user;
+		// the result of a timed out run is not cached
+		key = ""
 	}
-	result, err := ExtractResult(logger, ed.out, info.RandomSecret, ed.execTime)
+	if ed.artifacts != "" {
+		// the artifacts are moved out of the staging folder when saved
+		defer rData.Artifacts.discard(ed.artifacts)
+	}
+	if ed.artifactsErr != nil {
+		logger.Errorf("Failed to collect artifacts for %s: %w", rData.JobOwner, ed.artifactsErr)
+	}
+	result, err := extractResult(logger, ed, info, rData.Assignment)
 	if err != nil {
 		logger.Errorf("Failed to extract results from log: %w", err)
 		return
 	}
-	recordResults(logger, db, rData, result)
+	runs := rerunTests(ctx, logger, runner, info, rData, result)
+	if ctx.Err() != nil {
+		logger.Debugf("Build for %s canceled", rData.JobOwner)
+		return
+	}
+	recordTestOutcomes(logger, db, rData, runs)
+	result.BuildInfo.CacheKey = key
+	submission := recordResults(logger, db, rData, result)
+	if submission != nil {
+		recordBuildLog(logger, db, submission.GetID(), ed.log, info.RandomSecret)
+	}
+	if submission != nil && ed.artifacts != "" {
+		if err := rData.Artifacts.save(ed.artifacts, submission.GetID()); err != nil {
+			logger.Errorf("Failed to save artifacts for submission %d: %w", submission.GetID(), err)
+		}
+	}
 }
 
 type execData struct {
 	out      string
 	execTime time.Duration
+	// log is the complete output of the test execution, before truncation.
+	log string
+	// artifacts is the staging folder holding the collected build artifacts, if any.
+	artifacts    string
+	artifactsErr error
 }
 
 // runTests returns execData struct.
 // An error is returned if the execution fails, or times out.
 // If a timeout is the cause of the error, we also return an output string to the user.
-func runTests(path string, runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
+func runTests(ctx context.Context, path string, runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
 	job, err := parseScriptTemplate(path, info)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse script template: %w", err)
 	}
 
 	job.Name = rData.String(info.RandomSecret[:6])
+	job.Labels = rData.Assignment.RunnerLabelNames()
 	start := time.Now()
 
 	timeout := containerTimeout
@@ -74,23 +198,76 @@ func runTests(path string, runner Runner, info *AssignmentInfo, rData *RunData)
 	if t > 0 {
 		timeout = time.Duration(t) * time.Minute
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	var artifacts string
+	var artifactsErr error
+	if rData.Artifacts != nil {
+		job.ArtifactsDir = info.ArtifactsDir
+		job.CollectArtifacts = func(archive io.Reader) {
+			artifacts, artifactsErr = rData.Artifacts.collect(archive)
+		}
+	}
+
+	var log string
+	job.CollectLog = func(all string) {
+		log = all
+	}
+
 	out, err := runner.Run(ctx, job)
 	if err != nil && out == "" {
+		if artifacts != "" {
+			rData.Artifacts.discard(artifacts)
+		}
 		return nil, fmt.Errorf("test execution failed: %w", err)
 	}
+	if log == "" {
+		// the runner does not collect the complete log
+		log = out
+	}
 	// this may return a timeout error as well
-	return &execData{out: out, execTime: time.Since(start)}, err
+	return &execData{out: out, log: log, execTime: time.Since(start), artifacts: artifacts, artifactsErr: artifactsErr}, err
+}
+
+// extractResult returns the result of the given test execution,
+// including the scores of the assignment's static analyzers,
+// of the coverage of the student's own tests, and of its benchmarks.
+func extractResult(logger *zap.SugaredLogger, ed *execData, info *AssignmentInfo, assignment *pb.Assignment) (*Result, error) {
+	result, err := ExtractResult(logger, ed.out, info.RandomSecret, info.nonce, ed.execTime)
+	if err != nil {
+		return nil, err
+	}
+	analyzers, err := Analyzers(assignment)
+	if err != nil {
+		return nil, err
+	}
+	result.AddAnalysisScores(analyzers)
+	coverage, err := AssignmentCoverage(assignment)
+	if err != nil {
+		return nil, err
+	}
+	result.AddCoverageScores(coverage)
+	benchmarks, err := Benchmarks(assignment)
+	if err != nil {
+		return nil, err
+	}
+	result.AddBenchmarkScores(benchmarks)
+	return result, nil
 }
 
-// recordResults for the assignment given by the run data structure.
-func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *Result) {
+// recordResults for the assignment given by the run data structure,
+// and returns the recorded submission, or nil if it could not be recorded.
+// The results of a pushed commit are not recorded if the most recent
+// submission is of a newer push.
+func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *Result) *pb.Submission {
+	if !rData.PushTime.IsZero() {
+		result.BuildInfo.PushDate = rData.PushTime.Format(time.RFC3339Nano)
+	}
 	buildInfo, scores, err := result.Marshal()
 	if err != nil {
 		logger.Errorf("Failed to marshal build info and scores: %w", err)
-		return
+		return nil
 	}
 
 	logger.Debugf("Fetching most recent submission for assignment %d", rData.Assignment.GetID())
@@ -102,12 +279,24 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 	newest, err := db.GetSubmission(submissionQuery)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		logger.Errorf("Failed to get submission data from database: %w", err)
-		return
+		return nil
+	}
+	if newerPush(newest, rData.PushTime) {
+		logger.Debugf("Not recording results for %s: a newer push has been recorded", rData.JobOwner)
+		return nil
 	}
 	// keep approved status if already approved
 	approvedStatus := newest.GetStatus()
 	if rData.Assignment.AutoApprove && result.TotalScore() >= rData.Assignment.GetScoreLimit() {
-		approvedStatus = pb.Submission_APPROVED
+		missing, err := MissingPrerequisites(db, rData.Assignment, rData.Repo.GetUserID(), rData.Repo.GetGroupID())
+		switch {
+		case err != nil:
+			logger.Errorf("Failed to check prerequisites: %w", err)
+		case len(missing) > 0:
+			logger.Debugf("Not approving submission for %s: prerequisites %v not approved", rData.JobOwner, missing)
+		default:
+			approvedStatus = pb.Submission_APPROVED
+		}
 	}
 
 	score := result.TotalScore()
@@ -124,10 +313,11 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 	err = db.CreateSubmission(newSubmission)
 	if err != nil {
 		logger.Errorf("Failed to add submission to database: %w", err)
-		return
+		return nil
 	}
 	logger.Debugf("Created submission for assignment '%s' with status %s", rData.Assignment.GetName(), approvedStatus)
 	updateSlipDays(logger, db, rData.Assignment, newSubmission, result.BuildInfo.BuildDate)
+	return newSubmission
 }
 
 func randomSecret() string {
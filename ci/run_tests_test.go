@@ -1,6 +1,7 @@
 package ci
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha1"
 	"fmt"
@@ -53,7 +54,7 @@ func TestRunTests(t *testing.T) {
 		t.Fatal(err)
 	}
 	defer runner.Close()
-	ed, err := runTests("scripts", runner, info, runData)
+	ed, err := runTests(context.Background(), "scripts", runner, info, runData)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,213 @@
+package ci
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// highPriorityWindow is the time before an assignment's deadline
+	// within which its builds have high priority.
+	highPriorityWindow = 24 * time.Hour
+	// normalPriorityWindow is the time before an assignment's deadline
+	// within which its builds have at least normal priority.
+	normalPriorityWindow = 7 * 24 * time.Hour
+	// repeatPushWindow is the time within which a repeated push of the same
+	// assignment and repository lowers the build's priority, and is started
+	// after the other builds of the same priority.
+	repeatPushWindow = 5 * time.Minute
+)
+
+// Lane is a queue of builds with its own workers, so that
+// builds in one lane do not wait for builds in another.
+type Lane int
+
+const (
+	// PushLane holds the builds of pushed commits.
+	PushLane Lane = iota
+	// RebuildLane holds the builds started by teachers, such as rebuilds.
+	RebuildLane
+	numLanes
+)
+
+func (l Lane) String() string {
+	if l == RebuildLane {
+		return "rebuild"
+	}
+	return "push"
+}
+
+// Priority is the priority of a queued build; builds of higher
+// priority are started before builds of lower priority.
+type Priority int
+
+const (
+	// LowPriority is the priority of builds of assignments whose deadline
+	// has passed or is more than a week away.
+	LowPriority Priority = iota
+	// NormalPriority is the priority of builds of assignments
+	// whose deadline is within a week.
+	NormalPriority
+	// HighPriority is the priority of builds of assignments
+	// whose deadline is within a day.
+	HighPriority
+)
+
+func (p Priority) String() string {
+	switch p {
+	case HighPriority:
+		return "high"
+	case NormalPriority:
+		return "normal"
+	}
+	return "low"
+}
+
+// lane holds the queued builds of a lane.
+type lane struct {
+	// workers is the number of builds that can run concurrently; zero means no limit.
+	workers int
+	running int
+	queue   []*queuedBuild
+}
+
+// queuedBuild is a build waiting for a worker.
+type queuedBuild struct {
+	lane     Lane
+	priority Priority
+	// repeated is true for a repeated push, which is started after the other builds of the same priority.
+	repeated bool
+	deadline time.Time
+	seq      uint64
+	queued   time.Time
+	ready    chan struct{} // closed when the build has been given a worker
+}
+
+// laneOf returns the lane of the build of the run data; builds
+// without a push time are started by teachers.
+func laneOf(rData *RunData) Lane {
+	if rData.PushTime.IsZero() {
+		return RebuildLane
+	}
+	return PushLane
+}
+
+// acquire waits for a worker in the lane of the run data's build and returns a
+// function to call when the build is done. Waiting builds are started in order
+// of priority, then of repeated pushes last, then of deadline, then of arrival.
+// An error is returned if the
+// context is done before the build has been given a worker.
+func (b *Builds) acquire(ctx context.Context, rData *RunData) (func(), error) {
+	b.mu.Lock()
+	now := b.now()
+	q := &queuedBuild{lane: laneOf(rData), queued: now, ready: make(chan struct{})}
+	q.priority, q.deadline, q.repeated = b.priority(rData, now)
+	b.seq++
+	q.seq = b.seq
+	l := b.lanes[q.lane]
+	l.queue = append(l.queue, q)
+	BuildQueueLengthMetric.WithLabelValues(q.lane.String(), q.priority.String()).Inc()
+	b.dispatch(l)
+	b.mu.Unlock()
+
+	release := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		l.running--
+		b.dispatch(l)
+	}
+	select {
+	case <-q.ready:
+		return release, nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		select {
+		case <-q.ready:
+			// the build was given a worker while the lock was not held
+			l.running--
+			b.dispatch(l)
+		default:
+			l.remove(q)
+			BuildQueueLengthMetric.WithLabelValues(q.lane.String(), q.priority.String()).Dec()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// dispatch starts the lane's waiting builds while it has free workers. The lock must be held.
+func (b *Builds) dispatch(l *lane) {
+	for len(l.queue) > 0 && (l.workers == 0 || l.running < l.workers) {
+		next := l.queue[0]
+		for _, q := range l.queue[1:] {
+			if q.before(next) {
+				next = q
+			}
+		}
+		l.remove(next)
+		l.running++
+		BuildQueueLengthMetric.WithLabelValues(next.lane.String(), next.priority.String()).Dec()
+		BuildQueueWaitMetric.WithLabelValues(next.lane.String(), next.priority.String()).Observe(b.now().Sub(next.queued).Seconds())
+		close(next.ready)
+	}
+}
+
+// priority returns the priority and deadline of the run data's build, and whether it
+// is a repeated push. The time of a push is recorded so that repeated pushes within
+// minutes are lowered one priority level, and started after the other builds of that
+// level, which also deprioritizes repeated pushes of low priority. The lock must be held.
+func (b *Builds) priority(rData *RunData, now time.Time) (Priority, time.Time, bool) {
+	priority := LowPriority
+	var deadline time.Time
+	if sinceDeadline, err := rData.Assignment.SinceDeadline(now); err == nil {
+		deadline = now.Add(-sinceDeadline)
+		switch untilDeadline := -sinceDeadline; {
+		case untilDeadline < 0:
+		case untilDeadline <= highPriorityWindow:
+			priority = HighPriority
+		case untilDeadline <= normalPriorityWindow:
+			priority = NormalPriority
+		}
+	}
+	if laneOf(rData) == RebuildLane {
+		return priority, deadline, false
+	}
+
+	key := newBuildKey(rData)
+	last, repeated := b.pushes[key]
+	repeated = repeated && now.Sub(last) < repeatPushWindow
+	if repeated && priority > LowPriority {
+		priority--
+	}
+	b.pushes[key] = now
+	for k, last := range b.pushes {
+		if now.Sub(last) >= repeatPushWindow {
+			delete(b.pushes, k)
+		}
+	}
+	return priority, deadline, repeated
+}
+
+// before returns true if q should be started before other.
+func (q *queuedBuild) before(other *queuedBuild) bool {
+	if q.priority != other.priority {
+		return q.priority > other.priority
+	}
+	if q.repeated != other.repeated {
+		return other.repeated
+	}
+	if !q.deadline.Equal(other.deadline) && !q.deadline.IsZero() && !other.deadline.IsZero() {
+		return q.deadline.Before(other.deadline)
+	}
+	return q.seq < other.seq
+}
+
+// remove removes the build from the lane's queue, if queued.
+func (l *lane) remove(q *queuedBuild) {
+	for i, other := range l.queue {
+		if other == q {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package ci
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestBuildsPriority(t *testing.T) {
+	now := time.Date(2020, 11, 20, 12, 0, 0, 0, time.Local)
+	run := func(userID uint64, deadline time.Time, pushTime time.Time) *RunData {
+		return &RunData{
+			Assignment: &pb.Assignment{ID: 1, Deadline: deadline.Format(layout)},
+			Repo:       &pb.Repository{UserID: userID},
+			PushTime:   pushTime,
+		}
+	}
+	tests := []struct {
+		name     string
+		rData    *RunData
+		elapsed  time.Duration
+		priority Priority
+		repeated bool
+	}{
+		{"deadline within a day", run(1, now.Add(2*time.Hour), now), 0, HighPriority, false},
+		{"deadline within a week", run(2, now.Add(72*time.Hour), now), 0, NormalPriority, false},
+		{"deadline in a month", run(3, now.Add(30*24*time.Hour), now), 0, LowPriority, false},
+		{"deadline passed", run(4, now.Add(-time.Hour), now), 0, LowPriority, false},
+		{"repeated push", run(1, now.Add(2*time.Hour), now), time.Minute, NormalPriority, true},
+		{"repeated push of low priority", run(3, now.Add(30*24*time.Hour), now), 0, LowPriority, true},
+		{"repeated push after a while", run(1, now.Add(2*time.Hour), now), 10 * time.Minute, HighPriority, false},
+		{"rebuild after push", run(1, now.Add(2*time.Hour), time.Time{}), 10 * time.Minute, HighPriority, false},
+	}
+	builds := NewBuilds(0, 0)
+	for _, test := range tests {
+		now = now.Add(test.elapsed)
+		if priority, _, repeated := builds.priority(test.rData, now); priority != test.priority || repeated != test.repeated {
+			t.Errorf("%s: priority() = %v, %t, want %v, %t", test.name, priority, repeated, test.priority, test.repeated)
+		}
+	}
+}
+
+func TestBuildsQueueOrder(t *testing.T) {
+	now := time.Now()
+	builds := NewBuilds(1, 1)
+	run := func(userID uint64, deadline time.Duration) *RunData {
+		return &RunData{
+			Assignment: &pb.Assignment{ID: userID, Deadline: now.Add(deadline).Format(layout)},
+			Repo:       &pb.Repository{UserID: userID},
+			PushTime:   now,
+		}
+	}
+	release, err := builds.acquire(context.Background(), run(1, time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a rebuild has its own lane and does not wait for pushes
+	rebuild := run(1, time.Hour)
+	rebuild.PushTime = time.Time{}
+	releaseRebuild, err := builds.acquire(context.Background(), rebuild)
+	if err != nil {
+		t.Fatal(err)
+	}
+	releaseRebuild()
+
+	// queue builds one at a time, so that their arrival order is known
+	order := make(chan uint64, 5)
+	queue := func(rData *RunData) {
+		go func() {
+			release, err := builds.acquire(context.Background(), rData)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			order <- rData.Repo.GetUserID()
+			release()
+		}()
+		waitQueued(t, builds, PushLane)
+	}
+	queue(run(2, 30*24*time.Hour))
+	// a repeated push of low priority is started after the other low priority builds
+	queue(run(2, 30*24*time.Hour))
+	queue(run(6, 40*24*time.Hour))
+	queue(run(3, 72*time.Hour))
+	queue(run(4, 2*time.Hour))
+
+	// a canceled build leaves the queue
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := builds.acquire(ctx, run(5, time.Hour)); err == nil {
+		t.Error("acquire() with canceled context succeeded, want error")
+	}
+
+	release()
+	for _, want := range []uint64{4, 3, 2, 6, 2} {
+		if got := <-order; got != want {
+			t.Errorf("started build of user %d, want user %d", got, want)
+		}
+	}
+}
+
+// waitQueued waits until a build is queued in the given lane.
+func waitQueued(t *testing.T, builds *Builds, lane Lane) {
+	t.Helper()
+	builds.mu.Lock()
+	n := len(builds.lanes[lane].queue)
+	builds.mu.Unlock()
+	for i := 0; i < 100; i++ {
+		time.Sleep(10 * time.Millisecond)
+		builds.mu.Lock()
+		queued := len(builds.lanes[lane].queue)
+		builds.mu.Unlock()
+		if queued > n {
+			return
+		}
+	}
+	t.Fatalf("no build queued in %v lane", lane)
+}
@@ -8,6 +8,8 @@ ls
 
 git clone  {{ .GetURL }} /home/gradle/user  
 git clone  {{ .TestURL }} /home/gradle/test
+{{ if .TestsCommit }}git -C /home/gradle/test checkout --quiet {{ .TestsCommit }}
+{{ end }}printf "QuickFeed tests commit: %s\n" "$(git -C /home/gradle/test rev-parse HEAD)"
 
 cat <<EOF> /home/gradle/.gradle/gradle.properties
 org.gradle.parallel=true
@@ -7,6 +7,8 @@ export PYTHONPATH="/root"
 
 git clone {{ .GetURL }} user
 git clone {{ .TestURL }} test
+{{ if .TestsCommit }}git -C test checkout --quiet {{ .TestsCommit }}
+{{ end }}printf "QuickFeed tests commit: %s\n" "$(git -C test rev-parse HEAD)"
 
 history -c
 
@@ -0,0 +1,198 @@
+package ci
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// stageMarker starts a named stage in a script template, followed by the
+	// stage's name and options, e.g., '#stage/build timeout=2m onfail=stop'.
+	stageMarker = "#stage/"
+	// stagePrefix prefixes the line in the build log, printed after each stage,
+	// that holds the job's nonce and the stage's name, exit status, execution time and timeout.
+	stagePrefix = "QuickFeed stage: "
+	// stageScript holds the commands of the running stage inside the container.
+	stageScript = "/tmp/quickfeed-stage.sh"
+	// stageDir holds the working directory at the end of the last stage,
+	// so that the next stage continues in the same directory.
+	stageDir = "/tmp/quickfeed-stage-dir"
+)
+
+// Stage status values recorded in a stage's result.
+const (
+	StagePassed  = "passed"
+	StageFailed  = "failed"
+	StageTimeout = "timeout"
+	StageSkipped = "skipped"
+)
+
+var stageNamePattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// Stage describes a named stage of a job, such as build, lint or test.
+type Stage struct {
+	Name string
+	// Timeout limits the execution time of the stage; if zero,
+	// only the timeout of the job applies.
+	Timeout time.Duration
+	// ContinueOnFailure runs the remaining stages even if this stage fails.
+	// By default, the remaining stages are skipped.
+	ContinueOnFailure bool
+	Commands          []string
+}
+
+// StageResult holds the outcome of one stage of a test execution.
+type StageResult struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	ExitCode int    `json:"exitcode"`
+	ExecTime int64  `json:"exectime"` // milliseconds
+}
+
+// parseStages splits the given script lines into the commands before the first
+// stage marker and the declared stages. If there are no stage markers, all lines
+// are returned as commands.
+func parseStages(lines []string) ([]string, []*Stage, error) {
+	var commands []string
+	var stages []*Stage
+	names := make(map[string]bool)
+	for _, line := range lines {
+		if !strings.HasPrefix(line, stageMarker) {
+			if len(stages) == 0 {
+				commands = append(commands, line)
+			} else {
+				stage := stages[len(stages)-1]
+				stage.Commands = append(stage.Commands, line)
+			}
+			continue
+		}
+		stage, err := parseStage(strings.TrimPrefix(line, stageMarker))
+		if err != nil {
+			return nil, nil, err
+		}
+		if names[stage.Name] {
+			return nil, nil, fmt.Errorf("stage %s declared more than once", stage.Name)
+		}
+		names[stage.Name] = true
+		stages = append(stages, stage)
+	}
+	return commands, stages, nil
+}
+
+// parseStage returns the stage declared by the given stage marker fields.
+func parseStage(declaration string) (*Stage, error) {
+	fields := strings.Fields(declaration)
+	if len(fields) == 0 || !stageNamePattern.MatchString(fields[0]) {
+		return nil, fmt.Errorf("invalid stage name in '%s%s'", stageMarker, declaration)
+	}
+	stage := &Stage{Name: fields[0]}
+	for _, option := range fields[1:] {
+		parts := strings.SplitN(option, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("stage %s: invalid option %q", stage.Name, option)
+		}
+		switch key, value := parts[0], parts[1]; key {
+		case "timeout":
+			timeout, err := time.ParseDuration(value)
+			if err != nil || timeout < time.Second {
+				return nil, fmt.Errorf("stage %s: invalid timeout %q", stage.Name, value)
+			}
+			stage.Timeout = timeout
+		case "onfail":
+			switch value {
+			case "stop":
+				stage.ContinueOnFailure = false
+			case "continue":
+				stage.ContinueOnFailure = true
+			default:
+				return nil, fmt.Errorf("stage %s: invalid onfail %q; must be stop or continue", stage.Name, value)
+			}
+		default:
+			return nil, fmt.Errorf("stage %s: unknown option %q", stage.Name, key)
+		}
+	}
+	return stage, nil
+}
+
+// stageCommands returns the shell commands that run the given stages in order.
+// Each stage runs as a separate script, so that it can be stopped on timeout,
+// in the working directory where the previous stage ended. Variables set before
+// the first stage are exported to all stages. After each stage, a line with the
+// stage's outcome, marked with the given nonce, is printed for ExtractResult.
+// Once a stage fails, the remaining stages are skipped, unless the failed stage
+// allows continuing.
+func stageCommands(stages []*Stage, nonce string) []string {
+	var cmds []string
+	for _, stage := range stages {
+		timeout := int(stage.Timeout.Seconds())
+		run := "bash " + stageScript
+		if timeout > 0 {
+			run = fmt.Sprintf("timeout %d %s", timeout, run)
+		}
+		cmds = append(cmds, `if [ -z "$QUICKFEED_STOPPED" ]; then`,
+			"cat > "+stageScript+" <<'QUICKFEED_STAGE'",
+			"trap 'pwd > "+stageDir+"' EXIT",
+			// the script may hold secrets; bash keeps reading the removed file
+			"rm -f "+stageScript,
+		)
+		cmds = append(cmds, stage.Commands...)
+		cmds = append(cmds, "QUICKFEED_STAGE",
+			fmt.Sprintf(`printf "\n*** Stage %s ***\n"`, stage.Name),
+			"QUICKFEED_START=$SECONDS",
+			run,
+			"QUICKFEED_STATUS=$?",
+			"QUICKFEED_TIME=$(( SECONDS - QUICKFEED_START ))",
+			`[ -f `+stageDir+` ] && cd "$(cat `+stageDir+`)"`,
+			fmt.Sprintf(`printf "\n*** Stage %s finished with exit status %%d in %%d seconds ***\n" $QUICKFEED_STATUS $QUICKFEED_TIME`, stage.Name),
+			fmt.Sprintf(`printf "%s%s %%d %%d %d\n" $QUICKFEED_STATUS $QUICKFEED_TIME`, marker(stagePrefix, nonce), stage.Name, timeout),
+		)
+		if !stage.ContinueOnFailure {
+			cmds = append(cmds, fmt.Sprintf(`[ $QUICKFEED_STATUS -ne 0 ] && QUICKFEED_STOPPED=%s`, stage.Name))
+		}
+		cmds = append(cmds, "else",
+			fmt.Sprintf(`printf "\n*** Stage %s skipped after failed stage $QUICKFEED_STOPPED ***\n"`, stage.Name),
+			fmt.Sprintf(`printf "%s%s -1 0 0\n"`, marker(stagePrefix, nonce), stage.Name),
+			"fi",
+		)
+	}
+	return cmds
+}
+
+// parseStageLine returns the stage result recorded in the given stage line,
+// following the stage prefix and the job's nonce.
+func parseStageLine(line string) (*StageResult, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("malformed stage line: %q", line)
+	}
+	var values [3]int
+	for i, field := range fields[1:] {
+		v, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("malformed stage line: %q", line)
+		}
+		values[i] = v
+	}
+	exitCode, seconds, timeout := values[0], values[1], values[2]
+	result := &StageResult{
+		Name:     fields[0],
+		ExitCode: exitCode,
+		ExecTime: (time.Duration(seconds) * time.Second).Milliseconds(),
+	}
+	switch {
+	case exitCode < 0:
+		result.Status = StageSkipped
+		result.ExitCode = 0
+	case exitCode == 0:
+		result.Status = StagePassed
+	case timeout > 0 && (exitCode == 124 || exitCode == 143 && seconds >= timeout-1):
+		// timeout exits with 124 (coreutils) or 143 (busybox) when the stage is stopped
+		result.Status = StageTimeout
+	default:
+		result.Status = StageFailed
+	}
+	return result, nil
+}
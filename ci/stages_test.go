@@ -0,0 +1,110 @@
+package ci
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+func TestParseStages(t *testing.T) {
+	script := `#image/quickfeed:go
+cd /tmp
+#stage/build timeout=2m
+go build ./...
+#stage/lint onfail=continue
+go vet ./...
+#stage/test
+go test ./...`
+	job, err := newJob(script, "go.sh", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, stage := range job.Stages {
+		names = append(names, stage.Name)
+	}
+	if diff := cmp.Diff([]string{"build", "lint", "test"}, names); diff != "" {
+		t.Errorf("stages mismatch (-want +got):\n%s", diff)
+	}
+	build, lint := job.Stages[0], job.Stages[1]
+	if build.Timeout.Minutes() != 2 || build.ContinueOnFailure {
+		t.Errorf("build stage = %+v, want 2m timeout and stop on failure", build)
+	}
+	if !lint.ContinueOnFailure {
+		t.Errorf("lint stage = %+v, want continue on failure", lint)
+	}
+	if diff := cmp.Diff([]string{"go build ./..."}, build.Commands); diff != "" {
+		t.Errorf("build commands mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, invalid := range []string{
+		"#stage/",
+		"#stage/Build",
+		"#stage/build timeout=1ms",
+		"#stage/build onfail=ignore",
+		"#stage/build retries=2",
+		"#stage/build\n#stage/build",
+	} {
+		if _, err := newJob("#image/quickfeed:go\n"+invalid, "go.sh", ""); err == nil {
+			t.Errorf("newJob(%q): expected error", invalid)
+		}
+	}
+}
+
+func TestRunStages(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("This test requires bash")
+	}
+	script := `#image/quickfeed:go
+GREETING=hello
+cd /
+#stage/setup
+cd /tmp
+#stage/lint onfail=continue
+echo "$GREETING from $(pwd)"
+echo "QuickFeed stage: build 0 0 0"
+exit 3
+#stage/build
+true
+#stage/test timeout=1s
+sleep 5
+#stage/report
+echo "not run"`
+	job, err := newJob(script, "stages.sh", "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the local runner uses sh; the docker runner uses bash, as is needed by the stages
+	runner := Local{}
+	out, err := runner.Run(context.Background(), &Job{Commands: []string{"/bin/bash -s <<'EOF'\n" + strings.Join(job.Commands, "\n") + "\nEOF"}})
+	if err != nil && out == "" {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "hello from /tmp") {
+		t.Errorf("expected lint stage to see variable and directory from earlier stages:\n%s", out)
+	}
+
+	result, err := ExtractResult(zap.NewNop().Sugar(), out, "secret", "nonce", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stage lines printed by the student's code are not recorded
+	var got []string
+	for _, stage := range result.BuildInfo.Stages {
+		got = append(got, stage.Name+":"+stage.Status)
+	}
+	want := []string{"setup:passed", "lint:failed", "build:passed", "test:timeout", "report:skipped"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("stage results mismatch (-want +got):\n%s", diff)
+	}
+	if lint := result.BuildInfo.Stages[1]; lint.ExitCode != 3 {
+		t.Errorf("lint stage exit code = %d, want 3", lint.ExitCode)
+	}
+	if strings.Contains(result.BuildInfo.BuildLog, stagePrefix+"nonce") {
+		t.Errorf("build log contains stage lines:\n%s", result.BuildInfo.BuildLog)
+	}
+}
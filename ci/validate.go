@@ -0,0 +1,52 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"go.uber.org/zap"
+)
+
+// ValidateScript returns an error if the script template for the given assignment
+// cannot be parsed, or does not run the assignment's analyzers or coverage tests.
+func ValidateScript(course *pb.Course, assignment *pb.Assignment) error {
+	info, err := newAssignmentInfo(course, assignment, "", "")
+	if err != nil {
+		return err
+	}
+	job, err := parseScriptTemplate(scriptPath, info)
+	if err != nil {
+		return err
+	}
+	commands := strings.Join(job.Commands, "\n")
+	if info.Analysis != "" && !strings.Contains(commands, analysisPrefix) {
+		return fmt.Errorf("script template %s does not run the analyzers; add {{ .Analysis }} to the template", info.Script)
+	}
+	if info.Coverage != "" && !strings.Contains(commands, coveragePrefix) {
+		return fmt.Errorf("script template %s does not measure coverage; add {{ .Coverage }} to the template", info.Script)
+	}
+	return nil
+}
+
+// DryRun runs the tests for the given assignment against the given repository
+// and returns the result without recording it in the database. This is used to
+// check the tests against a solutions repository before students push their code.
+func DryRun(logger *zap.SugaredLogger, runner Runner, course *pb.Course, assignment *pb.Assignment, repo *pb.Repository) (*Result, error) {
+	info, err := newAssignmentInfo(course, assignment, repo.GetHTMLURL(), repo.GetTestURL())
+	if err != nil {
+		return nil, err
+	}
+	rData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       repo,
+		JobOwner:   "dryrun",
+	}
+	ed, err := runTests(context.Background(), scriptPath, runner, info, rData)
+	if err != nil {
+		return nil, err
+	}
+	return extractResult(logger, ed, info, assignment)
+}
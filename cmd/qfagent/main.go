@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/ci/agent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// qfagent runs the CI jobs dispatched by a QuickFeed server on the local container engine.
+// The agent authenticates with the token in the QUICKFEED_AGENT_TOKEN environment variable,
+// and always connects over TLS, since the jobs' commands carry the courses' access tokens.
+// Example usage:
+// QUICKFEED_AGENT_TOKEN=secret qfagent -server quickfeed.example.com:9091 -ca ca.pem -labels java,large-mem
+
+// retryDelay is the time to wait before reconnecting to the server.
+const retryDelay = 5 * time.Second
+
+func main() {
+	hostname, _ := os.Hostname()
+	var (
+		server   = flag.String("server", "localhost:9091", "address of the QuickFeed server's runner service")
+		name     = flag.String("name", hostname, "name of the agent")
+		labels   = flag.String("labels", "", "comma separated list of the agent's labels")
+		capacity = flag.Int("capacity", runtime.NumCPU(), "number of jobs to run concurrently")
+		caFile   = flag.String("ca", "", "certificate of the server's CA; the system's CAs are used if empty")
+		engine   = flag.String("engine", "docker", "container engine to run jobs: docker or podman")
+	)
+	flag.Parse()
+
+	token := os.Getenv("QUICKFEED_AGENT_TOKEN")
+	if token == "" {
+		log.Fatalln("QUICKFEED_AGENT_TOKEN must be set")
+	}
+
+	creds := credentials.NewTLS(&tls.Config{})
+	if *caFile != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(*caFile, "")
+		if err != nil {
+			log.Fatalf("failed to load CA certificate: %v\n", err)
+		}
+	}
+	conn, err := grpc.Dial(*server, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		log.Fatalf("failed to connect to %s: %v\n", *server, err)
+	}
+	defer conn.Close()
+
+	runner, err := ci.NewContainerCI(*engine)
+	if err != nil {
+		log.Fatalf("failed to set up %s runner: %v\n", *engine, err)
+	}
+	defer runner.Close()
+
+	a := &ci.Agent{
+		Name:     *name,
+		Labels:   splitLabels(*labels),
+		Capacity: *capacity,
+		Runner:   runner,
+	}
+	client := agent.NewRunnerServiceClient(conn)
+	for {
+		err := a.Serve(context.Background(), client, token)
+		log.Printf("disconnected from %s: %v; reconnecting in %v\n", *server, err, retryDelay)
+		time.Sleep(retryDelay)
+	}
+}
+
+// splitLabels returns the non-empty labels of the given comma separated list.
+func splitLabels(list string) []string {
+	var labels []string
+	for _, label := range strings.Split(list, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
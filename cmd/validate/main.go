@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/ci"
+	"go.uber.org/zap"
+)
+
+// Example usage (from the quickfeed directory, so that ci/scripts can be found):
+// validate -tests ../dat320-2020/tests
+// validate -tests ../dat320-2020/tests -solutions https://github.com/dat320-2020/solutions
+//
+// The tests of each assignment are run in docker (or podman, with -engine podman) against the solutions repository,
+// using the GITHUB_ACCESS_TOKEN environment variable to clone the repositories.
+// The containers clone the tests repository at the commit checked out in the local clone,
+// which must therefore be pushed; uncommitted changes in the local clone are not tested.
+
+func main() {
+	var (
+		testsDir  = flag.String("tests", "", "path to a local clone of the course's tests repository")
+		solutions = flag.String("solutions", "", "URL of a solutions repository in the course organization; if set, the tests are run against it")
+		token     = flag.String("token", os.Getenv("GITHUB_ACCESS_TOKEN"), "access token used to clone the solutions and tests repositories")
+		engine    = flag.String("engine", "docker", "container engine to run the tests: docker or podman")
+	)
+	flag.Parse()
+	if *testsDir == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	course := &pb.Course{}
+	pb.SetAccessToken(course.GetID(), *token)
+	parsed, report := assignments.Validate(course, *testsDir)
+	if *solutions != "" && len(report.GetErrors()) == 0 {
+		runner, err := ci.NewContainerCI(*engine)
+		if err != nil {
+			log.Fatalf("Failed to set up %s runner: %v", *engine, err)
+		}
+		defer runner.Close()
+		logger, err := zap.NewDevelopment()
+		if err != nil {
+			log.Fatal(err)
+		}
+		commit, dirty, err := assignments.TestsCommit(context.Background(), *testsDir)
+		if err != nil {
+			log.Fatalf("Failed to get commit of %s: %v", *testsDir, err)
+		}
+		if dirty {
+			fmt.Printf("warning: %s has uncommitted changes; testing commit %s\n", *testsDir, commit)
+		}
+		assignments.PinTestsCommit(parsed, commit)
+		assignments.DryRun(logger.Sugar(), runner, course, parsed, &pb.Repository{HTMLURL: *solutions}, report)
+	}
+
+	if !printReport(report) {
+		os.Exit(1)
+	}
+}
+
+// printReport prints the validation report and returns true if no problems were found.
+func printReport(report *pb.TestsValidation) bool {
+	valid := len(report.GetErrors()) == 0
+	for _, err := range report.GetErrors() {
+		fmt.Printf("error: %s\n", err)
+	}
+	for _, assignment := range report.GetAssignments() {
+		status := "ok"
+		if len(assignment.GetErrors()) > 0 {
+			status = "FAILED"
+			valid = false
+		}
+		if assignment.GetTested() {
+			fmt.Printf("%-20s %-6s score %d%% (limit %d%%)\n", assignment.GetName(), status, assignment.GetScore(), assignment.GetScoreLimit())
+		} else {
+			fmt.Printf("%-20s %s\n", assignment.GetName(), status)
+		}
+		for _, err := range assignment.GetErrors() {
+			fmt.Printf("    %s\n", err)
+		}
+	}
+	return valid
+}
@@ -46,6 +46,8 @@ type Database interface {
 	GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Course, error)
 	// UpdateCourse updates course information.
 	UpdateCourse(*pb.Course) error
+	// UpdateCourseImage records the course's docker image and the error from its latest build.
+	UpdateCourseImage(*pb.Course) error
 
 	// CreateEnrollment creates a new pending enrollment.
 	CreateEnrollment(*pb.Enrollment) error
@@ -79,8 +81,11 @@ type Database interface {
 	GetAssignment(query *pb.Assignment) (*pb.Assignment, error)
 	// GetAssignmentsByCourse returns a list of all assignments for the given course ID.
 	GetAssignmentsByCourse(uint64, bool) ([]*pb.Assignment, error)
-	// UpdateAssignments updates the specified list of assignments.
-	UpdateAssignments([]*pb.Assignment) error
+	// UpdateAssignments updates the specified list of assignments of the given
+	// course ID, and archives assignments of the course that are not in the list.
+	UpdateAssignments(uint64, []*pb.Assignment) error
+	// ReleaseAssignments makes hidden assignments whose release date has passed visible.
+	ReleaseAssignments(now string) ([]*pb.Assignment, error)
 	// CreateBenchmark creates a new grading benchmark.
 	CreateBenchmark(*pb.GradingBenchmark) error
 	// UpdateBenchmark updates the given benchmark.
@@ -118,6 +123,27 @@ type Database interface {
 	UpdateReview(*pb.Review) error
 	// DeleteReview removes all review records matching the query.
 	DeleteReview(*pb.Review) error
+	// RecordTestOutcomes adds the given runs and passes to the recorded outcomes of each test.
+	RecordTestOutcomes([]*pb.TestOutcome) error
+	// GetTestOutcomes returns the recorded test outcomes for the given assignment.
+	GetTestOutcomes(assignmentID uint64) ([]*pb.TestOutcome, error)
+	// UpdateBuildLog records the complete log of a submission's latest build.
+	UpdateBuildLog(*pb.BuildLog) error
+	// GetBuildLog returns the complete log of the given submission's latest build.
+	GetBuildLog(submissionID uint64) (*pb.BuildLog, error)
+	// CreateRegrade creates a new regrade request for a submission.
+	CreateRegrade(*pb.Regrade) error
+	// GetRegrade returns the regrade matching the given query.
+	GetRegrade(query *pb.Regrade) (*pb.Regrade, error)
+	// GetRegrades returns all regrades matching the given query.
+	GetRegrades(query *pb.Regrade) ([]*pb.Regrade, error)
+	// GetRegradesByCourse returns the regrades of all submissions for the given course.
+	GetRegradesByCourse(courseID uint64) ([]*pb.Regrade, error)
+	// UpdateRegrade updates the state of the given regrade, and changes
+	// the submission's score with the given score update, if any.
+	UpdateRegrade(*pb.Regrade, *pb.ScoreUpdate) error
+	// CreateScoreUpdate records a change of a submission's score.
+	CreateScoreUpdate(*pb.ScoreUpdate) error
 
 	// CreateRepository creates a new repository.
 	CreateRepository(repo *pb.Repository) error
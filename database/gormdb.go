@@ -63,6 +63,10 @@ func NewGormDB(driver, path string, logger GormLogger) (*GormDB, error) {
 		&pb.GradingBenchmark{},
 		&pb.GradingCriterion{},
 		&pb.Review{},
+		&pb.TestOutcome{},
+		&pb.BuildLog{},
+		&pb.Regrade{},
+		&pb.ScoreUpdate{},
 	).Error; err != nil {
 		return nil, err
 	}
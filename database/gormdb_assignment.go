@@ -32,15 +32,30 @@ func (db *GormDB) CreateAssignment(assignment *pb.Assignment) error {
 			Order:    assignment.Order,
 		}).
 		Assign(map[string]interface{}{
-			"name":              assignment.Name,
-			"order":             assignment.Order,
-			"script_file":       assignment.ScriptFile,
-			"deadline":          assignment.Deadline,
-			"auto_approve":      assignment.AutoApprove,
-			"score_limit":       assignment.ScoreLimit,
-			"is_group_lab":      assignment.IsGroupLab,
-			"reviewers":         assignment.Reviewers,
-			"container_timeout": assignment.ContainerTimeout,
+			"name":                assignment.Name,
+			"order":               assignment.Order,
+			"script_file":         assignment.ScriptFile,
+			"deadline":            assignment.Deadline,
+			"auto_approve":        assignment.AutoApprove,
+			"score_limit":         assignment.ScoreLimit,
+			"is_group_lab":        assignment.IsGroupLab,
+			"reviewers":           assignment.Reviewers,
+			"container_timeout":   assignment.ContainerTimeout,
+			"skip_tests":          assignment.SkipTests,
+			"release_date":        assignment.ReleaseDate,
+			"hidden":              assignment.Hidden,
+			"prerequisites":       assignment.Prerequisites,
+			"prerequisite_policy": assignment.PrerequisitePolicy,
+			"archived":            assignment.Archived,
+			"tests_commit":        assignment.TestsCommit,
+			"script_template":     assignment.ScriptTemplate,
+			"analyzers":           assignment.Analyzers,
+			"coverage":            assignment.Coverage,
+			"artifacts_dir":       assignment.ArtifactsDir,
+			"reruns":              assignment.Reruns,
+			"runner_labels":       assignment.RunnerLabels,
+			"benchmarks":          assignment.Benchmarks,
+			"leaderboard":         assignment.Leaderboard,
 		}).FirstOrCreate(assignment).Error
 }
 
@@ -86,18 +101,58 @@ func (db *GormDB) GetAssignmentsByCourse(courseID uint64, withGrading bool) ([]*
 	return assignments, nil
 }
 
-// UpdateAssignments updates assignment information.
-func (db *GormDB) UpdateAssignments(assignments []*pb.Assignment) error {
+// UpdateAssignments updates assignment information for the given course.
+// The given assignments must belong to the course. Assignments of the course
+// that are not among the given assignments are archived, also if no
+// assignments are given; their submissions are kept.
+func (db *GormDB) UpdateAssignments(courseID uint64, assignments []*pb.Assignment) error {
 	//TODO(meling) Updating the database may need locking?? Or maybe rewrite as a single query or txn.
+	orders := make(map[uint32]bool)
+	for _, v := range assignments {
+		if v.GetCourseID() != courseID {
+			return fmt.Errorf("assignment %s belongs to course %d, not course %d", v.GetName(), v.GetCourseID(), courseID)
+		}
+	}
 	for _, v := range assignments {
 		// this will create or update an existing assignment
 		if err := db.CreateAssignment(v); err != nil {
 			return err
 		}
+		orders[v.GetOrder()] = true
+	}
+	var existing []*pb.Assignment
+	if err := db.conn.Where(&pb.Assignment{CourseID: courseID}).Find(&existing).Error; err != nil {
+		return err
+	}
+	for _, a := range existing {
+		if orders[a.GetOrder()] || a.GetArchived() {
+			continue
+		}
+		if err := db.conn.Model(a).Update("archived", true).Error; err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// ReleaseAssignments makes hidden assignments whose release date has passed
+// visible, and returns the released assignments. The release date is compared
+// lexicographically with the given time string, which must have the same layout.
+func (db *GormDB) ReleaseAssignments(now string) ([]*pb.Assignment, error) {
+	var assignments []*pb.Assignment
+	if err := db.conn.
+		Where("hidden = ? AND archived = ? AND release_date <> '' AND release_date <= ?", true, false, now).
+		Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	for _, a := range assignments {
+		if err := db.conn.Model(a).Update("hidden", false).Error; err != nil {
+			return nil, err
+		}
+	}
+	return assignments, nil
+}
+
 // GetCourseAssignmentsWithSubmissions returns all course assignments
 // of requested type with preloaded submissions.
 func (db *GormDB) GetCourseAssignmentsWithSubmissions(courseID uint64, submissionType pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error) {
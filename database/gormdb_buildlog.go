@@ -0,0 +1,26 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// UpdateBuildLog records the given build log of a submission,
+// replacing the submission's previous build log, if any.
+func (db *GormDB) UpdateBuildLog(buildLog *pb.BuildLog) error {
+	query := &pb.BuildLog{SubmissionID: buildLog.GetSubmissionID()}
+	var recorded pb.BuildLog
+	if err := db.conn.Where(query).Assign(&pb.BuildLog{Log: buildLog.GetLog()}).FirstOrCreate(&recorded).Error; err != nil {
+		return err
+	}
+	buildLog.ID = recorded.GetID()
+	return nil
+}
+
+// GetBuildLog returns the build log of the given submission.
+func (db *GormDB) GetBuildLog(submissionID uint64) (*pb.BuildLog, error) {
+	var buildLog pb.BuildLog
+	if err := db.conn.Where(&pb.BuildLog{SubmissionID: submissionID}).First(&buildLog).Error; err != nil {
+		return nil, err
+	}
+	return &buildLog, nil
+}
@@ -0,0 +1,35 @@
+package database_test
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/jinzhu/gorm"
+)
+
+func TestGormDBUpdateBuildLog(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	if _, err := db.GetBuildLog(1); err != gorm.ErrRecordNotFound {
+		t.Errorf("GetBuildLog() of submission without log: got error %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	for _, log := range [][]byte{[]byte("first build"), []byte("second build")} {
+		if err := db.UpdateBuildLog(&pb.BuildLog{SubmissionID: 1, Log: log}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateBuildLog(&pb.BuildLog{SubmissionID: 2, Log: []byte("other submission")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the log of a later build replaces the log of the previous build
+	buildLog, err := db.GetBuildLog(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte("second build"); !bytes.Equal(buildLog.GetLog(), want) {
+		t.Errorf("GetBuildLog() = %q, want %q", buildLog.GetLog(), want)
+	}
+}
@@ -139,3 +139,12 @@ func (db *GormDB) GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_User
 func (db *GormDB) UpdateCourse(course *pb.Course) error {
 	return db.conn.Model(&pb.Course{}).Updates(course).Error
 }
+
+// UpdateCourseImage records the course's docker image and the error from its latest build.
+// Unlike UpdateCourse, an empty build error is recorded.
+func (db *GormDB) UpdateCourseImage(course *pb.Course) error {
+	return db.conn.Model(&pb.Course{ID: course.GetID()}).Updates(map[string]interface{}{
+		"docker_image":       course.GetDockerImage(),
+		"docker_image_error": course.GetDockerImageError(),
+	}).Error
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// CreateRegrade creates a new regrade request.
+func (db *GormDB) CreateRegrade(regrade *pb.Regrade) error {
+	return db.conn.Create(regrade).Error
+}
+
+// GetRegrade returns the regrade matching the given query, with its score updates.
+func (db *GormDB) GetRegrade(query *pb.Regrade) (*pb.Regrade, error) {
+	var regrade pb.Regrade
+	if err := db.conn.Preload("ScoreUpdates").Where(query).Last(&regrade).Error; err != nil {
+		return nil, err
+	}
+	return &regrade, nil
+}
+
+// GetRegrades returns all regrades matching the given query, with their score updates,
+// in the order they were created.
+func (db *GormDB) GetRegrades(query *pb.Regrade) ([]*pb.Regrade, error) {
+	var regrades []*pb.Regrade
+	if err := db.conn.Preload("ScoreUpdates").Where(query).Order("id").Find(&regrades).Error; err != nil {
+		return nil, err
+	}
+	return regrades, nil
+}
+
+// GetRegradesByCourse returns the regrades of all submissions
+// for the given course's assignments, with their score updates.
+func (db *GormDB) GetRegradesByCourse(courseID uint64) ([]*pb.Regrade, error) {
+	var regrades []*pb.Regrade
+	if err := db.conn.Preload("ScoreUpdates").
+		Joins("JOIN submissions ON submissions.id = regrades.submission_id").
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Where("assignments.course_id = ?", courseID).
+		Order("regrades.id").
+		Find(&regrades).Error; err != nil {
+		return nil, err
+	}
+	return regrades, nil
+}
+
+// UpdateRegrade records the state of the given regrade and the teacher's response.
+// If a score update is given, the submission's score is changed and the update is
+// recorded together with the regrade's state.
+func (db *GormDB) UpdateRegrade(regrade *pb.Regrade, update *pb.ScoreUpdate) error {
+	tx := db.conn.Begin()
+	if err := tx.Model(regrade).Updates(map[string]interface{}{
+		"state":         regrade.GetState(),
+		"resolver_id":   regrade.GetResolverID(),
+		"resolved_date": regrade.GetResolvedDate(),
+		"response":      regrade.GetResponse(),
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if update != nil {
+		if err := tx.Model(&pb.Submission{ID: update.GetSubmissionID()}).Update("score", update.GetNewScore()).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Create(update).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// CreateScoreUpdate records a change of a submission's score.
+func (db *GormDB) CreateScoreUpdate(update *pb.ScoreUpdate) error {
+	return db.conn.Create(update).Error
+}
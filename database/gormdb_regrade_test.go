@@ -0,0 +1,80 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGormDBRegrades(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	student := createFakeUser(t, db, 2)
+	courses := make([]*pb.Course, 2)
+	submissions := make([]*pb.Submission, 2)
+	for i := range courses {
+		courses[i] = &pb.Course{OrganizationID: uint64(i + 1)}
+		if err := db.CreateCourse(teacher.ID, courses[i]); err != nil {
+			t.Fatal(err)
+		}
+		assignment := &pb.Assignment{CourseID: courses[i].ID, Name: "lab1", Order: 1}
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		submissions[i] = &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 50}
+		if err := db.CreateSubmission(submissions[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	regrade := &pb.Regrade{SubmissionID: submissions[0].ID, UserID: student.ID, Reason: "TestA is wrong", TestName: "TestA"}
+	if err := db.CreateRegrade(regrade); err != nil {
+		t.Fatal(err)
+	}
+	other := &pb.Regrade{SubmissionID: submissions[1].ID, UserID: student.ID, Reason: "missing points"}
+	if err := db.CreateRegrade(other); err != nil {
+		t.Fatal(err)
+	}
+	update := &pb.ScoreUpdate{SubmissionID: submissions[0].ID, RegradeID: regrade.ID, UpdatedByID: teacher.ID, OldScore: 50, NewScore: 60}
+	regrade.State = pb.Regrade_ACCEPTED
+	regrade.ResolverID = teacher.ID
+	regrade.Response = "fixed"
+	if err := db.UpdateRegrade(regrade, update); err != nil {
+		t.Fatal(err)
+	}
+	submission, err := db.GetSubmission(&pb.Submission{ID: submissions[0].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetScore() != 60 {
+		t.Errorf("UpdateRegrade() submission score = %d, want 60", submission.GetScore())
+	}
+
+	got, err := db.GetRegrade(&pb.Regrade{ID: regrade.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	regrade.ScoreUpdates = []*pb.ScoreUpdate{update}
+	if diff := cmp.Diff(regrade, got); diff != "" {
+		t.Errorf("GetRegrade() mismatch (-want +got):\n%s", diff)
+	}
+
+	// only the regrades of the course's submissions are returned
+	regrades, err := db.GetRegradesByCourse(courses[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regrades) != 1 || regrades[0].GetID() != other.ID {
+		t.Errorf("GetRegradesByCourse() = %v, want regrade %d", regrades, other.ID)
+	}
+	regrades, err = db.GetRegrades(&pb.Regrade{UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regrades) != 2 {
+		t.Errorf("GetRegrades() = %d regrades, want 2", len(regrades))
+	}
+}
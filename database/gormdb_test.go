@@ -330,6 +330,174 @@ func TestGormDBCreateAssignment(t *testing.T) {
 	}
 }
 
+func TestGormDBCreateAssignmentUpdate(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	if err := db.CreateCourse(user.ID, &pb.Course{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateAssignment(&pb.Assignment{CourseID: 1, Order: 1, Name: "lab1", ScriptFile: "go.sh"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// creating an assignment with the same course and order updates the existing assignment
+	updated := &pb.Assignment{
+		CourseID:     1,
+		Order:        1,
+		Name:         "lab1-updated",
+		ScriptFile:   "rust.sh",
+		Deadline:     "2020-08-30T23:59:00",
+		TestsCommit:  "0123456789abcdef0123456789abcdef01234567",
+		Analyzers:    `[{"name":"gofmt","weight":1,"deduction":1,"maxdeduction":10}]`,
+		Coverage:     `{"thresholds":[50,80],"weight":1}`,
+		ArtifactsDir: "plots",
+		Reruns:       2,
+		RunnerLabels: "java,large-mem",
+		Benchmarks:   `[{"name":"BenchmarkSort","maxnsperop":1000}]`,
+		Leaderboard:  true,
+	}
+	// CreateAssignment loads the existing assignment into its argument
+	want := *updated
+	want.ID = 1
+	if err := db.CreateAssignment(updated); err != nil {
+		t.Fatal(err)
+	}
+	assignments, err := db.GetAssignmentsByCourse(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("have %d assignments wanted %d", len(assignments), 1)
+	}
+	if !reflect.DeepEqual(assignments[0], &want) {
+		t.Errorf("have assignment %+v want %+v", assignments[0], &want)
+	}
+}
+
+func TestGormDBUpdateAssignmentsArchive(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	if err := db.CreateCourse(user.ID, &pb.Course{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateAssignments(1, []*pb.Assignment{
+		{CourseID: 1, Order: 1, Name: "lab1"},
+		{CourseID: 1, Order: 2, Name: "lab2"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: 2, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	// lab2 has been removed from the tests repository
+	if err := db.UpdateAssignments(1, []*pb.Assignment{{CourseID: 1, Order: 1, Name: "lab1"}}); err != nil {
+		t.Fatal(err)
+	}
+	assignments, err := db.GetAssignmentsByCourse(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 {
+		t.Fatalf("have %d assignments wanted %d", len(assignments), 2)
+	}
+	if assignments[0].GetArchived() || !assignments[1].GetArchived() {
+		t.Errorf("have archived (%t, %t) wanted (false, true)", assignments[0].GetArchived(), assignments[1].GetArchived())
+	}
+	if _, err := db.GetSubmission(&pb.Submission{ID: submission.ID}); err != nil {
+		t.Errorf("submission for archived assignment not kept: %v", err)
+	}
+
+	// lab2 is added back to the tests repository
+	if err := db.UpdateAssignments(1, []*pb.Assignment{
+		{CourseID: 1, Order: 1, Name: "lab1"},
+		{CourseID: 1, Order: 2, Name: "lab2"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	assignment, err := db.GetAssignment(&pb.Assignment{ID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assignment.GetArchived() {
+		t.Errorf("have archived assignment %s wanted active", assignment.GetName())
+	}
+
+	// all assignments have been removed from the tests repository
+	if err := db.UpdateAssignments(1, nil); err != nil {
+		t.Fatal(err)
+	}
+	assignments, err = db.GetAssignmentsByCourse(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, assignment := range assignments {
+		if !assignment.GetArchived() {
+			t.Errorf("have active assignment %s wanted archived", assignment.GetName())
+		}
+	}
+
+	// assignments of another course are not updated
+	if err := db.UpdateAssignments(1, []*pb.Assignment{{CourseID: 2, Order: 1, Name: "lab1"}}); err == nil {
+		t.Error("UpdateAssignments() with assignment of another course: got nil, want error")
+	}
+}
+
+func TestGormDBReleaseAssignments(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	if err := db.CreateCourse(user.ID, &pb.Course{}); err != nil {
+		t.Fatal(err)
+	}
+
+	assignments := []*pb.Assignment{
+		{CourseID: 1, Order: 1, Name: "lab1", Hidden: true, ReleaseDate: "2020-01-10T12:00:00"},
+		{CourseID: 1, Order: 2, Name: "lab2", Hidden: true, ReleaseDate: "2020-02-10T12:00:00"},
+		{CourseID: 1, Order: 3, Name: "lab3", Hidden: true},
+		{CourseID: 1, Order: 4, Name: "lab4"},
+	}
+	for _, assignment := range assignments {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	released, err := db.ReleaseAssignments("2020-01-20T12:00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(released) != 1 || released[0].GetName() != "lab1" {
+		t.Fatalf("have released %v wanted only lab1", released)
+	}
+
+	stored, err := db.GetAssignmentsByCourse(1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHidden := []bool{false, true, true, false}
+	for i, assignment := range stored {
+		if assignment.GetHidden() != wantHidden[i] {
+			t.Errorf("have %s hidden=%t wanted hidden=%t", assignment.GetName(), assignment.GetHidden(), wantHidden[i])
+		}
+	}
+
+	// releasing again should not return already released assignments
+	released, err = db.ReleaseAssignments("2020-01-20T12:00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(released) != 0 {
+		t.Errorf("have released %v wanted none", released)
+	}
+}
+
 func TestGormDBCreateEnrollmentNoRecord(t *testing.T) {
 	const (
 		userId   = 1
@@ -844,6 +1012,33 @@ func TestGormDBUpdateCourse(t *testing.T) {
 	}
 }
 
+func TestGormDBUpdateCourseImage(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	user := createFakeUser(t, db, 10)
+	course := &pb.Course{Name: "Test Course", OrganizationID: 1234}
+	if err := db.CreateCourse(user.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []*pb.Course{
+		{ID: course.ID, DockerImage: "quickfeed/course-1:abc", DockerImageError: "failed to build image"},
+		// a successful build clears the error
+		{ID: course.ID, DockerImage: "quickfeed/course-1:def"},
+	} {
+		if err := db.UpdateCourseImage(want); err != nil {
+			t.Fatal(err)
+		}
+		got, err := db.GetCourse(course.ID, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetDockerImage() != want.GetDockerImage() || got.GetDockerImageError() != want.GetDockerImageError() {
+			t.Errorf("have image %q, error %q want %q, %q", got.GetDockerImage(), got.GetDockerImageError(), want.GetDockerImage(), want.GetDockerImageError())
+		}
+	}
+}
+
 func TestGormDBGetSubmissionForUser(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
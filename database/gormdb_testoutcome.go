@@ -0,0 +1,42 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/jinzhu/gorm"
+)
+
+// RecordTestOutcomes adds the runs and passes of the given test outcomes
+// to the outcomes recorded for the same assignment, commit and test.
+func (db *GormDB) RecordTestOutcomes(outcomes []*pb.TestOutcome) error {
+	tx := db.conn.Begin()
+	for _, outcome := range outcomes {
+		query := &pb.TestOutcome{
+			AssignmentID: outcome.GetAssignmentID(),
+			CommitHash:   outcome.GetCommitHash(),
+			TestName:     outcome.GetTestName(),
+		}
+		var recorded pb.TestOutcome
+		if err := tx.Where(query).FirstOrCreate(&recorded).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Model(&recorded).Updates(map[string]interface{}{
+			"runs":   gorm.Expr("runs + ?", outcome.GetRuns()),
+			"passes": gorm.Expr("passes + ?", outcome.GetPasses()),
+		}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// GetTestOutcomes returns the recorded test outcomes for the given assignment.
+func (db *GormDB) GetTestOutcomes(assignmentID uint64) ([]*pb.TestOutcome, error) {
+	var outcomes []*pb.TestOutcome
+	if err := db.conn.Where(&pb.TestOutcome{AssignmentID: assignmentID}).
+		Order("test_name, commit_hash").Find(&outcomes).Error; err != nil {
+		return nil, err
+	}
+	return outcomes, nil
+}
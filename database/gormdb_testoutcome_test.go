@@ -0,0 +1,44 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGormDBRecordTestOutcomes(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	if err := db.RecordTestOutcomes([]*pb.TestOutcome{
+		{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 2, Passes: 1},
+		{AssignmentID: 1, CommitHash: "abc", TestName: "TestB", Runs: 2, Passes: 2},
+		{AssignmentID: 2, CommitHash: "abc", TestName: "TestA", Runs: 1, Passes: 1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// outcomes of later runs on the same commit are added to the recorded outcomes
+	if err := db.RecordTestOutcomes([]*pb.TestOutcome{
+		{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 1, Passes: 1},
+		{AssignmentID: 1, CommitHash: "def", TestName: "TestA", Runs: 1, Passes: 0},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	outcomes, err := db.GetTestOutcomes(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.TestOutcome{
+		{AssignmentID: 1, CommitHash: "abc", TestName: "TestA", Runs: 3, Passes: 2},
+		{AssignmentID: 1, CommitHash: "def", TestName: "TestA", Runs: 1, Passes: 0},
+		{AssignmentID: 1, CommitHash: "abc", TestName: "TestB", Runs: 2, Passes: 2},
+	}
+	for _, outcome := range outcomes {
+		outcome.ID = 0
+	}
+	if diff := cmp.Diff(want, outcomes); diff != "" {
+		t.Errorf("GetTestOutcomes() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -2,7 +2,9 @@ package envoy
 
 import (
 	"context"
+	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/docker/docker/api/types/filters"
 	"go.uber.org/zap"
@@ -11,13 +13,18 @@ import (
 	"github.com/docker/docker/client"
 )
 
-// StartEnvoy creates a Docker API client. If an envoy container is not running,
+// StartEnvoy creates a Docker API client, or uses podman if the given container
+// engine is "podman". If an envoy container is not running,
 // it will be started from an image. If no image exists, it will pull an Envoy
 // image from docker and build it with options from envoy.yaml.
 //TODO(meling) since this runs in a separate goroutine it is actually bad practice
 //to Panic or Fatal on error, since other goroutines may not exit cleanly.
 //Instead it would be better to return an error and run synchronously.
-func StartEnvoy(l *zap.Logger) {
+func StartEnvoy(l *zap.Logger, engine string) {
+	if engine == "podman" {
+		startPodmanEnvoy(l)
+		return
+	}
 	ctx := context.Background()
 	cli, err := client.NewEnvClient()
 	if err != nil {
@@ -47,21 +54,62 @@ func StartEnvoy(l *zap.Logger) {
 		// if there is no active Envoy image, we build it
 		l.Info("building Envoy image...")
 		//TODO(meling) use docker api to build image: "docker build -t ag_envoy -f ./envoy/envoy.Dockerfile ."
-		out, err := exec.Command("/bin/sh", "./envoy/envoy.sh", "build").Output()
-		if err != nil {
-			l.Fatal("failed to execute bash script", zap.Error(err))
-		}
-		l.Debug("envoy.sh build", zap.String("output", string(out)))
+		buildEnvoy(l, "docker")
 	}
 	l.Info("starting Envoy container...")
 	//TODO(meling) use docker api to run image: "docker run --name=envoy -p 8080:8080 --net=host ag_envoy"
-	out, err := exec.Command("/bin/sh", "./envoy/envoy.sh").Output()
+	runEnvoy(l, "docker")
+}
+
+// startPodmanEnvoy starts the envoy container with podman, unless it is
+// already running. The Envoy image is built if it does not exist.
+func startPodmanEnvoy(l *zap.Logger) {
+	out, err := exec.Command("podman", "ps", "--filter", "name=^envoy$", "--format", "{{.Names}}").Output()
+	if err != nil {
+		l.Fatal("failed to retrieve podman container list", zap.Error(err))
+	}
+	if strings.TrimSpace(string(out)) == "envoy" {
+		l.Info("Envoy container is already running")
+		return
+	}
+	// removes a stopped envoy container, if any
+	_ = exec.Command("podman", "rm", "--force", "envoy").Run()
+
+	if err := exec.Command("podman", "image", "exists", "ag_envoy").Run(); err != nil {
+		// if there is no active Envoy image, we build it
+		l.Info("building Envoy image...")
+		buildEnvoy(l, "podman")
+	}
+	l.Info("starting Envoy container...")
+	runEnvoy(l, "podman")
+}
+
+// buildEnvoy builds the Envoy image with the given container engine.
+func buildEnvoy(l *zap.Logger, engine string) {
+	out, err := envoyScript(engine, "build").Output()
+	if err != nil {
+		l.Fatal("failed to execute bash script", zap.Error(err))
+	}
+	l.Debug("envoy.sh build", zap.String("output", string(out)))
+}
+
+// runEnvoy runs the Envoy container with the given container engine.
+func runEnvoy(l *zap.Logger, engine string) {
+	out, err := envoyScript(engine).Output()
 	if err != nil {
 		l.Fatal("failed to execute bash script", zap.Error(err))
 	}
 	l.Debug("envoy.sh", zap.String("output", string(out)))
 }
 
+// envoyScript returns the envoy.sh command with the given arguments,
+// using the given container engine.
+func envoyScript(engine string, args ...string) *exec.Cmd {
+	cmd := exec.Command("/bin/sh", append([]string{"./envoy/envoy.sh"}, args...)...)
+	cmd.Env = append(os.Environ(), "CONTAINER_ENGINE="+engine)
+	return cmd
+}
+
 // hasEnvoyImage returns true if the docker client has the latest Envoy image.
 func hasEnvoyImage(ctx context.Context, l *zap.Logger, cli *client.Client) bool {
 	l.Debug("no running Envoy container found")
@@ -2,10 +2,13 @@
 
 # builds a new container from Envoy image if called with an argument
 # othervise starts the Envoy container
+# uses the container engine in CONTAINER_ENGINE; docker by default
+
+ENGINE=${CONTAINER_ENGINE:-docker}
 
 if [ "$#" -eq 1 ]; then
     echo "got 1 arg"
-    docker build -t ag_envoy -f ./envoy/envoy.Dockerfile .
+    $ENGINE build -t ag_envoy -f ./envoy/envoy.Dockerfile .
 else
-    docker run --name=envoy -p 8080:8080 --net=host ag_envoy 
+    $ENGINE run --name=envoy -p 8080:8080 --net=host ag_envoy 
 fi
\ No newline at end of file
@@ -0,0 +1,82 @@
+package score
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// benchmarkPrefix is the start of the JSON representation of a benchmark.
+// The Benchmark field is first, so that benchmarks are not taken for scores.
+const benchmarkPrefix = `{"Benchmark":`
+
+// Benchmark holds the metrics of a benchmark run by a test. QuickFeed records
+// the metrics, and scores them against the assignment's benchmark thresholds.
+type Benchmark struct {
+	Benchmark   string // name of the test that ran the benchmark
+	Secret      string // the unique identifier for a scoring session
+	N           int    // number of iterations
+	NsPerOp     int64  // nanoseconds per iteration
+	AllocsPerOp int64  // memory allocations per iteration
+	BytesPerOp  int64  // bytes allocated per iteration
+}
+
+// RunBenchmark runs the given benchmark function, and prints a JSON representation
+// of its metrics to be recorded by QuickFeed. The benchmark is named after the test.
+//
+// Benchmarks are run from a test, rather than with go test -bench,
+// so that the metrics are printed with the scoring session's secret:
+//   func TestSortPerformance(t *testing.T) {
+//       score.RunBenchmark(t, BenchmarkSort)
+//   }
+//
+func RunBenchmark(t *testing.T, f func(b *testing.B)) *Benchmark {
+	r := testing.Benchmark(f)
+	bm := &Benchmark{
+		Benchmark:   t.Name(),
+		Secret:      sessionSecret,
+		N:           r.N,
+		NsPerOp:     r.NsPerOp(),
+		AllocsPerOp: r.AllocsPerOp(),
+		BytesPerOp:  r.AllocedBytesPerOp(),
+	}
+	fmt.Println(bm.json())
+	fmt.Printf("%s: %d ns/op, %d allocs/op, %d B/op\n", bm.Benchmark, bm.NsPerOp, bm.AllocsPerOp, bm.BytesPerOp)
+	return bm
+}
+
+// json returns a JSON string for the benchmark object.
+func (bm Benchmark) json() string {
+	b, err := json.Marshal(bm)
+	if err != nil {
+		return fmt.Sprintf("json.Marshal error: %v\n", err)
+	}
+	return string(b)
+}
+
+// HasBenchmarkPrefix returns true if the provided string s has the prefix of a benchmark.
+func HasBenchmarkPrefix(s string) bool {
+	return strings.HasPrefix(strings.TrimSpace(s), benchmarkPrefix)
+}
+
+// ParseBenchmark returns a benchmark object for the provided JSON string s
+// which contains secret.
+func ParseBenchmark(s, secret string) (*Benchmark, error) {
+	if !strings.Contains(s, secret) {
+		return nil, ErrScoreNotFound
+	}
+	var bm Benchmark
+	if err := json.Unmarshal([]byte(strings.TrimSpace(s)), &bm); err != nil {
+		if strings.Contains(err.Error(), secret) {
+			return nil, errors.New("error suppressed to avoid revealing secret")
+		}
+		return nil, err
+	}
+	if bm.Secret != secret {
+		return nil, ErrScoreNotFound
+	}
+	bm.Secret = hiddenSecret
+	return &bm, nil
+}
@@ -0,0 +1,40 @@
+package score
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunBenchmark(t *testing.T) {
+	bm := RunBenchmark(t, func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = strings.Repeat("a", 100)
+		}
+	})
+	if bm.Benchmark != "TestRunBenchmark" {
+		t.Errorf("Benchmark = %q, want %q", bm.Benchmark, "TestRunBenchmark")
+	}
+	if bm.N == 0 || bm.NsPerOp == 0 {
+		t.Errorf("RunBenchmark() = %+v, want iterations and time per iteration", bm)
+	}
+	if bm.AllocsPerOp != 1 {
+		t.Errorf("AllocsPerOp = %d, want 1", bm.AllocsPerOp)
+	}
+
+	// the session secret is read before the test sets it
+	bm.Secret = theSecret
+	line := bm.json()
+	if !HasBenchmarkPrefix(line) || HasPrefix(line) {
+		t.Errorf("benchmark line %q must have benchmark prefix and no score prefix", line)
+	}
+	parsed, err := ParseBenchmark(line, theSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Secret != hiddenSecret || parsed.NsPerOp != bm.NsPerOp || parsed.AllocsPerOp != bm.AllocsPerOp {
+		t.Errorf("ParseBenchmark() = %+v, want %+v with hidden secret", parsed, bm)
+	}
+	if _, err := ParseBenchmark(strings.Replace(line, theSecret, "the wrong secret", 1), theSecret); err != ErrScoreNotFound {
+		t.Errorf("ParseBenchmark() with wrong secret: got error %v, want %v", err, ErrScoreNotFound)
+	}
+}
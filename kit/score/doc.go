@@ -24,4 +24,12 @@
 // session's secret value. If such a JSON Score object is found, Quickfeed
 // extracts and records the Score object to be used in the above calculation.
 // All other output is ignored when computing the score.
+//
+// A test may describe why it failed with SetFailure, and record the expected
+// and actual values with SetExpected. These details, and the test's duration,
+// are recorded with the test's score, and shown to the student.
+//
+// A test may measure the performance of the student's code with RunBenchmark.
+// Quickfeed records the benchmark's metrics, and scores them against the
+// assignment's benchmark thresholds.
 package score
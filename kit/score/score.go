@@ -7,15 +7,24 @@ import (
 	"os"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 const (
 	secretEnvName = "QUICKFEED_SESSION_SECRET"
+	// maxSnippetLength is the maximum length of the expected and actual
+	// values recorded for a failed test; longer values are truncated.
+	maxSnippetLength = 1000
 )
 
 var sessionSecret string
 
+// startTimes holds the time each score object was created, to compute
+// the test's duration when the score is printed.
+var startTimes sync.Map
+
 func init() {
 	sessionSecret = os.Getenv(secretEnvName)
 	// remove variable as soon as it has been read
@@ -29,6 +38,10 @@ type Score struct {
 	Score    int    // the score obtained
 	MaxScore int    // max score possible to get on this specific test
 	Weight   int    // the weight of this test; used to compute final grade
+	Duration int64  `json:",omitempty"` // the test's execution time in milliseconds
+	Failure  string `json:",omitempty"` // why the test failed, if reported by the test
+	Expected string `json:",omitempty"` // snippet of the expected value, if reported by a failed test
+	Actual   string `json:",omitempty"` // snippet of the actual value, if reported by a failed test
 }
 
 // NewScore returns a new Score object with the given max and weight.
@@ -44,6 +57,7 @@ func NewScore(t *testing.T, max, weight int) *Score {
 		MaxScore: max,
 		Weight:   weight,
 	}
+	startTimes.Store(sc, time.Now())
 	// prints JSON score object with zero score, e.g.:
 	// {"Secret":"my secret code","TestName":"TestPanicHandler","Score":0,"MaxScore":8,"Weight":5}
 	// This registers the test, in case a panic occurs that prevents printing the score object.
@@ -95,6 +109,27 @@ func (s *Score) DecBy(n int) {
 	}
 }
 
+// SetFailure records a message describing why the test failed,
+// to be shown with the test's score.
+func (s *Score) SetFailure(format string, args ...interface{}) {
+	s.Failure = fmt.Sprintf(format, args...)
+}
+
+// SetExpected records the expected and actual values of a failed test,
+// to be shown with the test's score. Long values are truncated.
+func (s *Score) SetExpected(expected, actual interface{}) {
+	s.Expected = snippet(fmt.Sprintf("%v", expected))
+	s.Actual = snippet(fmt.Sprintf("%v", actual))
+}
+
+// snippet returns s truncated to at most maxSnippetLength bytes.
+func snippet(s string) string {
+	if len(s) <= maxSnippetLength {
+		return s
+	}
+	return s[:maxSnippetLength] + "..."
+}
+
 // String returns a string representation of the score.
 // Format: "TestName: 2/10 test cases passed".
 func (s Score) String() string {
@@ -124,6 +159,7 @@ func (s *Score) WriteJSON(w io.Writer) {
 }
 
 // Print prints both the JSON secret string and emits the number of test cases passed.
+// The time since the score object was created is recorded as the test's duration.
 // If a test panics, the score will be set to zero, and a panic message will be emitted.
 // Note that, if subtests are used, each subtest must defer call the PanicHandler method
 // to ensure that panics are caught and handled appropriately.
@@ -131,6 +167,13 @@ func (s *Score) Print(t *testing.T) {
 	if r := recover(); r != nil {
 		s.fail(t)
 		printPanicMessage(s.TestName, r)
+		if s.Failure == "" {
+			s.Failure = fmt.Sprintf("panic: %v", r)
+		}
+	}
+	if start, ok := startTimes.Load(s); ok {
+		s.Duration = time.Since(start.(time.Time)).Milliseconds()
+		startTimes.Delete(s)
 	}
 	// print JSON score object: {"Secret":"my secret code","TestName": ...}
 	fmt.Println(s.json())
@@ -3,7 +3,9 @@ package score
 import (
 	"log"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 var theSecret = "my secret code"
@@ -123,3 +125,34 @@ func TestParseJSONStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestScoreDetails(t *testing.T) {
+	sc := NewScore(t, 1, 1)
+	time.Sleep(10 * time.Millisecond)
+	sc.SetFailure("got %d results, want %d", 2, 3)
+	sc.SetExpected(strings.Repeat("a", maxSnippetLength+1), "b")
+	sc.Print(t)
+
+	if sc.Duration < 10 {
+		t.Errorf("Duration = %d, want at least 10", sc.Duration)
+	}
+	if want := "got 2 results, want 3"; sc.Failure != want {
+		t.Errorf("Failure = %q, want %q", sc.Failure, want)
+	}
+	if want := strings.Repeat("a", maxSnippetLength) + "..."; sc.Expected != want {
+		t.Errorf("Expected = %q, want %q", sc.Expected, want)
+	}
+	if sc.Actual != "b" {
+		t.Errorf("Actual = %q, want %q", sc.Actual, "b")
+	}
+
+	// the session secret is read before the test sets it
+	sc.Secret = theSecret
+	parsed, err := Parse(sc.json(), theSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Duration != sc.Duration || parsed.Failure != sc.Failure || parsed.Expected != sc.Expected || parsed.Actual != sc.Actual {
+		t.Errorf("Parse() = %+v, want details of %+v", parsed, sc)
+	}
+}
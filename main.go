@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"log"
@@ -8,8 +9,11 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/autograde/quickfeed/assignments"
 	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/ci/agent"
 	"github.com/autograde/quickfeed/envoy"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
@@ -21,6 +25,7 @@ import (
 
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
@@ -52,6 +57,8 @@ func init() {
 		pb.AgFailedMethodsMetric,
 		pb.AgMethodSuccessRateMetric,
 		pb.AgResponseTimeByMethodsMetric,
+		ci.BuildQueueLengthMetric,
+		ci.BuildQueueWaitMetric,
 	)
 }
 
@@ -67,6 +74,17 @@ func main() {
 		grpcAddr   = flag.String("grpc.addr", ":9090", "gRPC listen address")
 		scriptPath = flag.String("script.path", "ci/scripts", "path to continuous integration scripts")
 		fake       = flag.Bool("provider.fake", false, "enable fake provider")
+		artifacts  = flag.String("artifacts.dir", "artifacts", "path to store build artifacts")
+		maxSize    = flag.Int64("artifacts.size", 50, "maximum size of a build's artifacts in megabytes")
+		maxFiles   = flag.Int("artifacts.files", 100, "maximum number of artifacts of a build")
+		engine     = flag.String("runner.engine", "docker", "container engine to run jobs and envoy: docker or podman")
+		runnerAddr = flag.String("runner.addr", "", "runner agent listen address; jobs run on the local container engine if empty")
+		runnerCert = flag.String("runner.cert", "", "TLS certificate of the runner agent listener; required with runner.addr")
+		runnerKey  = flag.String("runner.key", "", "TLS key of the runner agent listener; required with runner.addr")
+		workers    = flag.Int("builds.workers", 0, "maximum number of concurrent builds of pushed commits; 0 means no limit")
+		rebuilds   = flag.Int("builds.rebuilds", 0, "maximum number of concurrent rebuilds; 0 means no limit")
+		quota      = flag.Int("builds.quota", 0, "maximum number of builds of each user or group within the quota window; 0 means no limit")
+		window     = flag.Duration("builds.window", time.Hour, "time window of the build quota")
 	)
 	flag.Parse()
 
@@ -92,8 +110,11 @@ func main() {
 		}
 	}()
 
-	// start envoy in a docker container; fetch envoy docker image if necessary
-	go envoy.StartEnvoy(logger)
+	// start envoy in a container; fetch envoy docker image if necessary
+	go envoy.StartEnvoy(logger, *engine)
+
+	// release hidden assignments when their release date has passed
+	go assignments.StartReleaseScheduler(logger.Sugar(), db, time.Minute)
 
 	// holds references for activated providers for current user token
 	scms := auth.NewScms()
@@ -102,13 +123,33 @@ func main() {
 		Secret:  os.Getenv("WEBHOOK_SECRET"),
 	}
 
-	runner, err := ci.NewDockerCI()
+	var runner ci.Runner
+	if *runnerAddr != "" {
+		remote, err := startRunnerService(logger, *runnerAddr, *runnerCert, *runnerKey)
+		if err != nil {
+			log.Fatalf("failed to start runner service: %v\n", err)
+		}
+		defer remote.Close()
+		runner = remote
+	} else {
+		local, err := ci.NewContainerCI(*engine)
+		if err != nil {
+			log.Fatalf("failed to set up %s runner: %v\n", *engine, err)
+		}
+		defer local.Close()
+		runner = local
+	}
+
+	store, err := ci.NewArtifactStore(*artifacts, *maxSize<<20, *maxFiles)
 	if err != nil {
-		log.Fatalf("failed to set up docker client: %v\n", err)
+		log.Fatalf("failed to set up artifact store: %v\n", err)
 	}
-	defer runner.Close()
 
 	agService := web.NewAutograderService(logger, db, scms, bh, runner)
+	agService.SetArtifactStore(store)
+	builds := ci.NewBuilds(*workers, *rebuilds)
+	builds.SetQuota(*quota, *window)
+	agService.SetBuilds(builds)
 	go web.New(agService, *public, *httpAddr, *scriptPath, *fake)
 
 	lis, err := net.Listen("tcp", *grpcAddr)
@@ -134,3 +175,34 @@ func main() {
 		log.Fatalf("failed to start grpc server: %v\n", err)
 	}
 }
+
+// startRunnerService starts the gRPC service to which runner agents connect, and returns
+// the runner that dispatches jobs to the agents. Agents authenticate with the token in
+// the QUICKFEED_AGENT_TOKEN environment variable, and must connect over TLS, since the
+// jobs' commands carry the courses' access tokens.
+func startRunnerService(logger *zap.Logger, addr, certFile, keyFile string) (*ci.Remote, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("runner.cert and runner.key are required to accept runner agents")
+	}
+	creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := ci.NewRemote(os.Getenv("QUICKFEED_AGENT_TOKEN"), ci.DefaultHeartbeatTimeout)
+	if err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// job results carry the build artifacts collected by the agents
+	server := grpc.NewServer(grpc.Creds(creds), grpc.MaxRecvMsgSize(ci.MaxAgentMessageSize))
+	agent.RegisterRunnerServiceServer(server, remote)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			logger.Sugar().Errorf("Runner service stopped: %v", err)
+		}
+	}()
+	return remote, nil
+}
@@ -67,9 +67,12 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoGetAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Assignments, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Assignments.deserializeBinary);
-        this.methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+        this.methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.AssignmentChanges, function (request) {
             return request.serializeBinary();
-        }, ag_pb_1.Void.deserializeBinary);
+        }, ag_pb_1.AssignmentChanges.deserializeBinary);
+        this.methodInfoValidateTests = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.TestsValidation, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.TestsValidation.deserializeBinary);
         this.methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Enrollments, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Enrollments.deserializeBinary);
@@ -100,6 +103,27 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoRebuildSubmission = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoRebuildAssignment = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.AssignmentRebuild, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.AssignmentRebuild.deserializeBinary);
+        this.methodInfoGetAssignmentRebuild = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.AssignmentRebuild, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.AssignmentRebuild.deserializeBinary);
+        this.methodInfoGetArtifacts = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Artifacts, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Artifacts.deserializeBinary);
+        this.methodInfoGetTestStats = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.TestStats, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.TestStats.deserializeBinary);
+        this.methodInfoCancelBuild = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoGetBuildLog = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.BuildLog, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.BuildLog.deserializeBinary);
+        this.methodInfoGetLeaderboard = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Leaderboard, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Leaderboard.deserializeBinary);
         this.methodInfoCreateBenchmark = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.GradingBenchmark, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.GradingBenchmark.deserializeBinary);
@@ -127,6 +151,15 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoGetReviewers = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Reviewers, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Reviewers.deserializeBinary);
+        this.methodInfoCreateRegrade = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Regrade, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Regrade.deserializeBinary);
+        this.methodInfoGetRegrades = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Regrades, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Regrades.deserializeBinary);
+        this.methodInfoUpdateRegrade = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
         this.methodInfoLoadCriteria = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Benchmarks, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Benchmarks.deserializeBinary);
@@ -285,6 +318,13 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/UpdateAssignments', request, metadata || {}, this.methodInfoUpdateAssignments);
     };
+    AutograderServiceClient.prototype.validateTests = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/ValidateTests', this.hostname_).toString(), request, metadata || {}, this.methodInfoValidateTests, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/ValidateTests', request, metadata || {}, this.methodInfoValidateTests);
+    };
     AutograderServiceClient.prototype.getEnrollmentsByUser = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/GetEnrollmentsByUser', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetEnrollmentsByUser, callback);
@@ -355,6 +395,55 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/RebuildSubmission', request, metadata || {}, this.methodInfoRebuildSubmission);
     };
+    AutograderServiceClient.prototype.rebuildAssignment = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/RebuildAssignment', this.hostname_).toString(), request, metadata || {}, this.methodInfoRebuildAssignment, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/RebuildAssignment', request, metadata || {}, this.methodInfoRebuildAssignment);
+    };
+    AutograderServiceClient.prototype.getAssignmentRebuild = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetAssignmentRebuild', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetAssignmentRebuild, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetAssignmentRebuild', request, metadata || {}, this.methodInfoGetAssignmentRebuild);
+    };
+    AutograderServiceClient.prototype.getArtifacts = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetArtifacts', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetArtifacts, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetArtifacts', request, metadata || {}, this.methodInfoGetArtifacts);
+    };
+    AutograderServiceClient.prototype.getTestStats = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetTestStats', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetTestStats, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetTestStats', request, metadata || {}, this.methodInfoGetTestStats);
+    };
+    AutograderServiceClient.prototype.cancelBuild = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/CancelBuild', this.hostname_).toString(), request, metadata || {}, this.methodInfoCancelBuild, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/CancelBuild', request, metadata || {}, this.methodInfoCancelBuild);
+    };
+    AutograderServiceClient.prototype.getBuildLog = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetBuildLog', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetBuildLog, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetBuildLog', request, metadata || {}, this.methodInfoGetBuildLog);
+    };
+    AutograderServiceClient.prototype.getLeaderboard = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetLeaderboard', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetLeaderboard, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetLeaderboard', request, metadata || {}, this.methodInfoGetLeaderboard);
+    };
     AutograderServiceClient.prototype.createBenchmark = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/CreateBenchmark', this.hostname_).toString(), request, metadata || {}, this.methodInfoCreateBenchmark, callback);
@@ -418,6 +507,27 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/AutograderService/GetReviewers', request, metadata || {}, this.methodInfoGetReviewers);
     };
+    AutograderServiceClient.prototype.createRegrade = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/CreateRegrade', this.hostname_).toString(), request, metadata || {}, this.methodInfoCreateRegrade, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/CreateRegrade', request, metadata || {}, this.methodInfoCreateRegrade);
+    };
+    AutograderServiceClient.prototype.getRegrades = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/GetRegrades', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetRegrades, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/GetRegrades', request, metadata || {}, this.methodInfoGetRegrades);
+    };
+    AutograderServiceClient.prototype.updateRegrade = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/AutograderService/UpdateRegrade', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateRegrade, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/AutograderService/UpdateRegrade', request, metadata || {}, this.methodInfoUpdateRegrade);
+    };
     AutograderServiceClient.prototype.loadCriteria = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/AutograderService/LoadCriteria', this.hostname_).toString(), request, metadata || {}, this.methodInfoLoadCriteria, callback);
@@ -15,9 +15,17 @@ import * as grpcWeb from 'grpc-web';
 
 
 import {
+  Artifacts,
+  ArtifactsRequest,
+  AssignmentChanges,
+  AssignmentRebuild,
+  AssignmentRequest,
   Assignments,
   AuthorizationResponse,
   Benchmarks,
+  BuildLog,
+  BuildLogRequest,
+  CancelBuildRequest,
   Course,
   CourseRequest,
   CourseSubmissions,
@@ -33,11 +41,16 @@ import {
   Group,
   GroupRequest,
   Groups,
+  Leaderboard,
   LoadCriteriaRequest,
   OrgRequest,
   Organization,
   Providers,
+  RebuildAssignmentRequest,
   RebuildRequest,
+  Regrade,
+  Regrades,
+  RegradesRequest,
   Repositories,
   RepositoryRequest,
   Review,
@@ -48,7 +61,11 @@ import {
   SubmissionReviewersRequest,
   Submissions,
   SubmissionsForCourseRequest,
+  TestStats,
+  TestsValidation,
+  TestsValidationRequest,
   URLRequest,
+  UpdateRegradeRequest,
   UpdateSubmissionRequest,
   UpdateSubmissionsRequest,
   User,
@@ -777,28 +794,28 @@ export class AutograderServiceClient {
   }
 
   methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(
-    Void,
+    AssignmentChanges,
     (request: CourseRequest) => {
       return request.serializeBinary();
     },
-    Void.deserializeBinary
+    AssignmentChanges.deserializeBinary
   );
 
   updateAssignments(
     request: CourseRequest,
-    metadata: grpcWeb.Metadata | null): Promise<Void>;
+    metadata: grpcWeb.Metadata | null): Promise<AssignmentChanges>;
 
   updateAssignments(
     request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback: (err: grpcWeb.Error,
-               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+               response: AssignmentChanges) => void): grpcWeb.ClientReadableStream<AssignmentChanges>;
 
   updateAssignments(
     request: CourseRequest,
     metadata: grpcWeb.Metadata | null,
     callback?: (err: grpcWeb.Error,
-               response: Void) => void) {
+               response: AssignmentChanges) => void) {
     if (callback !== undefined) {
       return this.client_.rpcCall(
         new URL('/AutograderService/UpdateAssignments', this.hostname_).toString(),
@@ -815,6 +832,45 @@ export class AutograderServiceClient {
     this.methodInfoUpdateAssignments);
   }
 
+  methodInfoValidateTests = new grpcWeb.AbstractClientBase.MethodInfo(
+    TestsValidation,
+    (request: TestsValidationRequest) => {
+      return request.serializeBinary();
+    },
+    TestsValidation.deserializeBinary
+  );
+
+  validateTests(
+    request: TestsValidationRequest,
+    metadata: grpcWeb.Metadata | null): Promise<TestsValidation>;
+
+  validateTests(
+    request: TestsValidationRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: TestsValidation) => void): grpcWeb.ClientReadableStream<TestsValidation>;
+
+  validateTests(
+    request: TestsValidationRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: TestsValidation) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/ValidateTests', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoValidateTests,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/ValidateTests',
+    request,
+    metadata || {},
+    this.methodInfoValidateTests);
+  }
+
   methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(
     Enrollments,
     (request: EnrollmentStatusRequest) => {
@@ -1205,6 +1261,279 @@ export class AutograderServiceClient {
     this.methodInfoRebuildSubmission);
   }
 
+  methodInfoRebuildAssignment = new grpcWeb.AbstractClientBase.MethodInfo(
+    AssignmentRebuild,
+    (request: RebuildAssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    AssignmentRebuild.deserializeBinary
+  );
+
+  rebuildAssignment(
+    request: RebuildAssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<AssignmentRebuild>;
+
+  rebuildAssignment(
+    request: RebuildAssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: AssignmentRebuild) => void): grpcWeb.ClientReadableStream<AssignmentRebuild>;
+
+  rebuildAssignment(
+    request: RebuildAssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: AssignmentRebuild) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/RebuildAssignment', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoRebuildAssignment,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/RebuildAssignment',
+    request,
+    metadata || {},
+    this.methodInfoRebuildAssignment);
+  }
+
+  methodInfoGetAssignmentRebuild = new grpcWeb.AbstractClientBase.MethodInfo(
+    AssignmentRebuild,
+    (request: AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    AssignmentRebuild.deserializeBinary
+  );
+
+  getAssignmentRebuild(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<AssignmentRebuild>;
+
+  getAssignmentRebuild(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: AssignmentRebuild) => void): grpcWeb.ClientReadableStream<AssignmentRebuild>;
+
+  getAssignmentRebuild(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: AssignmentRebuild) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetAssignmentRebuild', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetAssignmentRebuild,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetAssignmentRebuild',
+    request,
+    metadata || {},
+    this.methodInfoGetAssignmentRebuild);
+  }
+
+  methodInfoGetArtifacts = new grpcWeb.AbstractClientBase.MethodInfo(
+    Artifacts,
+    (request: ArtifactsRequest) => {
+      return request.serializeBinary();
+    },
+    Artifacts.deserializeBinary
+  );
+
+  getArtifacts(
+    request: ArtifactsRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Artifacts>;
+
+  getArtifacts(
+    request: ArtifactsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Artifacts) => void): grpcWeb.ClientReadableStream<Artifacts>;
+
+  getArtifacts(
+    request: ArtifactsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Artifacts) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetArtifacts', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetArtifacts,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetArtifacts',
+    request,
+    metadata || {},
+    this.methodInfoGetArtifacts);
+  }
+
+  methodInfoGetTestStats = new grpcWeb.AbstractClientBase.MethodInfo(
+    TestStats,
+    (request: AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    TestStats.deserializeBinary
+  );
+
+  getTestStats(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<TestStats>;
+
+  getTestStats(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: TestStats) => void): grpcWeb.ClientReadableStream<TestStats>;
+
+  getTestStats(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: TestStats) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetTestStats', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetTestStats,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetTestStats',
+    request,
+    metadata || {},
+    this.methodInfoGetTestStats);
+  }
+
+  methodInfoCancelBuild = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: CancelBuildRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  cancelBuild(
+    request: CancelBuildRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  cancelBuild(
+    request: CancelBuildRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  cancelBuild(
+    request: CancelBuildRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/CancelBuild', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoCancelBuild,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/CancelBuild',
+    request,
+    metadata || {},
+    this.methodInfoCancelBuild);
+  }
+
+  methodInfoGetBuildLog = new grpcWeb.AbstractClientBase.MethodInfo(
+    BuildLog,
+    (request: BuildLogRequest) => {
+      return request.serializeBinary();
+    },
+    BuildLog.deserializeBinary
+  );
+
+  getBuildLog(
+    request: BuildLogRequest,
+    metadata: grpcWeb.Metadata | null): Promise<BuildLog>;
+
+  getBuildLog(
+    request: BuildLogRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: BuildLog) => void): grpcWeb.ClientReadableStream<BuildLog>;
+
+  getBuildLog(
+    request: BuildLogRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: BuildLog) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetBuildLog', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetBuildLog,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetBuildLog',
+    request,
+    metadata || {},
+    this.methodInfoGetBuildLog);
+  }
+
+  methodInfoGetLeaderboard = new grpcWeb.AbstractClientBase.MethodInfo(
+    Leaderboard,
+    (request: AssignmentRequest) => {
+      return request.serializeBinary();
+    },
+    Leaderboard.deserializeBinary
+  );
+
+  getLeaderboard(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Leaderboard>;
+
+  getLeaderboard(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Leaderboard) => void): grpcWeb.ClientReadableStream<Leaderboard>;
+
+  getLeaderboard(
+    request: AssignmentRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Leaderboard) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetLeaderboard', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetLeaderboard,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetLeaderboard',
+    request,
+    metadata || {},
+    this.methodInfoGetLeaderboard);
+  }
+
   methodInfoCreateBenchmark = new grpcWeb.AbstractClientBase.MethodInfo(
     GradingBenchmark,
     (request: GradingBenchmark) => {
@@ -1556,6 +1885,123 @@ export class AutograderServiceClient {
     this.methodInfoGetReviewers);
   }
 
+  methodInfoCreateRegrade = new grpcWeb.AbstractClientBase.MethodInfo(
+    Regrade,
+    (request: Regrade) => {
+      return request.serializeBinary();
+    },
+    Regrade.deserializeBinary
+  );
+
+  createRegrade(
+    request: Regrade,
+    metadata: grpcWeb.Metadata | null): Promise<Regrade>;
+
+  createRegrade(
+    request: Regrade,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Regrade) => void): grpcWeb.ClientReadableStream<Regrade>;
+
+  createRegrade(
+    request: Regrade,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Regrade) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/CreateRegrade', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoCreateRegrade,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/CreateRegrade',
+    request,
+    metadata || {},
+    this.methodInfoCreateRegrade);
+  }
+
+  methodInfoGetRegrades = new grpcWeb.AbstractClientBase.MethodInfo(
+    Regrades,
+    (request: RegradesRequest) => {
+      return request.serializeBinary();
+    },
+    Regrades.deserializeBinary
+  );
+
+  getRegrades(
+    request: RegradesRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Regrades>;
+
+  getRegrades(
+    request: RegradesRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Regrades) => void): grpcWeb.ClientReadableStream<Regrades>;
+
+  getRegrades(
+    request: RegradesRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Regrades) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/GetRegrades', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetRegrades,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/GetRegrades',
+    request,
+    metadata || {},
+    this.methodInfoGetRegrades);
+  }
+
+  methodInfoUpdateRegrade = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: UpdateRegradeRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  updateRegrade(
+    request: UpdateRegradeRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  updateRegrade(
+    request: UpdateRegradeRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  updateRegrade(
+    request: UpdateRegradeRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/AutograderService/UpdateRegrade', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateRegrade,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/AutograderService/UpdateRegrade',
+    request,
+    metadata || {},
+    this.methodInfoUpdateRegrade);
+  }
+
   methodInfoLoadCriteria = new grpcWeb.AbstractClientBase.MethodInfo(
     Benchmarks,
     (request: LoadCriteriaRequest) => {
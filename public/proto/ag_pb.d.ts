@@ -229,6 +229,18 @@ export class Course extends jspb.Message {
   clearGroupsList(): Course;
   addGroups(value?: Group, index?: number): Group;
 
+  getTemplatebranch(): string;
+  setTemplatebranch(value: string): Course;
+
+  getPushassignments(): boolean;
+  setPushassignments(value: boolean): Course;
+
+  getDockerimage(): string;
+  setDockerimage(value: string): Course;
+
+  getDockerimageerror(): string;
+  setDockerimageerror(value: string): Course;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Course.AsObject;
   static toObject(includeInstance: boolean, msg: Course): Course.AsObject;
@@ -253,6 +265,10 @@ export namespace Course {
     enrollmentsList: Array<Enrollment.AsObject>,
     assignmentsList: Array<Assignment.AsObject>,
     groupsList: Array<Group.AsObject>,
+    templatebranch: string,
+    pushassignments: boolean,
+    dockerimage: string,
+    dockerimageerror: string,
   }
 }
 
@@ -594,6 +610,48 @@ export class Assignment extends jspb.Message {
   getContainertimeout(): number;
   setContainertimeout(value: number): Assignment;
 
+  getReleasedate(): string;
+  setReleasedate(value: string): Assignment;
+
+  getHidden(): boolean;
+  setHidden(value: boolean): Assignment;
+
+  getPrerequisites(): string;
+  setPrerequisites(value: string): Assignment;
+
+  getPrerequisitepolicy(): Assignment.PrerequisitePolicy;
+  setPrerequisitepolicy(value: Assignment.PrerequisitePolicy): Assignment;
+
+  getArchived(): boolean;
+  setArchived(value: boolean): Assignment;
+
+  getTestscommit(): string;
+  setTestscommit(value: string): Assignment;
+
+  getScripttemplate(): string;
+  setScripttemplate(value: string): Assignment;
+
+  getAnalyzers(): string;
+  setAnalyzers(value: string): Assignment;
+
+  getCoverage(): string;
+  setCoverage(value: string): Assignment;
+
+  getArtifactsdir(): string;
+  setArtifactsdir(value: string): Assignment;
+
+  getReruns(): number;
+  setReruns(value: number): Assignment;
+
+  getRunnerlabels(): string;
+  setRunnerlabels(value: string): Assignment;
+
+  getBenchmarks(): string;
+  setBenchmarks(value: string): Assignment;
+
+  getLeaderboard(): boolean;
+  setLeaderboard(value: boolean): Assignment;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Assignment.AsObject;
   static toObject(includeInstance: boolean, msg: Assignment): Assignment.AsObject;
@@ -618,6 +676,25 @@ export namespace Assignment {
     submissionsList: Array<Submission.AsObject>,
     gradingbenchmarksList: Array<GradingBenchmark.AsObject>,
     containertimeout: number,
+    releasedate: string,
+    hidden: boolean,
+    prerequisites: string,
+    prerequisitepolicy: Assignment.PrerequisitePolicy,
+    archived: boolean,
+    testscommit: string,
+    scripttemplate: string,
+    analyzers: string,
+    coverage: string,
+    artifactsdir: string,
+    reruns: number,
+    runnerlabels: string,
+    benchmarks: string,
+    leaderboard: boolean,
+  }
+
+  export enum PrerequisitePolicy { 
+    BLOCK_TESTS = 0,
+    BLOCK_APPROVAL = 1,
   }
 }
 
@@ -641,6 +718,50 @@ export namespace Assignments {
   }
 }
 
+export class AssignmentChanges extends jspb.Message {
+  getAddedList(): Array<string>;
+  setAddedList(value: Array<string>): AssignmentChanges;
+  clearAddedList(): AssignmentChanges;
+  addAdded(value: string, index?: number): AssignmentChanges;
+
+  getUpdatedList(): Array<string>;
+  setUpdatedList(value: Array<string>): AssignmentChanges;
+  clearUpdatedList(): AssignmentChanges;
+  addUpdated(value: string, index?: number): AssignmentChanges;
+
+  getRenamedMap(): jspb.Map<string, string>;
+  clearRenamedMap(): AssignmentChanges;
+
+  getArchivedList(): Array<string>;
+  setArchivedList(value: Array<string>): AssignmentChanges;
+  clearArchivedList(): AssignmentChanges;
+  addArchived(value: string, index?: number): AssignmentChanges;
+
+  getImage(): string;
+  setImage(value: string): AssignmentChanges;
+
+  getImageerror(): string;
+  setImageerror(value: string): AssignmentChanges;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentChanges.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentChanges): AssignmentChanges.AsObject;
+  static serializeBinaryToWriter(message: AssignmentChanges, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentChanges;
+  static deserializeBinaryFromReader(message: AssignmentChanges, reader: jspb.BinaryReader): AssignmentChanges;
+}
+
+export namespace AssignmentChanges {
+  export type AsObject = {
+    addedList: Array<string>,
+    updatedList: Array<string>,
+    renamedMap: Array<[string, string]>,
+    archivedList: Array<string>,
+    image: string,
+    imageerror: string,
+  }
+}
+
 export class Submission extends jspb.Message {
   getId(): number;
   setId(value: number): Submission;
@@ -680,6 +801,11 @@ export class Submission extends jspb.Message {
   clearReviewsList(): Submission;
   addReviews(value?: Review, index?: number): Review;
 
+  getTestsList(): Array<TestResult>;
+  setTestsList(value: Array<TestResult>): Submission;
+  clearTestsList(): Submission;
+  addTests(value?: TestResult, index?: number): TestResult;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Submission.AsObject;
   static toObject(includeInstance: boolean, msg: Submission): Submission.AsObject;
@@ -702,6 +828,7 @@ export namespace Submission {
     status: Submission.Status,
     approveddate: string,
     reviewsList: Array<Review.AsObject>,
+    testsList: Array<TestResult.AsObject>,
   }
 
   export enum Status { 
@@ -712,6 +839,80 @@ export namespace Submission {
   }
 }
 
+export class TestResult extends jspb.Message {
+  getTestname(): string;
+  setTestname(value: string): TestResult;
+
+  getScore(): number;
+  setScore(value: number): TestResult;
+
+  getMaxscore(): number;
+  setMaxscore(value: number): TestResult;
+
+  getWeight(): number;
+  setWeight(value: number): TestResult;
+
+  getDuration(): number;
+  setDuration(value: number): TestResult;
+
+  getFailure(): string;
+  setFailure(value: string): TestResult;
+
+  getExpected(): string;
+  setExpected(value: string): TestResult;
+
+  getActual(): string;
+  setActual(value: string): TestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestResult.AsObject;
+  static toObject(includeInstance: boolean, msg: TestResult): TestResult.AsObject;
+  static serializeBinaryToWriter(message: TestResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestResult;
+  static deserializeBinaryFromReader(message: TestResult, reader: jspb.BinaryReader): TestResult;
+}
+
+export namespace TestResult {
+  export type AsObject = {
+    testname: string,
+    score: number,
+    maxscore: number,
+    weight: number,
+    duration: number,
+    failure: string,
+    expected: string,
+    actual: string,
+  }
+}
+
+export class BuildLog extends jspb.Message {
+  getId(): number;
+  setId(value: number): BuildLog;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): BuildLog;
+
+  getLog(): Uint8Array | string;
+  getLog_asU8(): Uint8Array;
+  getLog_asB64(): string;
+  setLog(value: Uint8Array | string): BuildLog;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLog.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLog): BuildLog.AsObject;
+  static serializeBinaryToWriter(message: BuildLog, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLog;
+  static deserializeBinaryFromReader(message: BuildLog, reader: jspb.BinaryReader): BuildLog;
+}
+
+export namespace BuildLog {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    log: Uint8Array | string,
+  }
+}
+
 export class Submissions extends jspb.Message {
   getSubmissionsList(): Array<Submission>;
   setSubmissionsList(value: Array<Submission>): Submissions;
@@ -900,6 +1101,134 @@ export namespace Reviewers {
   }
 }
 
+export class Regrade extends jspb.Message {
+  getId(): number;
+  setId(value: number): Regrade;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): Regrade;
+
+  getUserid(): number;
+  setUserid(value: number): Regrade;
+
+  getReason(): string;
+  setReason(value: string): Regrade;
+
+  getTestname(): string;
+  setTestname(value: string): Regrade;
+
+  getState(): Regrade.State;
+  setState(value: Regrade.State): Regrade;
+
+  getCreateddate(): string;
+  setCreateddate(value: string): Regrade;
+
+  getResolverid(): number;
+  setResolverid(value: number): Regrade;
+
+  getResolveddate(): string;
+  setResolveddate(value: string): Regrade;
+
+  getResponse(): string;
+  setResponse(value: string): Regrade;
+
+  getScoreupdatesList(): Array<ScoreUpdate>;
+  setScoreupdatesList(value: Array<ScoreUpdate>): Regrade;
+  clearScoreupdatesList(): Regrade;
+  addScoreupdates(value?: ScoreUpdate, index?: number): ScoreUpdate;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Regrade.AsObject;
+  static toObject(includeInstance: boolean, msg: Regrade): Regrade.AsObject;
+  static serializeBinaryToWriter(message: Regrade, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Regrade;
+  static deserializeBinaryFromReader(message: Regrade, reader: jspb.BinaryReader): Regrade;
+}
+
+export namespace Regrade {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    userid: number,
+    reason: string,
+    testname: string,
+    state: Regrade.State,
+    createddate: string,
+    resolverid: number,
+    resolveddate: string,
+    response: string,
+    scoreupdatesList: Array<ScoreUpdate.AsObject>,
+  }
+
+  export enum State { 
+    OPEN = 0,
+    ACCEPTED = 1,
+    REJECTED = 2,
+  }
+}
+
+export class ScoreUpdate extends jspb.Message {
+  getId(): number;
+  setId(value: number): ScoreUpdate;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): ScoreUpdate;
+
+  getRegradeid(): number;
+  setRegradeid(value: number): ScoreUpdate;
+
+  getUpdatedbyid(): number;
+  setUpdatedbyid(value: number): ScoreUpdate;
+
+  getOldscore(): number;
+  setOldscore(value: number): ScoreUpdate;
+
+  getNewscore(): number;
+  setNewscore(value: number): ScoreUpdate;
+
+  getUpdateddate(): string;
+  setUpdateddate(value: string): ScoreUpdate;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ScoreUpdate.AsObject;
+  static toObject(includeInstance: boolean, msg: ScoreUpdate): ScoreUpdate.AsObject;
+  static serializeBinaryToWriter(message: ScoreUpdate, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScoreUpdate;
+  static deserializeBinaryFromReader(message: ScoreUpdate, reader: jspb.BinaryReader): ScoreUpdate;
+}
+
+export namespace ScoreUpdate {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    regradeid: number,
+    updatedbyid: number,
+    oldscore: number,
+    newscore: number,
+    updateddate: string,
+  }
+}
+
+export class Regrades extends jspb.Message {
+  getRegradesList(): Array<Regrade>;
+  setRegradesList(value: Array<Regrade>): Regrades;
+  clearRegradesList(): Regrades;
+  addRegrades(value?: Regrade, index?: number): Regrade;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Regrades.AsObject;
+  static toObject(includeInstance: boolean, msg: Regrades): Regrades.AsObject;
+  static serializeBinaryToWriter(message: Regrades, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Regrades;
+  static deserializeBinaryFromReader(message: Regrades, reader: jspb.BinaryReader): Regrades;
+}
+
+export namespace Regrades {
+  export type AsObject = {
+    regradesList: Array<Regrade.AsObject>,
+  }
+}
+
 export class ReviewRequest extends jspb.Message {
   getCourseid(): number;
   setCourseid(value: number): ReviewRequest;
@@ -1425,6 +1754,12 @@ export class RebuildRequest extends jspb.Message {
   getAssignmentid(): number;
   setAssignmentid(value: number): RebuildRequest;
 
+  getLatesttests(): boolean;
+  setLatesttests(value: boolean): RebuildRequest;
+
+  getNocache(): boolean;
+  setNocache(value: boolean): RebuildRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RebuildRequest.AsObject;
   static toObject(includeInstance: boolean, msg: RebuildRequest): RebuildRequest.AsObject;
@@ -1437,6 +1772,474 @@ export namespace RebuildRequest {
   export type AsObject = {
     submissionid: number,
     assignmentid: number,
+    latesttests: boolean,
+    nocache: boolean,
+  }
+}
+
+export class AssignmentRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): AssignmentRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AssignmentRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentRequest): AssignmentRequest.AsObject;
+  static serializeBinaryToWriter(message: AssignmentRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentRequest;
+  static deserializeBinaryFromReader(message: AssignmentRequest, reader: jspb.BinaryReader): AssignmentRequest;
+}
+
+export namespace AssignmentRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+  }
+}
+
+export class RebuildAssignmentRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): RebuildAssignmentRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): RebuildAssignmentRequest;
+
+  getStatusesList(): Array<Submission.Status>;
+  setStatusesList(value: Array<Submission.Status>): RebuildAssignmentRequest;
+  clearStatusesList(): RebuildAssignmentRequest;
+  addStatuses(value: Submission.Status, index?: number): RebuildAssignmentRequest;
+
+  getScorebelow(): number;
+  setScorebelow(value: number): RebuildAssignmentRequest;
+
+  getLatesttests(): boolean;
+  setLatesttests(value: boolean): RebuildAssignmentRequest;
+
+  getNocache(): boolean;
+  setNocache(value: boolean): RebuildAssignmentRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RebuildAssignmentRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RebuildAssignmentRequest): RebuildAssignmentRequest.AsObject;
+  static serializeBinaryToWriter(message: RebuildAssignmentRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RebuildAssignmentRequest;
+  static deserializeBinaryFromReader(message: RebuildAssignmentRequest, reader: jspb.BinaryReader): RebuildAssignmentRequest;
+}
+
+export namespace RebuildAssignmentRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    statusesList: Array<Submission.Status>,
+    scorebelow: number,
+    latesttests: boolean,
+    nocache: boolean,
+  }
+}
+
+export class ScoreChange extends jspb.Message {
+  getSubmissionid(): number;
+  setSubmissionid(value: number): ScoreChange;
+
+  getUserid(): number;
+  setUserid(value: number): ScoreChange;
+
+  getGroupid(): number;
+  setGroupid(value: number): ScoreChange;
+
+  getOldscore(): number;
+  setOldscore(value: number): ScoreChange;
+
+  getNewscore(): number;
+  setNewscore(value: number): ScoreChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ScoreChange.AsObject;
+  static toObject(includeInstance: boolean, msg: ScoreChange): ScoreChange.AsObject;
+  static serializeBinaryToWriter(message: ScoreChange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScoreChange;
+  static deserializeBinaryFromReader(message: ScoreChange, reader: jspb.BinaryReader): ScoreChange;
+}
+
+export namespace ScoreChange {
+  export type AsObject = {
+    submissionid: number,
+    userid: number,
+    groupid: number,
+    oldscore: number,
+    newscore: number,
+  }
+}
+
+export class AssignmentRebuild extends jspb.Message {
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AssignmentRebuild;
+
+  getTotal(): number;
+  setTotal(value: number): AssignmentRebuild;
+
+  getRebuilt(): number;
+  setRebuilt(value: number): AssignmentRebuild;
+
+  getFailed(): number;
+  setFailed(value: number): AssignmentRebuild;
+
+  getDone(): boolean;
+  setDone(value: boolean): AssignmentRebuild;
+
+  getIncreased(): number;
+  setIncreased(value: number): AssignmentRebuild;
+
+  getDecreased(): number;
+  setDecreased(value: number): AssignmentRebuild;
+
+  getScorechangesList(): Array<ScoreChange>;
+  setScorechangesList(value: Array<ScoreChange>): AssignmentRebuild;
+  clearScorechangesList(): AssignmentRebuild;
+  addScorechanges(value?: ScoreChange, index?: number): ScoreChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentRebuild.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentRebuild): AssignmentRebuild.AsObject;
+  static serializeBinaryToWriter(message: AssignmentRebuild, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentRebuild;
+  static deserializeBinaryFromReader(message: AssignmentRebuild, reader: jspb.BinaryReader): AssignmentRebuild;
+}
+
+export namespace AssignmentRebuild {
+  export type AsObject = {
+    assignmentid: number,
+    total: number,
+    rebuilt: number,
+    failed: number,
+    done: boolean,
+    increased: number,
+    decreased: number,
+    scorechangesList: Array<ScoreChange.AsObject>,
+  }
+}
+
+export class TestOutcome extends jspb.Message {
+  getId(): number;
+  setId(value: number): TestOutcome;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): TestOutcome;
+
+  getCommithash(): string;
+  setCommithash(value: string): TestOutcome;
+
+  getTestname(): string;
+  setTestname(value: string): TestOutcome;
+
+  getRuns(): number;
+  setRuns(value: number): TestOutcome;
+
+  getPasses(): number;
+  setPasses(value: number): TestOutcome;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestOutcome.AsObject;
+  static toObject(includeInstance: boolean, msg: TestOutcome): TestOutcome.AsObject;
+  static serializeBinaryToWriter(message: TestOutcome, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestOutcome;
+  static deserializeBinaryFromReader(message: TestOutcome, reader: jspb.BinaryReader): TestOutcome;
+}
+
+export namespace TestOutcome {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    commithash: string,
+    testname: string,
+    runs: number,
+    passes: number,
+  }
+}
+
+export class TestStat extends jspb.Message {
+  getTestname(): string;
+  setTestname(value: string): TestStat;
+
+  getRuns(): number;
+  setRuns(value: number): TestStat;
+
+  getPasses(): number;
+  setPasses(value: number): TestStat;
+
+  getCommits(): number;
+  setCommits(value: number): TestStat;
+
+  getFlakycommits(): number;
+  setFlakycommits(value: number): TestStat;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestStat.AsObject;
+  static toObject(includeInstance: boolean, msg: TestStat): TestStat.AsObject;
+  static serializeBinaryToWriter(message: TestStat, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestStat;
+  static deserializeBinaryFromReader(message: TestStat, reader: jspb.BinaryReader): TestStat;
+}
+
+export namespace TestStat {
+  export type AsObject = {
+    testname: string,
+    runs: number,
+    passes: number,
+    commits: number,
+    flakycommits: number,
+  }
+}
+
+export class TestStats extends jspb.Message {
+  getAssignmentid(): number;
+  setAssignmentid(value: number): TestStats;
+
+  getStatsList(): Array<TestStat>;
+  setStatsList(value: Array<TestStat>): TestStats;
+  clearStatsList(): TestStats;
+  addStats(value?: TestStat, index?: number): TestStat;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestStats.AsObject;
+  static toObject(includeInstance: boolean, msg: TestStats): TestStats.AsObject;
+  static serializeBinaryToWriter(message: TestStats, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestStats;
+  static deserializeBinaryFromReader(message: TestStats, reader: jspb.BinaryReader): TestStats;
+}
+
+export namespace TestStats {
+  export type AsObject = {
+    assignmentid: number,
+    statsList: Array<TestStat.AsObject>,
+  }
+}
+
+export class ArtifactsRequest extends jspb.Message {
+  getSubmissionid(): number;
+  setSubmissionid(value: number): ArtifactsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ArtifactsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ArtifactsRequest): ArtifactsRequest.AsObject;
+  static serializeBinaryToWriter(message: ArtifactsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ArtifactsRequest;
+  static deserializeBinaryFromReader(message: ArtifactsRequest, reader: jspb.BinaryReader): ArtifactsRequest;
+}
+
+export namespace ArtifactsRequest {
+  export type AsObject = {
+    submissionid: number,
+  }
+}
+
+export class Leaderboard extends jspb.Message {
+  getAssignmentid(): number;
+  setAssignmentid(value: number): Leaderboard;
+
+  getEntriesList(): Array<LeaderboardEntry>;
+  setEntriesList(value: Array<LeaderboardEntry>): Leaderboard;
+  clearEntriesList(): Leaderboard;
+  addEntries(value?: LeaderboardEntry, index?: number): LeaderboardEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Leaderboard.AsObject;
+  static toObject(includeInstance: boolean, msg: Leaderboard): Leaderboard.AsObject;
+  static serializeBinaryToWriter(message: Leaderboard, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Leaderboard;
+  static deserializeBinaryFromReader(message: Leaderboard, reader: jspb.BinaryReader): Leaderboard;
+}
+
+export namespace Leaderboard {
+  export type AsObject = {
+    assignmentid: number,
+    entriesList: Array<LeaderboardEntry.AsObject>,
+  }
+}
+
+export class LeaderboardEntry extends jspb.Message {
+  getBenchmark(): string;
+  setBenchmark(value: string): LeaderboardEntry;
+
+  getRank(): number;
+  setRank(value: number): LeaderboardEntry;
+
+  getNsperop(): number;
+  setNsperop(value: number): LeaderboardEntry;
+
+  getAllocsperop(): number;
+  setAllocsperop(value: number): LeaderboardEntry;
+
+  getBytesperop(): number;
+  setBytesperop(value: number): LeaderboardEntry;
+
+  getOwn(): boolean;
+  setOwn(value: boolean): LeaderboardEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LeaderboardEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: LeaderboardEntry): LeaderboardEntry.AsObject;
+  static serializeBinaryToWriter(message: LeaderboardEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LeaderboardEntry;
+  static deserializeBinaryFromReader(message: LeaderboardEntry, reader: jspb.BinaryReader): LeaderboardEntry;
+}
+
+export namespace LeaderboardEntry {
+  export type AsObject = {
+    benchmark: string,
+    rank: number,
+    nsperop: number,
+    allocsperop: number,
+    bytesperop: number,
+    own: boolean,
+  }
+}
+
+export class RegradesRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): RegradesRequest;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): RegradesRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RegradesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RegradesRequest): RegradesRequest.AsObject;
+  static serializeBinaryToWriter(message: RegradesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RegradesRequest;
+  static deserializeBinaryFromReader(message: RegradesRequest, reader: jspb.BinaryReader): RegradesRequest;
+}
+
+export namespace RegradesRequest {
+  export type AsObject = {
+    courseid: number,
+    submissionid: number,
+  }
+}
+
+export class UpdateRegradeRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): UpdateRegradeRequest;
+
+  getRegradeid(): number;
+  setRegradeid(value: number): UpdateRegradeRequest;
+
+  getState(): Regrade.State;
+  setState(value: Regrade.State): UpdateRegradeRequest;
+
+  getResponse(): string;
+  setResponse(value: string): UpdateRegradeRequest;
+
+  getScore(): number;
+  setScore(value: number): UpdateRegradeRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateRegradeRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateRegradeRequest): UpdateRegradeRequest.AsObject;
+  static serializeBinaryToWriter(message: UpdateRegradeRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateRegradeRequest;
+  static deserializeBinaryFromReader(message: UpdateRegradeRequest, reader: jspb.BinaryReader): UpdateRegradeRequest;
+}
+
+export namespace UpdateRegradeRequest {
+  export type AsObject = {
+    courseid: number,
+    regradeid: number,
+    state: Regrade.State,
+    response: string,
+    score: number,
+  }
+}
+
+export class BuildLogRequest extends jspb.Message {
+  getSubmissionid(): number;
+  setSubmissionid(value: number): BuildLogRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLogRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLogRequest): BuildLogRequest.AsObject;
+  static serializeBinaryToWriter(message: BuildLogRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLogRequest;
+  static deserializeBinaryFromReader(message: BuildLogRequest, reader: jspb.BinaryReader): BuildLogRequest;
+}
+
+export namespace BuildLogRequest {
+  export type AsObject = {
+    submissionid: number,
+  }
+}
+
+export class CancelBuildRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): CancelBuildRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): CancelBuildRequest;
+
+  getUserid(): number;
+  setUserid(value: number): CancelBuildRequest;
+
+  getGroupid(): number;
+  setGroupid(value: number): CancelBuildRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CancelBuildRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelBuildRequest): CancelBuildRequest.AsObject;
+  static serializeBinaryToWriter(message: CancelBuildRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelBuildRequest;
+  static deserializeBinaryFromReader(message: CancelBuildRequest, reader: jspb.BinaryReader): CancelBuildRequest;
+}
+
+export namespace CancelBuildRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+  }
+}
+
+export class Artifact extends jspb.Message {
+  getName(): string;
+  setName(value: string): Artifact;
+
+  getFilesize(): number;
+  setFilesize(value: number): Artifact;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Artifact.AsObject;
+  static toObject(includeInstance: boolean, msg: Artifact): Artifact.AsObject;
+  static serializeBinaryToWriter(message: Artifact, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Artifact;
+  static deserializeBinaryFromReader(message: Artifact, reader: jspb.BinaryReader): Artifact;
+}
+
+export namespace Artifact {
+  export type AsObject = {
+    name: string,
+    filesize: number,
+  }
+}
+
+export class Artifacts extends jspb.Message {
+  getArtifactsList(): Array<Artifact>;
+  setArtifactsList(value: Array<Artifact>): Artifacts;
+  clearArtifactsList(): Artifacts;
+  addArtifacts(value?: Artifact, index?: number): Artifact;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Artifacts.AsObject;
+  static toObject(includeInstance: boolean, msg: Artifacts): Artifacts.AsObject;
+  static serializeBinaryToWriter(message: Artifacts, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Artifacts;
+  static deserializeBinaryFromReader(message: Artifacts, reader: jspb.BinaryReader): Artifacts;
+}
+
+export namespace Artifacts {
+  export type AsObject = {
+    artifactsList: Array<Artifact.AsObject>,
   }
 }
 
@@ -1488,6 +2291,90 @@ export namespace LoadCriteriaRequest {
   }
 }
 
+export class TestsValidationRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): TestsValidationRequest;
+
+  getSolutionsrepo(): string;
+  setSolutionsrepo(value: string): TestsValidationRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestsValidationRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TestsValidationRequest): TestsValidationRequest.AsObject;
+  static serializeBinaryToWriter(message: TestsValidationRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestsValidationRequest;
+  static deserializeBinaryFromReader(message: TestsValidationRequest, reader: jspb.BinaryReader): TestsValidationRequest;
+}
+
+export namespace TestsValidationRequest {
+  export type AsObject = {
+    courseid: number,
+    solutionsrepo: string,
+  }
+}
+
+export class AssignmentValidation extends jspb.Message {
+  getName(): string;
+  setName(value: string): AssignmentValidation;
+
+  getErrorsList(): Array<string>;
+  setErrorsList(value: Array<string>): AssignmentValidation;
+  clearErrorsList(): AssignmentValidation;
+  addErrors(value: string, index?: number): AssignmentValidation;
+
+  getTested(): boolean;
+  setTested(value: boolean): AssignmentValidation;
+
+  getScore(): number;
+  setScore(value: number): AssignmentValidation;
+
+  getScorelimit(): number;
+  setScorelimit(value: number): AssignmentValidation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentValidation.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentValidation): AssignmentValidation.AsObject;
+  static serializeBinaryToWriter(message: AssignmentValidation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentValidation;
+  static deserializeBinaryFromReader(message: AssignmentValidation, reader: jspb.BinaryReader): AssignmentValidation;
+}
+
+export namespace AssignmentValidation {
+  export type AsObject = {
+    name: string,
+    errorsList: Array<string>,
+    tested: boolean,
+    score: number,
+    scorelimit: number,
+  }
+}
+
+export class TestsValidation extends jspb.Message {
+  getErrorsList(): Array<string>;
+  setErrorsList(value: Array<string>): TestsValidation;
+  clearErrorsList(): TestsValidation;
+  addErrors(value: string, index?: number): TestsValidation;
+
+  getAssignmentsList(): Array<AssignmentValidation>;
+  setAssignmentsList(value: Array<AssignmentValidation>): TestsValidation;
+  clearAssignmentsList(): TestsValidation;
+  addAssignments(value?: AssignmentValidation, index?: number): AssignmentValidation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestsValidation.AsObject;
+  static toObject(includeInstance: boolean, msg: TestsValidation): TestsValidation.AsObject;
+  static serializeBinaryToWriter(message: TestsValidation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestsValidation;
+  static deserializeBinaryFromReader(message: TestsValidation, reader: jspb.BinaryReader): TestsValidation;
+}
+
+export namespace TestsValidation {
+  export type AsObject = {
+    errorsList: Array<string>,
+    assignmentsList: Array<AssignmentValidation.AsObject>,
+  }
+}
+
 export class Void extends jspb.Message {
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Void.AsObject;
@@ -12,10 +12,21 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = Function('return this')();
 
+goog.exportSymbol('proto.Artifact', null, global);
+goog.exportSymbol('proto.Artifacts', null, global);
+goog.exportSymbol('proto.ArtifactsRequest', null, global);
 goog.exportSymbol('proto.Assignment', null, global);
+goog.exportSymbol('proto.Assignment.PrerequisitePolicy', null, global);
+goog.exportSymbol('proto.AssignmentChanges', null, global);
+goog.exportSymbol('proto.AssignmentRebuild', null, global);
+goog.exportSymbol('proto.AssignmentRequest', null, global);
+goog.exportSymbol('proto.AssignmentValidation', null, global);
 goog.exportSymbol('proto.Assignments', null, global);
 goog.exportSymbol('proto.AuthorizationResponse', null, global);
 goog.exportSymbol('proto.Benchmarks', null, global);
+goog.exportSymbol('proto.BuildLog', null, global);
+goog.exportSymbol('proto.BuildLogRequest', null, global);
+goog.exportSymbol('proto.CancelBuildRequest', null, global);
 goog.exportSymbol('proto.Course', null, global);
 goog.exportSymbol('proto.CourseRequest', null, global);
 goog.exportSymbol('proto.CourseSubmissions', null, global);
@@ -36,13 +47,20 @@ goog.exportSymbol('proto.Group', null, global);
 goog.exportSymbol('proto.Group.GroupStatus', null, global);
 goog.exportSymbol('proto.GroupRequest', null, global);
 goog.exportSymbol('proto.Groups', null, global);
+goog.exportSymbol('proto.Leaderboard', null, global);
+goog.exportSymbol('proto.LeaderboardEntry', null, global);
 goog.exportSymbol('proto.LoadCriteriaRequest', null, global);
 goog.exportSymbol('proto.OrgRequest', null, global);
 goog.exportSymbol('proto.Organization', null, global);
 goog.exportSymbol('proto.Organizations', null, global);
 goog.exportSymbol('proto.Provider', null, global);
 goog.exportSymbol('proto.Providers', null, global);
+goog.exportSymbol('proto.RebuildAssignmentRequest', null, global);
 goog.exportSymbol('proto.RebuildRequest', null, global);
+goog.exportSymbol('proto.Regrade', null, global);
+goog.exportSymbol('proto.Regrade.State', null, global);
+goog.exportSymbol('proto.Regrades', null, global);
+goog.exportSymbol('proto.RegradesRequest', null, global);
 goog.exportSymbol('proto.RemoteIdentity', null, global);
 goog.exportSymbol('proto.Repositories', null, global);
 goog.exportSymbol('proto.Repository', null, global);
@@ -51,6 +69,8 @@ goog.exportSymbol('proto.RepositoryRequest', null, global);
 goog.exportSymbol('proto.Review', null, global);
 goog.exportSymbol('proto.ReviewRequest', null, global);
 goog.exportSymbol('proto.Reviewers', null, global);
+goog.exportSymbol('proto.ScoreChange', null, global);
+goog.exportSymbol('proto.ScoreUpdate', null, global);
 goog.exportSymbol('proto.Status', null, global);
 goog.exportSymbol('proto.Submission', null, global);
 goog.exportSymbol('proto.Submission.Status', null, global);
@@ -60,7 +80,14 @@ goog.exportSymbol('proto.SubmissionReviewersRequest', null, global);
 goog.exportSymbol('proto.Submissions', null, global);
 goog.exportSymbol('proto.SubmissionsForCourseRequest', null, global);
 goog.exportSymbol('proto.SubmissionsForCourseRequest.Type', null, global);
+goog.exportSymbol('proto.TestOutcome', null, global);
+goog.exportSymbol('proto.TestResult', null, global);
+goog.exportSymbol('proto.TestStat', null, global);
+goog.exportSymbol('proto.TestStats', null, global);
+goog.exportSymbol('proto.TestsValidation', null, global);
+goog.exportSymbol('proto.TestsValidationRequest', null, global);
 goog.exportSymbol('proto.URLRequest', null, global);
+goog.exportSymbol('proto.UpdateRegradeRequest', null, global);
 goog.exportSymbol('proto.UpdateSubmissionRequest', null, global);
 goog.exportSymbol('proto.UpdateSubmissionsRequest', null, global);
 goog.exportSymbol('proto.UsedSlipDays', null, global);
@@ -404,6 +431,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.Assignments.displayName = 'proto.Assignments';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AssignmentChanges = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.AssignmentChanges.repeatedFields_, null);
+};
+goog.inherits(proto.AssignmentChanges, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AssignmentChanges.displayName = 'proto.AssignmentChanges';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -425,6 +473,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.Submission.displayName = 'proto.Submission';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.TestResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestResult.displayName = 'proto.TestResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.BuildLog = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.BuildLog, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.BuildLog.displayName = 'proto.BuildLog';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -551,6 +641,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.Reviewers.displayName = 'proto.Reviewers';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Regrade = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Regrade.repeatedFields_, null);
+};
+goog.inherits(proto.Regrade, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Regrade.displayName = 'proto.Regrade';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ScoreUpdate = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ScoreUpdate, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ScoreUpdate.displayName = 'proto.ScoreUpdate';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Regrades = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Regrades.repeatedFields_, null);
+};
+goog.inherits(proto.Regrades, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Regrades.displayName = 'proto.Regrades';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1044,16 +1197,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.CourseUserRequest = function(opt_data) {
+proto.AssignmentRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.CourseUserRequest, jspb.Message);
+goog.inherits(proto.AssignmentRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.CourseUserRequest.displayName = 'proto.CourseUserRequest';
+  proto.AssignmentRequest.displayName = 'proto.AssignmentRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1065,16 +1218,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.LoadCriteriaRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.RebuildAssignmentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.RebuildAssignmentRequest.repeatedFields_, null);
 };
-goog.inherits(proto.LoadCriteriaRequest, jspb.Message);
+goog.inherits(proto.RebuildAssignmentRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.LoadCriteriaRequest.displayName = 'proto.LoadCriteriaRequest';
+  proto.RebuildAssignmentRequest.displayName = 'proto.RebuildAssignmentRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1086,140 +1239,7098 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.Void = function(opt_data) {
+proto.ScoreChange = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.Void, jspb.Message);
+goog.inherits(proto.ScoreChange, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.Void.displayName = 'proto.Void';
+  proto.ScoreChange.displayName = 'proto.ScoreChange';
 }
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.User.repeatedFields_ = [8,9];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
+proto.AssignmentRebuild = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.AssignmentRebuild.repeatedFields_, null);
+};
+goog.inherits(proto.AssignmentRebuild, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AssignmentRebuild.displayName = 'proto.AssignmentRebuild';
+}
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.User.prototype.toObject = function(opt_includeInstance) {
-  return proto.User.toObject(opt_includeInstance, this);
+proto.TestOutcome = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.TestOutcome, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestOutcome.displayName = 'proto.TestOutcome';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.User} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.User.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
-    proto.RemoteIdentity.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.TestStat = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.TestStat, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestStat.displayName = 'proto.TestStat';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.User}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.User.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.User;
-  return proto.User.deserializeBinaryFromReader(msg, reader);
+proto.TestStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.TestStats.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.TestStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestStats.displayName = 'proto.TestStats';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.User} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.User}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.User.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+proto.ArtifactsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ArtifactsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ArtifactsRequest.displayName = 'proto.ArtifactsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Leaderboard = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Leaderboard.repeatedFields_, null);
+};
+goog.inherits(proto.Leaderboard, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Leaderboard.displayName = 'proto.Leaderboard';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.LeaderboardEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.LeaderboardEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.LeaderboardEntry.displayName = 'proto.LeaderboardEntry';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.RegradesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.RegradesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.RegradesRequest.displayName = 'proto.RegradesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.UpdateRegradeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.UpdateRegradeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.UpdateRegradeRequest.displayName = 'proto.UpdateRegradeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.BuildLogRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.BuildLogRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.BuildLogRequest.displayName = 'proto.BuildLogRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.CancelBuildRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.CancelBuildRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.CancelBuildRequest.displayName = 'proto.CancelBuildRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Artifact = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Artifact, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Artifact.displayName = 'proto.Artifact';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Artifacts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Artifacts.repeatedFields_, null);
+};
+goog.inherits(proto.Artifacts, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Artifacts.displayName = 'proto.Artifacts';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.CourseUserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.CourseUserRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.CourseUserRequest.displayName = 'proto.CourseUserRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.LoadCriteriaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.LoadCriteriaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.LoadCriteriaRequest.displayName = 'proto.LoadCriteriaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestsValidationRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.TestsValidationRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestsValidationRequest.displayName = 'proto.TestsValidationRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.AssignmentValidation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.AssignmentValidation.repeatedFields_, null);
+};
+goog.inherits(proto.AssignmentValidation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.AssignmentValidation.displayName = 'proto.AssignmentValidation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestsValidation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.TestsValidation.repeatedFields_, null);
+};
+goog.inherits(proto.TestsValidation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestsValidation.displayName = 'proto.TestsValidation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Void = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.Void, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Void.displayName = 'proto.Void';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.User.repeatedFields_ = [8,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.User.prototype.toObject = function(opt_includeInstance) {
+  return proto.User.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.User} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.User.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
+    proto.RemoteIdentity.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.User}
+ */
+proto.User.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.User;
+  return proto.User.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.User} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.User}
+ */
+proto.User.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsadmin(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStudentid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatarurl(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogin(value);
+      break;
+    case 8:
+      var value = new proto.RemoteIdentity;
+      reader.readMessage(value,proto.RemoteIdentity.deserializeBinaryFromReader);
+      msg.addRemoteidentities(value);
+      break;
+    case 9:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.User.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.User.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.User} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.User.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getIsadmin();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStudentid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAvatarurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getLogin();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getRemoteidentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.RemoteIdentity.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.User.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bool isAdmin = 2;
+ * @return {boolean}
+ */
+proto.User.prototype.getIsadmin = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setIsadmin = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.User.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string studentID = 4;
+ * @return {string}
+ */
+proto.User.prototype.getStudentid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setStudentid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string email = 5;
+ * @return {string}
+ */
+proto.User.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string avatarURL = 6;
+ * @return {string}
+ */
+proto.User.prototype.getAvatarurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setAvatarurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string login = 7;
+ * @return {string}
+ */
+proto.User.prototype.getLogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.setLogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated RemoteIdentity remoteIdentities = 8;
+ * @return {!Array<!proto.RemoteIdentity>}
+ */
+proto.User.prototype.getRemoteidentitiesList = function() {
+  return /** @type{!Array<!proto.RemoteIdentity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.RemoteIdentity, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.RemoteIdentity>} value
+ * @return {!proto.User} returns this
+*/
+proto.User.prototype.setRemoteidentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.RemoteIdentity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.RemoteIdentity}
+ */
+proto.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.RemoteIdentity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.clearRemoteidentitiesList = function() {
+  return this.setRemoteidentitiesList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 9;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.User.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.User} returns this
+*/
+proto.User.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.User.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.User} returns this
+ */
+proto.User.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Users.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Users.prototype.toObject = function(opt_includeInstance) {
+  return proto.Users.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Users} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Users.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.User.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Users}
+ */
+proto.Users.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Users;
+  return proto.Users.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Users} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Users}
+ */
+proto.Users.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Users.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Users.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Users} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Users.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated User users = 1;
+ * @return {!Array<!proto.User>}
+ */
+proto.Users.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.User>} value
+ * @return {!proto.Users} returns this
+*/
+proto.Users.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.User}
+ */
+proto.Users.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Users} returns this
+ */
+proto.Users.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
+  return proto.RemoteIdentity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.RemoteIdentity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.RemoteIdentity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.RemoteIdentity}
+ */
+proto.RemoteIdentity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.RemoteIdentity;
+  return proto.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.RemoteIdentity}
+ */
+proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRemoteid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccesstoken(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.RemoteIdentity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.RemoteIdentity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRemoteid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getAccesstoken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.RemoteIdentity.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.RemoteIdentity.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 remoteID = 3;
+ * @return {number}
+ */
+proto.RemoteIdentity.prototype.getRemoteid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setRemoteid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string accessToken = 4;
+ * @return {string}
+ */
+proto.RemoteIdentity.prototype.getAccesstoken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setAccesstoken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 userID = 5;
+ * @return {number}
+ */
+proto.RemoteIdentity.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RemoteIdentity} returns this
+ */
+proto.RemoteIdentity.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Group.repeatedFields_ = [6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Group.prototype.toObject = function(opt_includeInstance) {
+  return proto.Group.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Group} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Group.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.User.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Group}
+ */
+proto.Group.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Group;
+  return proto.Group.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Group} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Group}
+ */
+proto.Group.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTeamid(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.Group.GroupStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 6:
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    case 7:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Group.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Group.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Group} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Group.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getTeamid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Group.GroupStatus = {
+  PENDING: 0,
+  APPROVED: 1
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Group.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.Group.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.Group.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 teamID = 4;
+ * @return {number}
+ */
+proto.Group.prototype.getTeamid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setTeamid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional GroupStatus status = 5;
+ * @return {!proto.Group.GroupStatus}
+ */
+proto.Group.prototype.getStatus = function() {
+  return /** @type {!proto.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.Group.GroupStatus} value
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * repeated User users = 6;
+ * @return {!Array<!proto.User>}
+ */
+proto.Group.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.User, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.User>} value
+ * @return {!proto.Group} returns this
+*/
+proto.Group.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.User}
+ */
+proto.Group.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 7;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.Group.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.Group} returns this
+*/
+proto.Group.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.Group.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Group} returns this
+ */
+proto.Group.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Groups.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Groups.prototype.toObject = function(opt_includeInstance) {
+  return proto.Groups.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Groups} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Groups.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.Group.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Groups}
+ */
+proto.Groups.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Groups;
+  return proto.Groups.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Groups} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Groups}
+ */
+proto.Groups.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Group;
+      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Groups.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Groups.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Groups} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Groups.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Group.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Group groups = 1;
+ * @return {!Array<!proto.Group>}
+ */
+proto.Groups.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Group, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Group>} value
+ * @return {!proto.Groups} returns this
+*/
+proto.Groups.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Group}
+ */
+proto.Groups.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Groups} returns this
+ */
+proto.Groups.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Course.repeatedFields_ = [12,13,14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Course.prototype.toObject = function(opt_includeInstance) {
+  return proto.Course.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Course} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Course.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    enrolled: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.Assignment.toObject, includeInstance),
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.Group.toObject, includeInstance),
+    templatebranch: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    pushassignments: jspb.Message.getBooleanFieldWithDefault(msg, 16, false),
+    dockerimage: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    dockerimageerror: jspb.Message.getFieldWithDefault(msg, 18, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Course}
+ */
+proto.Course.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Course;
+  return proto.Course.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Course} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Course}
+ */
+proto.Course.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCoursecreatorid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCode(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setYear(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTag(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganizationpath(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdays(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setEnrolled(value);
+      break;
+    case 12:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    case 13:
+      var value = new proto.Assignment;
+      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    case 14:
+      var value = new proto.Group;
+      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTemplatebranch(value);
+      break;
+    case 16:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPushassignments(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDockerimage(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDockerimageerror(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Course.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Course.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Course} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Course.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCoursecreatorid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getYear();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getTag();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getOrganizationpath();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getSlipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getEnrolled();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getTemplatebranch();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getPushassignments();
+  if (f) {
+    writer.writeBool(
+      16,
+      f
+    );
+  }
+  f = message.getDockerimage();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getDockerimageerror();
+  if (f.length > 0) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Course.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseCreatorID = 2;
+ * @return {number}
+ */
+proto.Course.prototype.getCoursecreatorid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setCoursecreatorid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.Course.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string code = 4;
+ * @return {string}
+ */
+proto.Course.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 year = 5;
+ * @return {number}
+ */
+proto.Course.prototype.getYear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setYear = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string tag = 6;
+ * @return {string}
+ */
+proto.Course.prototype.getTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string provider = 7;
+ * @return {string}
+ */
+proto.Course.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 8;
+ * @return {number}
+ */
+proto.Course.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional string organizationPath = 9;
+ * @return {string}
+ */
+proto.Course.prototype.getOrganizationpath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setOrganizationpath = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 slipDays = 10;
+ * @return {number}
+ */
+proto.Course.prototype.getSlipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setSlipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional Enrollment.UserStatus enrolled = 11;
+ * @return {!proto.Enrollment.UserStatus}
+ */
+proto.Course.prototype.getEnrolled = function() {
+  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {!proto.Enrollment.UserStatus} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setEnrolled = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 12;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.Course.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.Course} returns this
+*/
+proto.Course.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.Course.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+/**
+ * repeated Assignment assignments = 13;
+ * @return {!Array<!proto.Assignment>}
+ */
+proto.Course.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.Assignment>} value
+ * @return {!proto.Course} returns this
+*/
+proto.Course.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Assignment}
+ */
+proto.Course.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+/**
+ * repeated Group groups = 14;
+ * @return {!Array<!proto.Group>}
+ */
+proto.Course.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Group, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.Group>} value
+ * @return {!proto.Course} returns this
+*/
+proto.Course.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Group}
+ */
+proto.Course.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+/**
+ * optional string templateBranch = 15;
+ * @return {string}
+ */
+proto.Course.prototype.getTemplatebranch = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setTemplatebranch = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional bool pushAssignments = 16;
+ * @return {boolean}
+ */
+proto.Course.prototype.getPushassignments = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 16, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setPushassignments = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 16, value);
+};
+
+
+/**
+ * optional string dockerImage = 17;
+ * @return {string}
+ */
+proto.Course.prototype.getDockerimage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setDockerimage = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+/**
+ * optional string dockerImageError = 18;
+ * @return {string}
+ */
+proto.Course.prototype.getDockerimageerror = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Course} returns this
+ */
+proto.Course.prototype.setDockerimageerror = function(value) {
+  return jspb.Message.setProto3StringField(this, 18, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Courses.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Courses.prototype.toObject = function(opt_includeInstance) {
+  return proto.Courses.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Courses} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Courses.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
+    proto.Course.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Courses}
+ */
+proto.Courses.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Courses;
+  return proto.Courses.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Courses} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Courses}
+ */
+proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.addCourses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Courses.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Courses.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Courses} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Courses.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCoursesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Course courses = 1;
+ * @return {!Array<!proto.Course>}
+ */
+proto.Courses.prototype.getCoursesList = function() {
+  return /** @type{!Array<!proto.Course>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Course, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Course>} value
+ * @return {!proto.Courses} returns this
+*/
+proto.Courses.prototype.setCoursesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Course=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Course}
+ */
+proto.Courses.prototype.addCourses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Course, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Courses} returns this
+ */
+proto.Courses.prototype.clearCoursesList = function() {
+  return this.setCoursesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Repository.prototype.toObject = function(opt_includeInstance) {
+  return proto.Repository.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Repository} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Repository.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Repository}
+ */
+proto.Repository.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Repository;
+  return proto.Repository.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Repository} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Repository}
+ */
+proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHtmlurl(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.Repository.Type} */ (reader.readEnum());
+      msg.setRepotype(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Repository.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Repository.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Repository} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Repository.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getHtmlurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRepotype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Repository.Type = {
+  NONE: 0,
+  COURSEINFO: 1,
+  ASSIGNMENTS: 2,
+  TESTS: 3,
+  USER: 4,
+  GROUP: 5
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Repository.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 2;
+ * @return {number}
+ */
+proto.Repository.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 repositoryID = 3;
+ * @return {number}
+ */
+proto.Repository.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 userID = 4;
+ * @return {number}
+ */
+proto.Repository.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 groupID = 5;
+ * @return {number}
+ */
+proto.Repository.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string HTMLURL = 6;
+ * @return {string}
+ */
+proto.Repository.prototype.getHtmlurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setHtmlurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional Type repoType = 7;
+ * @return {!proto.Repository.Type}
+ */
+proto.Repository.prototype.getRepotype = function() {
+  return /** @type {!proto.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.Repository.Type} value
+ * @return {!proto.Repository} returns this
+ */
+proto.Repository.prototype.setRepotype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Enrollment.repeatedFields_ = [14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Enrollment.prototype.toObject = function(opt_includeInstance) {
+  return proto.Enrollment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Enrollment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    user: (f = msg.getUser()) && proto.User.toObject(includeInstance, f),
+    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
+    group: (f = msg.getGroup()) && proto.Group.toObject(includeInstance, f),
+    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
+    proto.UsedSlipDays.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Enrollment}
+ */
+proto.Enrollment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Enrollment;
+  return proto.Enrollment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Enrollment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Enrollment}
+ */
+proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasteacherscopes(value);
+      break;
+    case 6:
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    case 7:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 8:
+      var value = new proto.Group;
+      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
+      msg.setGroup(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.Enrollment.DisplayState} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdaysremaining(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastactivitydate(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalapproved(value);
+      break;
+    case 14:
+      var value = new proto.UsedSlipDays;
+      reader.readMessage(value,proto.UsedSlipDays.deserializeBinaryFromReader);
+      msg.addUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Enrollment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Enrollment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Enrollment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getHasteacherscopes();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroup();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getSlipdaysremaining();
+  if (f !== 0) {
+    writer.writeUint32(
+      11,
+      f
+    );
+  }
+  f = message.getLastactivitydate();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getTotalapproved();
+  if (f !== 0) {
+    writer.writeUint64(
+      13,
+      f
+    );
+  }
+  f = message.getUsedslipdaysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.UsedSlipDays.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Enrollment.UserStatus = {
+  NONE: 0,
+  PENDING: 1,
+  STUDENT: 2,
+  TEACHER: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.Enrollment.DisplayState = {
+  UNSET: 0,
+  HIDDEN: 1,
+  VISIBLE: 2,
+  FAVORITE: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool hasTeacherScopes = 5;
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.getHasteacherscopes = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setHasteacherscopes = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional User user = 6;
+ * @return {?proto.User}
+ */
+proto.Enrollment.prototype.getUser = function() {
+  return /** @type{?proto.User} */ (
+    jspb.Message.getWrapperField(this, proto.User, 6));
+};
+
+
+/**
+ * @param {?proto.User|undefined} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearUser = function() {
+  return this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional Course course = 7;
+ * @return {?proto.Course}
+ */
+proto.Enrollment.prototype.getCourse = function() {
+  return /** @type{?proto.Course} */ (
+    jspb.Message.getWrapperField(this, proto.Course, 7));
+};
+
+
+/**
+ * @param {?proto.Course|undefined} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional Group group = 8;
+ * @return {?proto.Group}
+ */
+proto.Enrollment.prototype.getGroup = function() {
+  return /** @type{?proto.Group} */ (
+    jspb.Message.getWrapperField(this, proto.Group, 8));
+};
+
+
+/**
+ * @param {?proto.Group|undefined} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setGroup = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearGroup = function() {
+  return this.setGroup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.Enrollment.prototype.hasGroup = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional UserStatus status = 9;
+ * @return {!proto.Enrollment.UserStatus}
+ */
+proto.Enrollment.prototype.getStatus = function() {
+  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.Enrollment.UserStatus} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional DisplayState state = 10;
+ * @return {!proto.Enrollment.DisplayState}
+ */
+proto.Enrollment.prototype.getState = function() {
+  return /** @type {!proto.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.Enrollment.DisplayState} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional uint32 slipDaysRemaining = 11;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getSlipdaysremaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setSlipdaysremaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional string lastActivityDate = 12;
+ * @return {string}
+ */
+proto.Enrollment.prototype.getLastactivitydate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setLastactivitydate = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional uint64 totalApproved = 13;
+ * @return {number}
+ */
+proto.Enrollment.prototype.getTotalapproved = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.setTotalapproved = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * repeated UsedSlipDays usedSlipDays = 14;
+ * @return {!Array<!proto.UsedSlipDays>}
+ */
+proto.Enrollment.prototype.getUsedslipdaysList = function() {
+  return /** @type{!Array<!proto.UsedSlipDays>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.UsedSlipDays, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.UsedSlipDays>} value
+ * @return {!proto.Enrollment} returns this
+*/
+proto.Enrollment.prototype.setUsedslipdaysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.UsedSlipDays=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.UsedSlipDays}
+ */
+proto.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.UsedSlipDays, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Enrollment} returns this
+ */
+proto.Enrollment.prototype.clearUsedslipdaysList = function() {
+  return this.setUsedslipdaysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
+  return proto.UsedSlipDays.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.UsedSlipDays} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.UsedSlipDays.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.UsedSlipDays}
+ */
+proto.UsedSlipDays.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.UsedSlipDays;
+  return proto.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.UsedSlipDays}
+ */
+proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setEnrollmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.UsedSlipDays.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.UsedSlipDays} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getEnrollmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUsedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 enrollmentID = 2;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getEnrollmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setEnrollmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 3;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 usedSlipDays = 4;
+ * @return {number}
+ */
+proto.UsedSlipDays.prototype.getUsedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UsedSlipDays} returns this
+ */
+proto.UsedSlipDays.prototype.setUsedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Enrollments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Enrollments.prototype.toObject = function(opt_includeInstance) {
+  return proto.Enrollments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Enrollments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Enrollments}
+ */
+proto.Enrollments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Enrollments;
+  return proto.Enrollments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Enrollments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Enrollments}
+ */
+proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Enrollments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Enrollments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Enrollments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Enrollments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Enrollment enrollments = 1;
+ * @return {!Array<!proto.Enrollment>}
+ */
+proto.Enrollments.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Enrollment>} value
+ * @return {!proto.Enrollments} returns this
+*/
+proto.Enrollments.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Enrollment}
+ */
+proto.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Enrollments} returns this
+ */
+proto.Enrollments.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SubmissionLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignment: (f = msg.getAssignment()) && proto.Assignment.toObject(includeInstance, f),
+    submission: (f = msg.getSubmission()) && proto.Submission.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SubmissionLink}
+ */
+proto.SubmissionLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SubmissionLink;
+  return proto.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SubmissionLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SubmissionLink}
+ */
+proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Assignment;
+      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
+      msg.setAssignment(value);
+      break;
+    case 2:
+      var value = new proto.Submission;
+      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
+      msg.setSubmission(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SubmissionLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SubmissionLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SubmissionLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmission();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Submission.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Assignment assignment = 1;
+ * @return {?proto.Assignment}
+ */
+proto.SubmissionLink.prototype.getAssignment = function() {
+  return /** @type{?proto.Assignment} */ (
+    jspb.Message.getWrapperField(this, proto.Assignment, 1));
+};
+
+
+/**
+ * @param {?proto.Assignment|undefined} value
+ * @return {!proto.SubmissionLink} returns this
+*/
+proto.SubmissionLink.prototype.setAssignment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.SubmissionLink} returns this
+ */
+proto.SubmissionLink.prototype.clearAssignment = function() {
+  return this.setAssignment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.SubmissionLink.prototype.hasAssignment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Submission submission = 2;
+ * @return {?proto.Submission}
+ */
+proto.SubmissionLink.prototype.getSubmission = function() {
+  return /** @type{?proto.Submission} */ (
+    jspb.Message.getWrapperField(this, proto.Submission, 2));
+};
+
+
+/**
+ * @param {?proto.Submission|undefined} value
+ * @return {!proto.SubmissionLink} returns this
+*/
+proto.SubmissionLink.prototype.setSubmission = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.SubmissionLink} returns this
+ */
+proto.SubmissionLink.prototype.clearSubmission = function() {
+  return this.setSubmission(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.SubmissionLink.prototype.hasSubmission = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.EnrollmentLink.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.EnrollmentLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollment: (f = msg.getEnrollment()) && proto.Enrollment.toObject(includeInstance, f),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.SubmissionLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.EnrollmentLink}
+ */
+proto.EnrollmentLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.EnrollmentLink;
+  return proto.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.EnrollmentLink}
+ */
+proto.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = new proto.Enrollment;
+      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
+      msg.setEnrollment(value);
+      break;
+    case 3:
+      var value = new proto.SubmissionLink;
+      reader.readMessage(value,proto.SubmissionLink.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.EnrollmentLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.EnrollmentLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollment();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.SubmissionLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Enrollment enrollment = 2;
+ * @return {?proto.Enrollment}
+ */
+proto.EnrollmentLink.prototype.getEnrollment = function() {
+  return /** @type{?proto.Enrollment} */ (
+    jspb.Message.getWrapperField(this, proto.Enrollment, 2));
+};
+
+
+/**
+ * @param {?proto.Enrollment|undefined} value
+ * @return {!proto.EnrollmentLink} returns this
+*/
+proto.EnrollmentLink.prototype.setEnrollment = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.EnrollmentLink} returns this
+ */
+proto.EnrollmentLink.prototype.clearEnrollment = function() {
+  return this.setEnrollment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.EnrollmentLink.prototype.hasEnrollment = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated SubmissionLink submissions = 3;
+ * @return {!Array<!proto.SubmissionLink>}
+ */
+proto.EnrollmentLink.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.SubmissionLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionLink, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.SubmissionLink>} value
+ * @return {!proto.EnrollmentLink} returns this
+*/
+proto.EnrollmentLink.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.SubmissionLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SubmissionLink}
+ */
+proto.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.SubmissionLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentLink} returns this
+ */
+proto.EnrollmentLink.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.CourseSubmissions.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseSubmissions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseSubmissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseSubmissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
+    linksList: jspb.Message.toObjectList(msg.getLinksList(),
+    proto.EnrollmentLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseSubmissions}
+ */
+proto.CourseSubmissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseSubmissions;
+  return proto.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseSubmissions}
+ */
+proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Course;
+      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 2:
+      var value = new proto.EnrollmentLink;
+      reader.readMessage(value,proto.EnrollmentLink.deserializeBinaryFromReader);
+      msg.addLinks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CourseSubmissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseSubmissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getLinksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.EnrollmentLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Course course = 1;
+ * @return {?proto.Course}
+ */
+proto.CourseSubmissions.prototype.getCourse = function() {
+  return /** @type{?proto.Course} */ (
+    jspb.Message.getWrapperField(this, proto.Course, 1));
+};
+
+
+/**
+ * @param {?proto.Course|undefined} value
+ * @return {!proto.CourseSubmissions} returns this
+*/
+proto.CourseSubmissions.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.CourseSubmissions} returns this
+ */
+proto.CourseSubmissions.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.CourseSubmissions.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated EnrollmentLink links = 2;
+ * @return {!Array<!proto.EnrollmentLink>}
+ */
+proto.CourseSubmissions.prototype.getLinksList = function() {
+  return /** @type{!Array<!proto.EnrollmentLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.EnrollmentLink, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.EnrollmentLink>} value
+ * @return {!proto.CourseSubmissions} returns this
+*/
+proto.CourseSubmissions.prototype.setLinksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.EnrollmentLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentLink}
+ */
+proto.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.EnrollmentLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.CourseSubmissions} returns this
+ */
+proto.CourseSubmissions.prototype.clearLinksList = function() {
+  return this.setLinksList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Assignment.repeatedFields_ = [12,13];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Assignment.prototype.toObject = function(opt_includeInstance) {
+  return proto.Assignment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Assignment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    skiptests: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.Submission.toObject, includeInstance),
+    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
+    proto.GradingBenchmark.toObject, includeInstance),
+    containertimeout: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    releasedate: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    hidden: jspb.Message.getBooleanFieldWithDefault(msg, 16, false),
+    prerequisites: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    prerequisitepolicy: jspb.Message.getFieldWithDefault(msg, 18, 0),
+    archived: jspb.Message.getBooleanFieldWithDefault(msg, 19, false),
+    testscommit: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    scripttemplate: jspb.Message.getFieldWithDefault(msg, 21, ""),
+    analyzers: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    coverage: jspb.Message.getFieldWithDefault(msg, 23, ""),
+    artifactsdir: jspb.Message.getFieldWithDefault(msg, 24, ""),
+    reruns: jspb.Message.getFieldWithDefault(msg, 25, 0),
+    runnerlabels: jspb.Message.getFieldWithDefault(msg, 26, ""),
+    benchmarks: jspb.Message.getFieldWithDefault(msg, 27, ""),
+    leaderboard: jspb.Message.getBooleanFieldWithDefault(msg, 28, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Assignment}
+ */
+proto.Assignment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Assignment;
+  return proto.Assignment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Assignment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Assignment}
+ */
+proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScriptfile(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAutoapprove(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOrder(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsgrouplab(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReviewers(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSkiptests(value);
+      break;
+    case 12:
+      var value = new proto.Submission;
+      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    case 13:
+      var value = new proto.GradingBenchmark;
+      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addGradingbenchmarks(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setContainertimeout(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReleasedate(value);
+      break;
+    case 16:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHidden(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrerequisites(value);
+      break;
+    case 18:
+      var value = /** @type {!proto.Assignment.PrerequisitePolicy} */ (reader.readEnum());
+      msg.setPrerequisitepolicy(value);
+      break;
+    case 19:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setArchived(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestscommit(value);
+      break;
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScripttemplate(value);
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAnalyzers(value);
+      break;
+    case 23:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCoverage(value);
+      break;
+    case 24:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArtifactsdir(value);
+      break;
+    case 25:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReruns(value);
+      break;
+    case 26:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunnerlabels(value);
+      break;
+    case 27:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBenchmarks(value);
+      break;
+    case 28:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLeaderboard(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Assignment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Assignment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Assignment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getScriptfile();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAutoapprove();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getOrder();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getIsgrouplab();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      9,
+      f
+    );
+  }
+  f = message.getReviewers();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getSkiptests();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.Submission.serializeBinaryToWriter
+    );
+  }
+  f = message.getGradingbenchmarksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.GradingBenchmark.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainertimeout();
+  if (f !== 0) {
+    writer.writeUint32(
+      14,
+      f
+    );
+  }
+  f = message.getReleasedate();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getHidden();
+  if (f) {
+    writer.writeBool(
+      16,
+      f
+    );
+  }
+  f = message.getPrerequisites();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getPrerequisitepolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      18,
+      f
+    );
+  }
+  f = message.getArchived();
+  if (f) {
+    writer.writeBool(
+      19,
+      f
+    );
+  }
+  f = message.getTestscommit();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = message.getScripttemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      21,
+      f
+    );
+  }
+  f = message.getAnalyzers();
+  if (f.length > 0) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
+  f = message.getCoverage();
+  if (f.length > 0) {
+    writer.writeString(
+      23,
+      f
+    );
+  }
+  f = message.getArtifactsdir();
+  if (f.length > 0) {
+    writer.writeString(
+      24,
+      f
+    );
+  }
+  f = message.getReruns();
+  if (f !== 0) {
+    writer.writeUint32(
+      25,
+      f
+    );
+  }
+  f = message.getRunnerlabels();
+  if (f.length > 0) {
+    writer.writeString(
+      26,
+      f
+    );
+  }
+  f = message.getBenchmarks();
+  if (f.length > 0) {
+    writer.writeString(
+      27,
+      f
+    );
+  }
+  f = message.getLeaderboard();
+  if (f) {
+    writer.writeBool(
+      28,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Assignment.PrerequisitePolicy = {
+  BLOCK_TESTS: 0,
+  BLOCK_APPROVAL: 1
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Assignment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.Assignment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.Assignment.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string scriptFile = 4;
+ * @return {string}
+ */
+proto.Assignment.prototype.getScriptfile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setScriptfile = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string deadline = 5;
+ * @return {string}
+ */
+proto.Assignment.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional bool autoApprove = 6;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getAutoapprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setAutoapprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 order = 7;
+ * @return {number}
+ */
+proto.Assignment.prototype.getOrder = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setOrder = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool isGroupLab = 8;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getIsgrouplab = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setIsgrouplab = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 9;
+ * @return {number}
+ */
+proto.Assignment.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 reviewers = 10;
+ * @return {number}
+ */
+proto.Assignment.prototype.getReviewers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setReviewers = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional bool skipTests = 11;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getSkiptests = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setSkiptests = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+/**
+ * repeated Submission submissions = 12;
+ * @return {!Array<!proto.Submission>}
+ */
+proto.Assignment.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.Submission>} value
+ * @return {!proto.Assignment} returns this
+*/
+proto.Assignment.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.Submission=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Submission}
+ */
+proto.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Submission, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+/**
+ * repeated GradingBenchmark gradingBenchmarks = 13;
+ * @return {!Array<!proto.GradingBenchmark>}
+ */
+proto.Assignment.prototype.getGradingbenchmarksList = function() {
+  return /** @type{!Array<!proto.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.GradingBenchmark>} value
+ * @return {!proto.Assignment} returns this
+*/
+proto.Assignment.prototype.setGradingbenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.GradingBenchmark=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.GradingBenchmark}
+ */
+proto.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.GradingBenchmark, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.clearGradingbenchmarksList = function() {
+  return this.setGradingbenchmarksList([]);
+};
+
+
+/**
+ * optional uint32 containerTimeout = 14;
+ * @return {number}
+ */
+proto.Assignment.prototype.getContainertimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setContainertimeout = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional string releaseDate = 15;
+ * @return {string}
+ */
+proto.Assignment.prototype.getReleasedate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setReleasedate = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional bool hidden = 16;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getHidden = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 16, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setHidden = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 16, value);
+};
+
+
+/**
+ * optional string prerequisites = 17;
+ * @return {string}
+ */
+proto.Assignment.prototype.getPrerequisites = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setPrerequisites = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+/**
+ * optional PrerequisitePolicy prerequisitePolicy = 18;
+ * @return {!proto.Assignment.PrerequisitePolicy}
+ */
+proto.Assignment.prototype.getPrerequisitepolicy = function() {
+  return /** @type {!proto.Assignment.PrerequisitePolicy} */ (jspb.Message.getFieldWithDefault(this, 18, 0));
+};
+
+
+/**
+ * @param {!proto.Assignment.PrerequisitePolicy} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setPrerequisitepolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 18, value);
+};
+
+
+/**
+ * optional bool archived = 19;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getArchived = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 19, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setArchived = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 19, value);
+};
+
+
+/**
+ * optional string testsCommit = 20;
+ * @return {string}
+ */
+proto.Assignment.prototype.getTestscommit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setTestscommit = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
+};
+
+
+/**
+ * optional string scriptTemplate = 21;
+ * @return {string}
+ */
+proto.Assignment.prototype.getScripttemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 21, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setScripttemplate = function(value) {
+  return jspb.Message.setProto3StringField(this, 21, value);
+};
+
+
+/**
+ * optional string analyzers = 22;
+ * @return {string}
+ */
+proto.Assignment.prototype.getAnalyzers = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setAnalyzers = function(value) {
+  return jspb.Message.setProto3StringField(this, 22, value);
+};
+
+
+/**
+ * optional string coverage = 23;
+ * @return {string}
+ */
+proto.Assignment.prototype.getCoverage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 23, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setCoverage = function(value) {
+  return jspb.Message.setProto3StringField(this, 23, value);
+};
+
+
+/**
+ * optional string artifactsDir = 24;
+ * @return {string}
+ */
+proto.Assignment.prototype.getArtifactsdir = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 24, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setArtifactsdir = function(value) {
+  return jspb.Message.setProto3StringField(this, 24, value);
+};
+
+
+/**
+ * optional uint32 reruns = 25;
+ * @return {number}
+ */
+proto.Assignment.prototype.getReruns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 25, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setReruns = function(value) {
+  return jspb.Message.setProto3IntField(this, 25, value);
+};
+
+
+/**
+ * optional string runnerLabels = 26;
+ * @return {string}
+ */
+proto.Assignment.prototype.getRunnerlabels = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 26, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setRunnerlabels = function(value) {
+  return jspb.Message.setProto3StringField(this, 26, value);
+};
+
+
+/**
+ * optional string benchmarks = 27;
+ * @return {string}
+ */
+proto.Assignment.prototype.getBenchmarks = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 27, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setBenchmarks = function(value) {
+  return jspb.Message.setProto3StringField(this, 27, value);
+};
+
+
+/**
+ * optional bool leaderboard = 28;
+ * @return {boolean}
+ */
+proto.Assignment.prototype.getLeaderboard = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 28, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Assignment} returns this
+ */
+proto.Assignment.prototype.setLeaderboard = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 28, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Assignments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Assignments.prototype.toObject = function(opt_includeInstance) {
+  return proto.Assignments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Assignments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.Assignment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Assignments}
+ */
+proto.Assignments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Assignments;
+  return proto.Assignments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Assignments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Assignments}
+ */
+proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Assignment;
+      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Assignments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Assignments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Assignments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Assignments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Assignment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Assignment assignments = 1;
+ * @return {!Array<!proto.Assignment>}
+ */
+proto.Assignments.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Assignment>} value
+ * @return {!proto.Assignments} returns this
+*/
+proto.Assignments.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Assignment}
+ */
+proto.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Assignments} returns this
+ */
+proto.Assignments.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.AssignmentChanges.repeatedFields_ = [1,2,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.AssignmentChanges.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignmentChanges.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.AssignmentChanges} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.AssignmentChanges.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    addedList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    updatedList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    renamedMap: (f = msg.getRenamedMap()) ? f.toObject(includeInstance, undefined) : [],
+    archivedList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    image: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    imageerror: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.AssignmentChanges}
+ */
+proto.AssignmentChanges.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.AssignmentChanges;
+  return proto.AssignmentChanges.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.AssignmentChanges} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.AssignmentChanges}
+ */
+proto.AssignmentChanges.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAdded(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUpdated(value);
+      break;
+    case 3:
+      var value = msg.getRenamedMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addArchived(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImage(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImageerror(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.AssignmentChanges.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.AssignmentChanges.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.AssignmentChanges} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.AssignmentChanges.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddedList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getUpdatedList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getRenamedMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getArchivedList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getImage();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getImageerror();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string added = 1;
+ * @return {!Array<string>}
+ */
+proto.AssignmentChanges.prototype.getAddedList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.setAddedList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.addAdded = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.clearAddedList = function() {
+  return this.setAddedList([]);
+};
+
+
+/**
+ * repeated string updated = 2;
+ * @return {!Array<string>}
+ */
+proto.AssignmentChanges.prototype.getUpdatedList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.setUpdatedList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.addUpdated = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.clearUpdatedList = function() {
+  return this.setUpdatedList([]);
+};
+
+
+/**
+ * map<string, string> renamed = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.AssignmentChanges.prototype.getRenamedMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.clearRenamedMap = function() {
+  this.getRenamedMap().clear();
+  return this;};
+
+
+/**
+ * repeated string archived = 4;
+ * @return {!Array<string>}
+ */
+proto.AssignmentChanges.prototype.getArchivedList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.setArchivedList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.addArchived = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.clearArchivedList = function() {
+  return this.setArchivedList([]);
+};
+
+
+/**
+ * optional string image = 5;
+ * @return {string}
+ */
+proto.AssignmentChanges.prototype.getImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.setImage = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string imageError = 6;
+ * @return {string}
+ */
+proto.AssignmentChanges.prototype.getImageerror = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.AssignmentChanges} returns this
+ */
+proto.AssignmentChanges.prototype.setImageerror = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Submission.repeatedFields_ = [12,13];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Submission.prototype.toObject = function(opt_includeInstance) {
+  return proto.Submission.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Submission} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Submission.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    scoreobjects: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    buildinfo: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    commithash: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    approveddate: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
+    proto.Review.toObject, includeInstance),
+    testsList: jspb.Message.toObjectList(msg.getTestsList(),
+    proto.TestResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Submission}
+ */
+proto.Submission.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Submission;
+  return proto.Submission.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Submission} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Submission}
+ */
+proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScoreobjects(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildinfo(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApproveddate(value);
+      break;
+    case 12:
+      var value = new proto.Review;
+      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
+      msg.addReviews(value);
+      break;
+    case 13:
+      var value = new proto.TestResult;
+      reader.readMessage(value,proto.TestResult.deserializeBinaryFromReader);
+      msg.addTests(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Submission.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Submission.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Submission} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Submission.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getScoreobjects();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getBuildinfo();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getApproveddate();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getReviewsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.Review.serializeBinaryToWriter
+    );
+  }
+  f = message.getTestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.TestResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.Submission.Status = {
+  NONE: 0,
+  APPROVED: 1,
+  REJECTED: 2,
+  REVISION: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.Submission.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.Submission.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.Submission.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.Submission.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 score = 5;
+ * @return {number}
+ */
+proto.Submission.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string scoreObjects = 6;
+ * @return {string}
+ */
+proto.Submission.prototype.getScoreobjects = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setScoreobjects = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string buildInfo = 7;
+ * @return {string}
+ */
+proto.Submission.prototype.getBuildinfo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setBuildinfo = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string commitHash = 8;
+ * @return {string}
+ */
+proto.Submission.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional bool released = 9;
+ * @return {boolean}
+ */
+proto.Submission.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional Status status = 10;
+ * @return {!proto.Submission.Status}
+ */
+proto.Submission.prototype.getStatus = function() {
+  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.Submission.Status} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional string approvedDate = 11;
+ * @return {string}
+ */
+proto.Submission.prototype.getApproveddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.setApproveddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * repeated Review reviews = 12;
+ * @return {!Array<!proto.Review>}
+ */
+proto.Submission.prototype.getReviewsList = function() {
+  return /** @type{!Array<!proto.Review>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Review, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.Review>} value
+ * @return {!proto.Submission} returns this
+*/
+proto.Submission.prototype.setReviewsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.Review=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Review}
+ */
+proto.Submission.prototype.addReviews = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Review, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.clearReviewsList = function() {
+  return this.setReviewsList([]);
+};
+
+
+/**
+ * repeated TestResult tests = 13;
+ * @return {!Array<!proto.TestResult>}
+ */
+proto.Submission.prototype.getTestsList = function() {
+  return /** @type{!Array<!proto.TestResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.TestResult, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.TestResult>} value
+ * @return {!proto.Submission} returns this
+*/
+proto.Submission.prototype.setTestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.TestResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.TestResult}
+ */
+proto.Submission.prototype.addTests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.TestResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Submission} returns this
+ */
+proto.Submission.prototype.clearTestsList = function() {
+  return this.setTestsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.TestResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.TestResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    testname: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    score: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maxscore: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    weight: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    duration: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    failure: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    expected: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    actual: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.TestResult}
+ */
+proto.TestResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.TestResult;
+  return proto.TestResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.TestResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.TestResult}
+ */
+proto.TestResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestname(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setScore(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxscore(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWeight(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDuration(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFailure(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExpected(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActual(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.TestResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.TestResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.TestResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTestname();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getMaxscore();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getFailure();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getExpected();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getActual();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string testName = 1;
+ * @return {string}
+ */
+proto.TestResult.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 score = 2;
+ * @return {number}
+ */
+proto.TestResult.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 maxScore = 3;
+ * @return {number}
+ */
+proto.TestResult.prototype.getMaxscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setMaxscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 weight = 4;
+ * @return {number}
+ */
+proto.TestResult.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 duration = 5;
+ * @return {number}
+ */
+proto.TestResult.prototype.getDuration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string failure = 6;
+ * @return {string}
+ */
+proto.TestResult.prototype.getFailure = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setFailure = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string expected = 7;
+ * @return {string}
+ */
+proto.TestResult.prototype.getExpected = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setExpected = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string actual = 8;
+ * @return {string}
+ */
+proto.TestResult.prototype.getActual = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setActual = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.BuildLog.prototype.toObject = function(opt_includeInstance) {
+  return proto.BuildLog.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.BuildLog} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.BuildLog.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    log: msg.getLog_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.BuildLog}
+ */
+proto.BuildLog.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.BuildLog;
+  return proto.BuildLog.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.BuildLog} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.BuildLog}
+ */
+proto.BuildLog.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsadmin(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStudentid(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatarurl(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogin(value);
-      break;
-    case 8:
-      var value = new proto.RemoteIdentity;
-      reader.readMessage(value,proto.RemoteIdentity.deserializeBinaryFromReader);
-      msg.addRemoteidentities(value);
-      break;
-    case 9:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLog(value);
       break;
     default:
       reader.skipField();
@@ -1234,9 +8345,9 @@ proto.User.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.User.prototype.serializeBinary = function() {
+proto.BuildLog.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.User.serializeBinaryToWriter(this, writer);
+  proto.BuildLog.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1244,11 +8355,11 @@ proto.User.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.User} message
+ * @param {!proto.BuildLog} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.User.serializeBinaryToWriter = function(message, writer) {
+proto.BuildLog.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -1257,64 +8368,20 @@ proto.User.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getIsadmin();
-  if (f) {
-    writer.writeBool(
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getName();
+  f = message.getLog_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       3,
       f
     );
   }
-  f = message.getStudentid();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getEmail();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getAvatarurl();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getLogin();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getRemoteidentitiesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      8,
-      f,
-      proto.RemoteIdentity.serializeBinaryToWriter
-    );
-  }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      9,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -1322,201 +8389,237 @@ proto.User.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.User.prototype.getId = function() {
+proto.BuildLog.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.User} returns this
+ * @return {!proto.BuildLog} returns this
  */
-proto.User.prototype.setId = function(value) {
+proto.BuildLog.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool isAdmin = 2;
- * @return {boolean}
- */
-proto.User.prototype.getIsadmin = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.User} returns this
+ * optional uint64 submissionID = 2;
+ * @return {number}
  */
-proto.User.prototype.setIsadmin = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.BuildLog.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.BuildLog} returns this
  */
-proto.User.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.BuildLog.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.User} returns this
+ * optional bytes log = 3;
+ * @return {!(string|Uint8Array)}
  */
-proto.User.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.BuildLog.prototype.getLog = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * optional string studentID = 4;
+ * optional bytes log = 3;
+ * This is a type-conversion wrapper around `getLog()`
  * @return {string}
  */
-proto.User.prototype.getStudentid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.BuildLog.prototype.getLog_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLog()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.User} returns this
+ * optional bytes log = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLog()`
+ * @return {!Uint8Array}
  */
-proto.User.prototype.setStudentid = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.BuildLog.prototype.getLog_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLog()));
 };
 
 
 /**
- * optional string email = 5;
- * @return {string}
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.BuildLog} returns this
  */
-proto.User.prototype.getEmail = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.BuildLog.prototype.setLog = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
 };
 
 
-/**
- * @param {string} value
- * @return {!proto.User} returns this
- */
-proto.User.prototype.setEmail = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
 
 /**
- * optional string avatarURL = 6;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.User.prototype.getAvatarurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
+proto.Submissions.repeatedFields_ = [1];
 
-/**
- * @param {string} value
- * @return {!proto.User} returns this
- */
-proto.User.prototype.setAvatarurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string login = 7;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.User.prototype.getLogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.Submissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.Submissions.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.User} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Submissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.User.prototype.setLogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.Submissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.Submission.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated RemoteIdentity remoteIdentities = 8;
- * @return {!Array<!proto.RemoteIdentity>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Submissions}
  */
-proto.User.prototype.getRemoteidentitiesList = function() {
-  return /** @type{!Array<!proto.RemoteIdentity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.RemoteIdentity, 8));
+proto.Submissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Submissions;
+  return proto.Submissions.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.RemoteIdentity>} value
- * @return {!proto.User} returns this
-*/
-proto.User.prototype.setRemoteidentitiesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Submissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Submissions}
+ */
+proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Submission;
+      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.RemoteIdentity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.RemoteIdentity}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.RemoteIdentity, opt_index);
+proto.Submissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Submissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.User} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Submissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.User.prototype.clearRemoteidentitiesList = function() {
-  return this.setRemoteidentitiesList([]);
+proto.Submissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Submission.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated Enrollment enrollments = 9;
- * @return {!Array<!proto.Enrollment>}
+ * repeated Submission submissions = 1;
+ * @return {!Array<!proto.Submission>}
  */
-proto.User.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 9));
+proto.Submissions.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 1));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.User} returns this
+ * @param {!Array<!proto.Submission>} value
+ * @return {!proto.Submissions} returns this
 */
-proto.User.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+proto.Submissions.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Enrollment=} opt_value
+ * @param {!proto.Submission=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * @return {!proto.Submission}
  */
-proto.User.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.Enrollment, opt_index);
+proto.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Submission, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.User} returns this
+ * @return {!proto.Submissions} returns this
  */
-proto.User.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.Submissions.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
 };
 
 
@@ -1526,7 +8629,7 @@ proto.User.prototype.clearEnrollmentsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.Users.repeatedFields_ = [1];
+proto.GradingBenchmark.repeatedFields_ = [5];
 
 
 
@@ -1543,8 +8646,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Users.prototype.toObject = function(opt_includeInstance) {
-  return proto.Users.toObject(opt_includeInstance, this);
+proto.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
+  return proto.GradingBenchmark.toObject(opt_includeInstance, this);
 };
 
 
@@ -1553,14 +8656,18 @@ proto.Users.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Users} msg The msg instance to transform.
+ * @param {!proto.GradingBenchmark} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Users.toObject = function(includeInstance, msg) {
+proto.GradingBenchmark.toObject = function(includeInstance, msg) {
   var f, obj = {
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.User.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    heading: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
+    proto.GradingCriterion.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1574,23 +8681,23 @@ proto.Users.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Users}
+ * @return {!proto.GradingBenchmark}
  */
-proto.Users.deserializeBinary = function(bytes) {
+proto.GradingBenchmark.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Users;
-  return proto.Users.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GradingBenchmark;
+  return proto.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Users} msg The message object to deserialize into.
+ * @param {!proto.GradingBenchmark} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Users}
+ * @return {!proto.GradingBenchmark}
  */
-proto.Users.deserializeBinaryFromReader = function(msg, reader) {
+proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1598,9 +8705,25 @@ proto.Users.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHeading(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    case 5:
+      var value = new proto.GradingCriterion;
+      reader.readMessage(value,proto.GradingCriterion.deserializeBinaryFromReader);
+      msg.addCriteria(value);
       break;
     default:
       reader.skipField();
@@ -1615,9 +8738,9 @@ proto.Users.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Users.prototype.serializeBinary = function() {
+proto.GradingBenchmark.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Users.serializeBinaryToWriter(this, writer);
+  proto.GradingBenchmark.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1625,62 +8748,169 @@ proto.Users.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Users} message
+ * @param {!proto.GradingBenchmark} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Users.serializeBinaryToWriter = function(message, writer) {
+proto.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsersList();
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getHeading();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getCriteriaList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      1,
+      5,
       f,
-      proto.User.serializeBinaryToWriter
+      proto.GradingCriterion.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated User users = 1;
- * @return {!Array<!proto.User>}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.Users.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
+proto.GradingBenchmark.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.GradingBenchmark} returns this
+ */
+proto.GradingBenchmark.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.GradingBenchmark.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.GradingBenchmark} returns this
+ */
+proto.GradingBenchmark.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string heading = 3;
+ * @return {string}
+ */
+proto.GradingBenchmark.prototype.getHeading = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.GradingBenchmark} returns this
+ */
+proto.GradingBenchmark.prototype.setHeading = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string comment = 4;
+ * @return {string}
+ */
+proto.GradingBenchmark.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.GradingBenchmark} returns this
+ */
+proto.GradingBenchmark.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * repeated GradingCriterion criteria = 5;
+ * @return {!Array<!proto.GradingCriterion>}
+ */
+proto.GradingBenchmark.prototype.getCriteriaList = function() {
+  return /** @type{!Array<!proto.GradingCriterion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingCriterion, 5));
 };
 
 
 /**
- * @param {!Array<!proto.User>} value
- * @return {!proto.Users} returns this
+ * @param {!Array<!proto.GradingCriterion>} value
+ * @return {!proto.GradingBenchmark} returns this
 */
-proto.Users.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.GradingBenchmark.prototype.setCriteriaList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.User=} opt_value
+ * @param {!proto.GradingCriterion=} opt_value
  * @param {number=} opt_index
- * @return {!proto.User}
+ * @return {!proto.GradingCriterion}
  */
-proto.Users.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
+proto.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.GradingCriterion, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Users} returns this
+ * @return {!proto.GradingBenchmark} returns this
  */
-proto.Users.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.GradingBenchmark.prototype.clearCriteriaList = function() {
+  return this.setCriteriaList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Benchmarks.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1696,8 +8926,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
-  return proto.RemoteIdentity.toObject(opt_includeInstance, this);
+proto.Benchmarks.prototype.toObject = function(opt_includeInstance) {
+  return proto.Benchmarks.toObject(opt_includeInstance, this);
 };
 
 
@@ -1706,17 +8936,14 @@ proto.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.RemoteIdentity} msg The msg instance to transform.
+ * @param {!proto.Benchmarks} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RemoteIdentity.toObject = function(includeInstance, msg) {
+proto.Benchmarks.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
+    proto.GradingBenchmark.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1730,23 +8957,23 @@ proto.RemoteIdentity.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.RemoteIdentity}
+ * @return {!proto.Benchmarks}
  */
-proto.RemoteIdentity.deserializeBinary = function(bytes) {
+proto.Benchmarks.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.RemoteIdentity;
-  return proto.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Benchmarks;
+  return proto.Benchmarks.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!proto.Benchmarks} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.RemoteIdentity}
+ * @return {!proto.Benchmarks}
  */
-proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1754,24 +8981,9 @@ proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setRemoteid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAccesstoken(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      var value = new proto.GradingBenchmark;
+      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addBenchmarks(value);
       break;
     default:
       reader.skipField();
@@ -1786,9 +8998,9 @@ proto.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.RemoteIdentity.prototype.serializeBinary = function() {
+proto.Benchmarks.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  proto.Benchmarks.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1796,148 +9008,62 @@ proto.RemoteIdentity.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.RemoteIdentity} message
+ * @param {!proto.Benchmarks} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+proto.Benchmarks.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getRemoteid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getAccesstoken();
+  f = message.getBenchmarksList();
   if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      5,
-      f
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.GradingBenchmark.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 ID = 1;
- * @return {number}
- */
-proto.RemoteIdentity.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.RemoteIdentity} returns this
- */
-proto.RemoteIdentity.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional string provider = 2;
- * @return {string}
- */
-proto.RemoteIdentity.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.RemoteIdentity} returns this
- */
-proto.RemoteIdentity.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional uint64 remoteID = 3;
- * @return {number}
- */
-proto.RemoteIdentity.prototype.getRemoteid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.RemoteIdentity} returns this
- */
-proto.RemoteIdentity.prototype.setRemoteid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional string accessToken = 4;
- * @return {string}
+ * repeated GradingBenchmark benchmarks = 1;
+ * @return {!Array<!proto.GradingBenchmark>}
  */
-proto.RemoteIdentity.prototype.getAccesstoken = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.Benchmarks.prototype.getBenchmarksList = function() {
+  return /** @type{!Array<!proto.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.RemoteIdentity} returns this
- */
-proto.RemoteIdentity.prototype.setAccesstoken = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+ * @param {!Array<!proto.GradingBenchmark>} value
+ * @return {!proto.Benchmarks} returns this
+*/
+proto.Benchmarks.prototype.setBenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional uint64 userID = 5;
- * @return {number}
+ * @param {!proto.GradingBenchmark=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.GradingBenchmark}
  */
-proto.RemoteIdentity.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.GradingBenchmark, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.RemoteIdentity} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Benchmarks} returns this
  */
-proto.RemoteIdentity.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.Benchmarks.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Group.repeatedFields_ = [6,7];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1953,8 +9079,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Group.prototype.toObject = function(opt_includeInstance) {
-  return proto.Group.toObject(opt_includeInstance, this);
+proto.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
+  return proto.GradingCriterion.toObject(opt_includeInstance, this);
 };
 
 
@@ -1963,21 +9089,18 @@ proto.Group.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Group} msg The msg instance to transform.
+ * @param {!proto.GradingCriterion} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Group.toObject = function(includeInstance, msg) {
+proto.GradingCriterion.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.User.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance)
+    points: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    benchmarkid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -1991,23 +9114,23 @@ proto.Group.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Group}
+ * @return {!proto.GradingCriterion}
  */
-proto.Group.deserializeBinary = function(bytes) {
+proto.GradingCriterion.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Group;
-  return proto.Group.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GradingCriterion;
+  return proto.GradingCriterion.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Group} msg The message object to deserialize into.
+ * @param {!proto.GradingCriterion} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Group}
+ * @return {!proto.GradingCriterion}
  */
-proto.Group.deserializeBinaryFromReader = function(msg, reader) {
+proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2019,30 +9142,24 @@ proto.Group.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setPoints(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setBenchmarkid(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setTeamid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
       break;
     case 5:
-      var value = /** @type {!proto.Group.GroupStatus} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {!proto.GradingCriterion.Grade} */ (reader.readEnum());
+      msg.setGrade(value);
       break;
     case 6:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
-      break;
-    case 7:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
       break;
     default:
       reader.skipField();
@@ -2057,9 +9174,9 @@ proto.Group.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Group.prototype.serializeBinary = function() {
+proto.GradingCriterion.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Group.serializeBinaryToWriter(this, writer);
+  proto.GradingCriterion.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2067,11 +9184,11 @@ proto.Group.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Group} message
+ * @param {!proto.GradingCriterion} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Group.serializeBinaryToWriter = function(message, writer) {
+proto.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -2080,48 +9197,39 @@ proto.Group.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPoints();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getBenchmarkid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getTeamid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getStatus();
+  f = message.getGrade();
   if (f !== 0.0) {
     writer.writeEnum(
       5,
       f
     );
   }
-  f = message.getUsersList();
+  f = message.getComment();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       6,
-      f,
-      proto.User.serializeBinaryToWriter
-    );
-  }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      7,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
+      f
     );
   }
 };
@@ -2130,334 +9238,117 @@ proto.Group.serializeBinaryToWriter = function(message, writer) {
 /**
  * @enum {number}
  */
-proto.Group.GroupStatus = {
-  PENDING: 0,
-  APPROVED: 1
+proto.GradingCriterion.Grade = {
+  NONE: 0,
+  FAILED: 1,
+  PASSED: 2
 };
 
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Group.prototype.getId = function() {
+proto.GradingCriterion.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional string name = 2;
- * @return {string}
- */
-proto.Group.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional uint64 courseID = 3;
- * @return {number}
- */
-proto.Group.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional uint64 teamID = 4;
- * @return {number}
- */
-proto.Group.prototype.getTeamid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.setTeamid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional GroupStatus status = 5;
- * @return {!proto.Group.GroupStatus}
- */
-proto.Group.prototype.getStatus = function() {
-  return /** @type {!proto.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {!proto.Group.GroupStatus} value
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
-};
-
-
-/**
- * repeated User users = 6;
- * @return {!Array<!proto.User>}
- */
-proto.Group.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.User, 6));
-};
-
-
-/**
- * @param {!Array<!proto.User>} value
- * @return {!proto.Group} returns this
-*/
-proto.Group.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
-};
-
-
-/**
- * @param {!proto.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.User}
- */
-proto.Group.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.User, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
-};
-
-
-/**
- * repeated Enrollment enrollments = 7;
- * @return {!Array<!proto.Enrollment>}
- */
-proto.Group.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 7));
-};
-
-
-/**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.Group} returns this
-*/
-proto.Group.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
-};
-
-
-/**
- * @param {!proto.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Enrollment}
- */
-proto.Group.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.Enrollment, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.Group} returns this
- */
-proto.Group.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Groups.repeatedFields_ = [1];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.Groups.prototype.toObject = function(opt_includeInstance) {
-  return proto.Groups.toObject(opt_includeInstance, this);
+proto.GradingCriterion.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.Groups} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint64 points = 2;
+ * @return {number}
  */
-proto.Groups.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.Group.toObject, includeInstance)
-  };
+proto.GradingCriterion.prototype.getPoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {number} value
+ * @return {!proto.GradingCriterion} returns this
+ */
+proto.GradingCriterion.prototype.setPoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Groups}
+ * optional uint64 benchmarkID = 3;
+ * @return {number}
  */
-proto.Groups.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Groups;
-  return proto.Groups.deserializeBinaryFromReader(msg, reader);
+proto.GradingCriterion.prototype.getBenchmarkid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.Groups} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Groups}
+ * @param {number} value
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.Groups.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.Group;
-      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.GradingCriterion.prototype.setBenchmarkid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string description = 4;
+ * @return {string}
  */
-proto.Groups.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.Groups.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.GradingCriterion.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.Groups} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.Groups.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getGroupsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.Group.serializeBinaryToWriter
-    );
-  }
+proto.GradingCriterion.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * repeated Group groups = 1;
- * @return {!Array<!proto.Group>}
+ * optional Grade grade = 5;
+ * @return {!proto.GradingCriterion.Grade}
  */
-proto.Groups.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Group, 1));
+proto.GradingCriterion.prototype.getGrade = function() {
+  return /** @type {!proto.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Group>} value
- * @return {!proto.Groups} returns this
-*/
-proto.Groups.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!proto.GradingCriterion.Grade} value
+ * @return {!proto.GradingCriterion} returns this
+ */
+proto.GradingCriterion.prototype.setGrade = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.Group=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Group}
+ * optional string comment = 6;
+ * @return {string}
  */
-proto.Groups.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Group, opt_index);
+proto.GradingCriterion.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Groups} returns this
+ * @param {string} value
+ * @return {!proto.GradingCriterion} returns this
  */
-proto.Groups.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.GradingCriterion.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -2467,7 +9358,7 @@ proto.Groups.prototype.clearGroupsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.Course.repeatedFields_ = [12,13,14];
+proto.Review.repeatedFields_ = [8];
 
 
 
@@ -2484,8 +9375,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Course.prototype.toObject = function(opt_includeInstance) {
-  return proto.Course.toObject(opt_includeInstance, this);
+proto.Review.prototype.toObject = function(opt_includeInstance) {
+  return proto.Review.toObject(opt_includeInstance, this);
 };
 
 
@@ -2494,29 +9385,21 @@ proto.Course.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Course} msg The msg instance to transform.
+ * @param {!proto.Review} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.toObject = function(includeInstance, msg) {
+proto.Review.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    enrolled: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance),
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.Assignment.toObject, includeInstance),
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.Group.toObject, includeInstance)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    review: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    feedback: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    ready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    score: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
+    proto.GradingBenchmark.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2530,23 +9413,23 @@ proto.Course.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Course}
+ * @return {!proto.Review}
  */
-proto.Course.deserializeBinary = function(bytes) {
+proto.Review.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Course;
-  return proto.Course.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Review;
+  return proto.Review.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Course} msg The message object to deserialize into.
+ * @param {!proto.Review} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Course}
+ * @return {!proto.Review}
  */
-proto.Course.deserializeBinaryFromReader = function(msg, reader) {
+proto.Review.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2559,58 +9442,32 @@ proto.Course.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCoursecreatorid(value);
+      msg.setSubmissionid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReviewerid(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCode(value);
+      msg.setReview(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setYear(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFeedback(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTag(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReady(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
-      break;
-    case 8:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrganizationpath(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdays(value);
-      break;
-    case 11:
-      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setEnrolled(value);
-      break;
-    case 12:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
-      break;
-    case 13:
-      var value = new proto.Assignment;
-      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      msg.setScore(value);
       break;
-    case 14:
-      var value = new proto.Group;
-      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
+    case 8:
+      var value = new proto.GradingBenchmark;
+      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addBenchmarks(value);
       break;
     default:
       reader.skipField();
@@ -2625,9 +9482,9 @@ proto.Course.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Course.prototype.serializeBinary = function() {
+proto.Review.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Course.serializeBinaryToWriter(this, writer);
+  proto.Review.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2635,111 +9492,67 @@ proto.Course.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Course} message
+ * @param {!proto.Review} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Course.serializeBinaryToWriter = function(message, writer) {
+proto.Review.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
     writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getCoursecreatorid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
+      1,
       f
     );
   }
-  f = message.getCode();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
       f
     );
   }
-  f = message.getYear();
+  f = message.getReviewerid();
   if (f !== 0) {
-    writer.writeUint32(
-      5,
+    writer.writeUint64(
+      3,
       f
     );
   }
-  f = message.getTag();
+  f = message.getReview();
   if (f.length > 0) {
     writer.writeString(
-      6,
+      4,
       f
     );
   }
-  f = message.getProvider();
+  f = message.getFeedback();
   if (f.length > 0) {
     writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getOrganizationid();
-  if (f !== 0) {
-    writer.writeUint64(
-      8,
+      5,
       f
     );
   }
-  f = message.getOrganizationpath();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
+  f = message.getReady();
+  if (f) {
+    writer.writeBool(
+      6,
       f
     );
   }
-  f = message.getSlipdays();
+  f = message.getScore();
   if (f !== 0) {
-    writer.writeUint32(
-      10,
-      f
-    );
-  }
-  f = message.getEnrolled();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      11,
+    writer.writeUint64(
+      7,
       f
     );
   }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
-    );
-  }
-  f = message.getAssignmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      13,
-      f,
-      proto.Assignment.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroupsList();
+  f = message.getBenchmarksList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      14,
+      8,
       f,
-      proto.Group.serializeBinaryToWriter
+      proto.GradingBenchmark.serializeBinaryToWriter
     );
   }
 };
@@ -2749,311 +9562,163 @@ proto.Course.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Course.prototype.getId = function() {
+proto.Review.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Course} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.setId = function(value) {
+proto.Review.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseCreatorID = 2;
+ * optional uint64 submissionID = 2;
  * @return {number}
  */
-proto.Course.prototype.getCoursecreatorid = function() {
+proto.Review.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Course} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.setCoursecreatorid = function(value) {
+proto.Review.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
- */
-proto.Course.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string code = 4;
- * @return {string}
- */
-proto.Course.prototype.getCode = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setCode = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional uint32 year = 5;
- * @return {number}
- */
-proto.Course.prototype.getYear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setYear = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
-
-
-/**
- * optional string tag = 6;
- * @return {string}
- */
-proto.Course.prototype.getTag = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setTag = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-/**
- * optional string provider = 7;
- * @return {string}
- */
-proto.Course.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
-
-
-/**
- * optional uint64 organizationID = 8;
+ * optional uint64 reviewerID = 3;
  * @return {number}
  */
-proto.Course.prototype.getOrganizationid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.Review.prototype.getReviewerid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Course} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.setOrganizationid = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.Review.prototype.setReviewerid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string organizationPath = 9;
+ * optional string review = 4;
  * @return {string}
  */
-proto.Course.prototype.getOrganizationpath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.Review.prototype.getReview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setOrganizationpath = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
-};
-
-
-/**
- * optional uint32 slipDays = 10;
- * @return {number}
- */
-proto.Course.prototype.getSlipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setSlipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
-};
-
-
-/**
- * optional Enrollment.UserStatus enrolled = 11;
- * @return {!proto.Enrollment.UserStatus}
- */
-proto.Course.prototype.getEnrolled = function() {
-  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
-};
-
-
-/**
- * @param {!proto.Enrollment.UserStatus} value
- * @return {!proto.Course} returns this
- */
-proto.Course.prototype.setEnrolled = function(value) {
-  return jspb.Message.setProto3EnumField(this, 11, value);
-};
-
-
-/**
- * repeated Enrollment enrollments = 12;
- * @return {!Array<!proto.Enrollment>}
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 12));
-};
-
-
-/**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.Course} returns this
-*/
-proto.Course.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+proto.Review.prototype.setReview = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * optional string feedback = 5;
+ * @return {string}
  */
-proto.Course.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Enrollment, opt_index);
+proto.Review.prototype.getFeedback = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Course} returns this
+ * @param {string} value
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.Review.prototype.setFeedback = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * repeated Assignment assignments = 13;
- * @return {!Array<!proto.Assignment>}
+ * optional bool ready = 6;
+ * @return {boolean}
  */
-proto.Course.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 13));
+proto.Review.prototype.getReady = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * @param {!Array<!proto.Assignment>} value
- * @return {!proto.Course} returns this
-*/
-proto.Course.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+ * @param {boolean} value
+ * @return {!proto.Review} returns this
+ */
+proto.Review.prototype.setReady = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.Assignment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Assignment}
+ * optional uint64 score = 7;
+ * @return {number}
  */
-proto.Course.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.Assignment, opt_index);
+proto.Review.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Course} returns this
+ * @param {number} value
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.Review.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * repeated Group groups = 14;
- * @return {!Array<!proto.Group>}
+ * repeated GradingBenchmark benchmarks = 8;
+ * @return {!Array<!proto.GradingBenchmark>}
  */
-proto.Course.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Group, 14));
+proto.Review.prototype.getBenchmarksList = function() {
+  return /** @type{!Array<!proto.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 8));
 };
 
 
 /**
- * @param {!Array<!proto.Group>} value
- * @return {!proto.Course} returns this
+ * @param {!Array<!proto.GradingBenchmark>} value
+ * @return {!proto.Review} returns this
 */
-proto.Course.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+proto.Review.prototype.setBenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
 };
 
 
 /**
- * @param {!proto.Group=} opt_value
+ * @param {!proto.GradingBenchmark=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Group}
+ * @return {!proto.GradingBenchmark}
  */
-proto.Course.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.Group, opt_index);
+proto.Review.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Course} returns this
+ * @return {!proto.Review} returns this
  */
-proto.Course.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.Review.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
 };
 
 
@@ -3063,7 +9728,7 @@ proto.Course.prototype.clearGroupsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.Courses.repeatedFields_ = [1];
+proto.Reviewers.repeatedFields_ = [1];
 
 
 
@@ -3080,8 +9745,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Courses.prototype.toObject = function(opt_includeInstance) {
-  return proto.Courses.toObject(opt_includeInstance, this);
+proto.Reviewers.prototype.toObject = function(opt_includeInstance) {
+  return proto.Reviewers.toObject(opt_includeInstance, this);
 };
 
 
@@ -3090,14 +9755,14 @@ proto.Courses.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Courses} msg The msg instance to transform.
+ * @param {!proto.Reviewers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Courses.toObject = function(includeInstance, msg) {
+proto.Reviewers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
-    proto.Course.toObject, includeInstance)
+    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
+    proto.User.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3111,23 +9776,23 @@ proto.Courses.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Courses}
+ * @return {!proto.Reviewers}
  */
-proto.Courses.deserializeBinary = function(bytes) {
+proto.Reviewers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Courses;
-  return proto.Courses.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Reviewers;
+  return proto.Reviewers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Courses} msg The message object to deserialize into.
+ * @param {!proto.Reviewers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Courses}
+ * @return {!proto.Reviewers}
  */
-proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
+proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3135,9 +9800,9 @@ proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Course;
-      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
-      msg.addCourses(value);
+      var value = new proto.User;
+      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
+      msg.addReviewers(value);
       break;
     default:
       reader.skipField();
@@ -3152,9 +9817,9 @@ proto.Courses.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Courses.prototype.serializeBinary = function() {
+proto.Reviewers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Courses.serializeBinaryToWriter(this, writer);
+  proto.Reviewers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3162,62 +9827,69 @@ proto.Courses.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Courses} message
+ * @param {!proto.Reviewers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Courses.serializeBinaryToWriter = function(message, writer) {
+proto.Reviewers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursesList();
+  f = message.getReviewersList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.Course.serializeBinaryToWriter
+      proto.User.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Course courses = 1;
- * @return {!Array<!proto.Course>}
+ * repeated User reviewers = 1;
+ * @return {!Array<!proto.User>}
  */
-proto.Courses.prototype.getCoursesList = function() {
-  return /** @type{!Array<!proto.Course>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Course, 1));
+proto.Reviewers.prototype.getReviewersList = function() {
+  return /** @type{!Array<!proto.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
 };
 
 
 /**
- * @param {!Array<!proto.Course>} value
- * @return {!proto.Courses} returns this
+ * @param {!Array<!proto.User>} value
+ * @return {!proto.Reviewers} returns this
 */
-proto.Courses.prototype.setCoursesList = function(value) {
+proto.Reviewers.prototype.setReviewersList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Course=} opt_value
+ * @param {!proto.User=} opt_value
  * @param {number=} opt_index
- * @return {!proto.Course}
+ * @return {!proto.User}
  */
-proto.Courses.prototype.addCourses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Course, opt_index);
+proto.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Courses} returns this
+ * @return {!proto.Reviewers} returns this
  */
-proto.Courses.prototype.clearCoursesList = function() {
-  return this.setCoursesList([]);
+proto.Reviewers.prototype.clearReviewersList = function() {
+  return this.setReviewersList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Regrade.repeatedFields_ = [11];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3233,8 +9905,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Repository.prototype.toObject = function(opt_includeInstance) {
-  return proto.Repository.toObject(opt_includeInstance, this);
+proto.Regrade.prototype.toObject = function(opt_includeInstance) {
+  return proto.Regrade.toObject(opt_includeInstance, this);
 };
 
 
@@ -3243,19 +9915,24 @@ proto.Repository.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Repository} msg The msg instance to transform.
+ * @param {!proto.Regrade} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Repository.toObject = function(includeInstance, msg) {
+proto.Regrade.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    testname: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    state: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    createddate: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    resolverid: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    resolveddate: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    response: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    scoreupdatesList: jspb.Message.toObjectList(msg.getScoreupdatesList(),
+    proto.ScoreUpdate.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3269,23 +9946,23 @@ proto.Repository.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Repository}
+ * @return {!proto.Regrade}
  */
-proto.Repository.deserializeBinary = function(bytes) {
+proto.Regrade.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Repository;
-  return proto.Repository.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Regrade;
+  return proto.Regrade.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Repository} msg The message object to deserialize into.
+ * @param {!proto.Regrade} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Repository}
+ * @return {!proto.Regrade}
  */
-proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
+proto.Regrade.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3298,27 +9975,44 @@ proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
+      msg.setSubmissionid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setRepositoryid(value);
+      msg.setUserid(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestname(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHtmlurl(value);
+      var value = /** @type {!proto.Regrade.State} */ (reader.readEnum());
+      msg.setState(value);
       break;
     case 7:
-      var value = /** @type {!proto.Repository.Type} */ (reader.readEnum());
-      msg.setRepotype(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCreateddate(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setResolverid(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResolveddate(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResponse(value);
+      break;
+    case 11:
+      var value = new proto.ScoreUpdate;
+      reader.readMessage(value,proto.ScoreUpdate.deserializeBinaryFromReader);
+      msg.addScoreupdates(value);
       break;
     default:
       reader.skipField();
@@ -3333,9 +10027,9 @@ proto.Repository.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Repository.prototype.serializeBinary = function() {
+proto.Regrade.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Repository.serializeBinaryToWriter(this, writer);
+  proto.Regrade.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3343,11 +10037,11 @@ proto.Repository.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Repository} message
+ * @param {!proto.Regrade} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Repository.serializeBinaryToWriter = function(message, writer) {
+proto.Regrade.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3356,46 +10050,75 @@ proto.Repository.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getOrganizationid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getRepositoryid();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getTestname();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getCreateddate();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getResolverid();
   if (f !== 0) {
     writer.writeUint64(
-      5,
+      8,
       f
     );
   }
-  f = message.getHtmlurl();
+  f = message.getResolveddate();
   if (f.length > 0) {
     writer.writeString(
-      6,
+      9,
+      f
+    );
+  }
+  f = message.getResponse();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
       f
     );
   }
-  f = message.getRepotype();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      7,
-      f
+  f = message.getScoreupdatesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      11,
+      f,
+      proto.ScoreUpdate.serializeBinaryToWriter
     );
   }
 };
@@ -3404,148 +10127,230 @@ proto.Repository.serializeBinaryToWriter = function(message, writer) {
 /**
  * @enum {number}
  */
-proto.Repository.Type = {
-  NONE: 0,
-  COURSEINFO: 1,
-  ASSIGNMENTS: 2,
-  TESTS: 3,
-  USER: 4,
-  GROUP: 5
+proto.Regrade.State = {
+  OPEN: 0,
+  ACCEPTED: 1,
+  REJECTED: 2
 };
 
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Repository.prototype.getId = function() {
+proto.Regrade.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setId = function(value) {
+proto.Regrade.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 organizationID = 2;
+ * optional uint64 submissionID = 2;
  * @return {number}
  */
-proto.Repository.prototype.getOrganizationid = function() {
+proto.Regrade.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setOrganizationid = function(value) {
+proto.Regrade.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 repositoryID = 3;
+ * optional uint64 userID = 3;
  * @return {number}
  */
-proto.Repository.prototype.getRepositoryid = function() {
+proto.Regrade.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setRepositoryid = function(value) {
+proto.Regrade.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 userID = 4;
- * @return {number}
+ * optional string reason = 4;
+ * @return {string}
  */
-proto.Repository.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.Regrade.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Repository} returns this
+ * @param {string} value
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.Regrade.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional uint64 groupID = 5;
+ * optional string testName = 5;
+ * @return {string}
+ */
+proto.Regrade.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Regrade} returns this
+ */
+proto.Regrade.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional State state = 6;
+ * @return {!proto.Regrade.State}
+ */
+proto.Regrade.prototype.getState = function() {
+  return /** @type {!proto.Regrade.State} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.Regrade.State} value
+ * @return {!proto.Regrade} returns this
+ */
+proto.Regrade.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional string createdDate = 7;
+ * @return {string}
+ */
+proto.Regrade.prototype.getCreateddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Regrade} returns this
+ */
+proto.Regrade.prototype.setCreateddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 resolverID = 8;
  * @return {number}
  */
-proto.Repository.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.Regrade.prototype.getResolverid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.Regrade.prototype.setResolverid = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
 /**
- * optional string HTMLURL = 6;
+ * optional string resolvedDate = 9;
  * @return {string}
  */
-proto.Repository.prototype.getHtmlurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.Regrade.prototype.getResolveddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.Repository} returns this
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setHtmlurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.Regrade.prototype.setResolveddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * optional Type repoType = 7;
- * @return {!proto.Repository.Type}
+ * optional string response = 10;
+ * @return {string}
  */
-proto.Repository.prototype.getRepotype = function() {
-  return /** @type {!proto.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.Regrade.prototype.getResponse = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /**
- * @param {!proto.Repository.Type} value
- * @return {!proto.Repository} returns this
+ * @param {string} value
+ * @return {!proto.Regrade} returns this
  */
-proto.Repository.prototype.setRepotype = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
+proto.Regrade.prototype.setResponse = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
+/**
+ * repeated ScoreUpdate scoreUpdates = 11;
+ * @return {!Array<!proto.ScoreUpdate>}
+ */
+proto.Regrade.prototype.getScoreupdatesList = function() {
+  return /** @type{!Array<!proto.ScoreUpdate>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ScoreUpdate, 11));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!Array<!proto.ScoreUpdate>} value
+ * @return {!proto.Regrade} returns this
+*/
+proto.Regrade.prototype.setScoreupdatesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 11, value);
+};
+
+
+/**
+ * @param {!proto.ScoreUpdate=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ScoreUpdate}
  */
-proto.Enrollment.repeatedFields_ = [14];
+proto.Regrade.prototype.addScoreupdates = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.ScoreUpdate, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Regrade} returns this
+ */
+proto.Regrade.prototype.clearScoreupdatesList = function() {
+  return this.setScoreupdatesList([]);
+};
+
+
 
 
 
@@ -3562,8 +10367,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Enrollment.prototype.toObject = function(opt_includeInstance) {
-  return proto.Enrollment.toObject(opt_includeInstance, this);
+proto.ScoreUpdate.prototype.toObject = function(opt_includeInstance) {
+  return proto.ScoreUpdate.toObject(opt_includeInstance, this);
 };
 
 
@@ -3572,27 +10377,19 @@ proto.Enrollment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Enrollment} msg The msg instance to transform.
+ * @param {!proto.ScoreUpdate} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.toObject = function(includeInstance, msg) {
+proto.ScoreUpdate.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    user: (f = msg.getUser()) && proto.User.toObject(includeInstance, f),
-    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
-    group: (f = msg.getGroup()) && proto.Group.toObject(includeInstance, f),
-    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
-    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
-    proto.UsedSlipDays.toObject, includeInstance)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    regradeid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    updatedbyid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    oldscore: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    newscore: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    updateddate: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -3606,23 +10403,23 @@ proto.Enrollment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Enrollment}
+ * @return {!proto.ScoreUpdate}
  */
-proto.Enrollment.deserializeBinary = function(bytes) {
+proto.ScoreUpdate.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Enrollment;
-  return proto.Enrollment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ScoreUpdate;
+  return proto.ScoreUpdate.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Enrollment} msg The message object to deserialize into.
+ * @param {!proto.ScoreUpdate} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Enrollment}
+ * @return {!proto.ScoreUpdate}
  */
-proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+proto.ScoreUpdate.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3635,59 +10432,27 @@ proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setSubmissionid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setRegradeid(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setUpdatedbyid(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHasteacherscopes(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOldscore(value);
       break;
     case 6:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.setUser(value);
-      break;
-    case 7:
-      var value = new proto.Course;
-      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
-      break;
-    case 8:
-      var value = new proto.Group;
-      reader.readMessage(value,proto.Group.deserializeBinaryFromReader);
-      msg.setGroup(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.Enrollment.DisplayState} */ (reader.readEnum());
-      msg.setState(value);
-      break;
-    case 11:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdaysremaining(value);
+      msg.setNewscore(value);
       break;
-    case 12:
+    case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLastactivitydate(value);
-      break;
-    case 13:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setTotalapproved(value);
-      break;
-    case 14:
-      var value = new proto.UsedSlipDays;
-      reader.readMessage(value,proto.UsedSlipDays.deserializeBinaryFromReader);
-      msg.addUsedslipdays(value);
+      msg.setUpdateddate(value);
       break;
     default:
       reader.skipField();
@@ -3702,9 +10467,9 @@ proto.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Enrollment.prototype.serializeBinary = function() {
+proto.ScoreUpdate.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Enrollment.serializeBinaryToWriter(this, writer);
+  proto.ScoreUpdate.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3712,11 +10477,11 @@ proto.Enrollment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Enrollment} message
+ * @param {!proto.ScoreUpdate} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.serializeBinaryToWriter = function(message, writer) {
+proto.ScoreUpdate.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3725,450 +10490,645 @@ proto.Enrollment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getHasteacherscopes();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.User.serializeBinaryToWriter
-    );
-  }
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroup();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.Group.serializeBinaryToWriter
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = message.getState();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
+  f = message.getRegradeid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
       f
     );
   }
-  f = message.getSlipdaysremaining();
+  f = message.getUpdatedbyid();
   if (f !== 0) {
-    writer.writeUint32(
-      11,
+    writer.writeUint64(
+      4,
       f
     );
   }
-  f = message.getLastactivitydate();
-  if (f.length > 0) {
-    writer.writeString(
-      12,
+  f = message.getOldscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
       f
     );
   }
-  f = message.getTotalapproved();
+  f = message.getNewscore();
   if (f !== 0) {
-    writer.writeUint64(
-      13,
+    writer.writeUint32(
+      6,
       f
     );
   }
-  f = message.getUsedslipdaysList();
+  f = message.getUpdateddate();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      14,
-      f,
-      proto.UsedSlipDays.serializeBinaryToWriter
+    writer.writeString(
+      7,
+      f
     );
   }
 };
 
 
-/**
- * @enum {number}
- */
-proto.Enrollment.UserStatus = {
-  NONE: 0,
-  PENDING: 1,
-  STUDENT: 2,
-  TEACHER: 3
-};
-
-/**
- * @enum {number}
- */
-proto.Enrollment.DisplayState = {
-  UNSET: 0,
-  HIDDEN: 1,
-  VISIBLE: 2,
-  FAVORITE: 3
-};
-
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Enrollment.prototype.getId = function() {
+proto.ScoreUpdate.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * @return {!proto.ScoreUpdate} returns this
  */
-proto.Enrollment.prototype.setId = function(value) {
+proto.ScoreUpdate.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
+ * optional uint64 submissionID = 2;
  * @return {number}
  */
-proto.Enrollment.prototype.getCourseid = function() {
+proto.ScoreUpdate.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * @return {!proto.ScoreUpdate} returns this
  */
-proto.Enrollment.prototype.setCourseid = function(value) {
+proto.ScoreUpdate.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 userID = 3;
+ * optional uint64 regradeID = 3;
  * @return {number}
  */
-proto.Enrollment.prototype.getUserid = function() {
+proto.ScoreUpdate.prototype.getRegradeid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * @return {!proto.ScoreUpdate} returns this
  */
-proto.Enrollment.prototype.setUserid = function(value) {
+proto.ScoreUpdate.prototype.setRegradeid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 groupID = 4;
+ * optional uint64 updatedByID = 4;
  * @return {number}
  */
-proto.Enrollment.prototype.getGroupid = function() {
+proto.ScoreUpdate.prototype.getUpdatedbyid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * @return {!proto.ScoreUpdate} returns this
  */
-proto.Enrollment.prototype.setGroupid = function(value) {
+proto.ScoreUpdate.prototype.setUpdatedbyid = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional bool hasTeacherScopes = 5;
- * @return {boolean}
+ * optional uint32 oldScore = 5;
+ * @return {number}
  */
-proto.Enrollment.prototype.getHasteacherscopes = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.ScoreUpdate.prototype.getOldscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Enrollment} returns this
+ * @param {number} value
+ * @return {!proto.ScoreUpdate} returns this
  */
-proto.Enrollment.prototype.setHasteacherscopes = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.ScoreUpdate.prototype.setOldscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional User user = 6;
- * @return {?proto.User}
+ * optional uint32 newScore = 6;
+ * @return {number}
  */
-proto.Enrollment.prototype.getUser = function() {
-  return /** @type{?proto.User} */ (
-    jspb.Message.getWrapperField(this, proto.User, 6));
+proto.ScoreUpdate.prototype.getNewscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {?proto.User|undefined} value
- * @return {!proto.Enrollment} returns this
-*/
-proto.Enrollment.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * @param {number} value
+ * @return {!proto.ScoreUpdate} returns this
+ */
+proto.ScoreUpdate.prototype.setNewscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.Enrollment} returns this
+ * optional string updatedDate = 7;
+ * @return {string}
  */
-proto.Enrollment.prototype.clearUser = function() {
-  return this.setUser(undefined);
+proto.ScoreUpdate.prototype.getUpdateddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.ScoreUpdate} returns this
  */
-proto.Enrollment.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.ScoreUpdate.prototype.setUpdateddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
+
 /**
- * optional Course course = 7;
- * @return {?proto.Course}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.Enrollment.prototype.getCourse = function() {
-  return /** @type{?proto.Course} */ (
-    jspb.Message.getWrapperField(this, proto.Course, 7));
+proto.Regrades.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Regrades.prototype.toObject = function(opt_includeInstance) {
+  return proto.Regrades.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.Course|undefined} value
- * @return {!proto.Enrollment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Regrades} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Regrades.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    regradesList: jspb.Message.toObjectList(msg.getRegradesList(),
+    proto.Regrade.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Regrades}
+ */
+proto.Regrades.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Regrades;
+  return proto.Regrades.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Regrades} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Regrades}
+ */
+proto.Regrades.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Regrade;
+      reader.readMessage(value,proto.Regrade.deserializeBinaryFromReader);
+      msg.addRegrades(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Regrades.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Regrades.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Regrades} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Regrades.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRegradesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Regrade.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Regrade regrades = 1;
+ * @return {!Array<!proto.Regrade>}
+ */
+proto.Regrades.prototype.getRegradesList = function() {
+  return /** @type{!Array<!proto.Regrade>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Regrade, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.Regrade>} value
+ * @return {!proto.Regrades} returns this
 */
-proto.Enrollment.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+proto.Regrades.prototype.setRegradesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.Enrollment} returns this
+ * @param {!proto.Regrade=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Regrade}
+ */
+proto.Regrades.prototype.addRegrades = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Regrade, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Regrades} returns this
+ */
+proto.Regrades.prototype.clearRegradesList = function() {
+  return this.setRegradesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ReviewRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ReviewRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
+proto.ReviewRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    review: (f = msg.getReview()) && proto.Review.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ReviewRequest}
  */
-proto.Enrollment.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.ReviewRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ReviewRequest;
+  return proto.ReviewRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional Group group = 8;
- * @return {?proto.Group}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ReviewRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ReviewRequest}
  */
-proto.Enrollment.prototype.getGroup = function() {
-  return /** @type{?proto.Group} */ (
-    jspb.Message.getWrapperField(this, proto.Group, 8));
+proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = new proto.Review;
+      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
+      msg.setReview(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {?proto.Group|undefined} value
- * @return {!proto.Enrollment} returns this
-*/
-proto.Enrollment.prototype.setGroup = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ReviewRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ReviewRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.Enrollment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ReviewRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.clearGroup = function() {
-  return this.setGroup(undefined);
+proto.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getReview();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.Review.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Enrollment.prototype.hasGroup = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.ReviewRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional UserStatus status = 9;
- * @return {!proto.Enrollment.UserStatus}
+ * @param {number} value
+ * @return {!proto.ReviewRequest} returns this
  */
-proto.Enrollment.prototype.getStatus = function() {
-  return /** @type {!proto.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.ReviewRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Enrollment.UserStatus} value
- * @return {!proto.Enrollment} returns this
+ * optional Review review = 2;
+ * @return {?proto.Review}
  */
-proto.Enrollment.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
+proto.ReviewRequest.prototype.getReview = function() {
+  return /** @type{?proto.Review} */ (
+    jspb.Message.getWrapperField(this, proto.Review, 2));
 };
 
 
 /**
- * optional DisplayState state = 10;
- * @return {!proto.Enrollment.DisplayState}
- */
-proto.Enrollment.prototype.getState = function() {
-  return /** @type {!proto.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+ * @param {?proto.Review|undefined} value
+ * @return {!proto.ReviewRequest} returns this
+*/
+proto.ReviewRequest.prototype.setReview = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.Enrollment.DisplayState} value
- * @return {!proto.Enrollment} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.ReviewRequest} returns this
  */
-proto.Enrollment.prototype.setState = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.ReviewRequest.prototype.clearReview = function() {
+  return this.setReview(undefined);
 };
 
 
 /**
- * optional uint32 slipDaysRemaining = 11;
- * @return {number}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.Enrollment.prototype.getSlipdaysremaining = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.ReviewRequest.prototype.hasReview = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
- */
-proto.Enrollment.prototype.setSlipdaysremaining = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string lastActivityDate = 12;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Enrollment.prototype.getLastactivitydate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.CourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Enrollment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CourseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollment.prototype.setLastactivitydate = function(value) {
-  return jspb.Message.setProto3StringField(this, 12, value);
+proto.CourseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint64 totalApproved = 13;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CourseRequest}
  */
-proto.Enrollment.prototype.getTotalapproved = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.CourseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CourseRequest;
+  return proto.CourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Enrollment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CourseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CourseRequest}
  */
-proto.Enrollment.prototype.setTotalapproved = function(value) {
-  return jspb.Message.setProto3IntField(this, 13, value);
+proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated UsedSlipDays usedSlipDays = 14;
- * @return {!Array<!proto.UsedSlipDays>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Enrollment.prototype.getUsedslipdaysList = function() {
-  return /** @type{!Array<!proto.UsedSlipDays>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.UsedSlipDays, 14));
+proto.CourseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CourseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<!proto.UsedSlipDays>} value
- * @return {!proto.Enrollment} returns this
-*/
-proto.Enrollment.prototype.setUsedslipdaysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CourseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.UsedSlipDays=} opt_value
- * @param {number=} opt_index
- * @return {!proto.UsedSlipDays}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.UsedSlipDays, opt_index);
+proto.CourseRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Enrollment} returns this
+ * @param {number} value
+ * @return {!proto.CourseRequest} returns this
  */
-proto.Enrollment.prototype.clearUsedslipdaysList = function() {
-  return this.setUsedslipdaysList([]);
+proto.CourseRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -4188,8 +11148,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
-  return proto.UsedSlipDays.toObject(opt_includeInstance, this);
+proto.UserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4198,16 +11158,13 @@ proto.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UsedSlipDays} msg The msg instance to transform.
+ * @param {!proto.UserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UsedSlipDays.toObject = function(includeInstance, msg) {
+proto.UserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -4221,23 +11178,23 @@ proto.UsedSlipDays.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UsedSlipDays}
+ * @return {!proto.UserRequest}
  */
-proto.UsedSlipDays.deserializeBinary = function(bytes) {
+proto.UserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UsedSlipDays;
-  return proto.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.UserRequest;
+  return proto.UserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!proto.UserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UsedSlipDays}
+ * @return {!proto.UserRequest}
  */
-proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4246,19 +11203,7 @@ proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setEnrollmentid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setUsedslipdays(value);
+      msg.setUserid(value);
       break;
     default:
       reader.skipField();
@@ -4273,9 +11218,9 @@ proto.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UsedSlipDays.prototype.serializeBinary = function() {
+proto.UserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  proto.UserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4283,122 +11228,40 @@ proto.UsedSlipDays.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UsedSlipDays} message
+ * @param {!proto.UserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+proto.UserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getEnrollmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getUsedslipdays();
-  if (f !== 0) {
-    writer.writeUint32(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.UsedSlipDays.prototype.getId = function() {
+proto.UserRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
+ * @return {!proto.UserRequest} returns this
  */
-proto.UsedSlipDays.prototype.setId = function(value) {
+proto.UserRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
-/**
- * optional uint64 enrollmentID = 2;
- * @return {number}
- */
-proto.UsedSlipDays.prototype.getEnrollmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
- */
-proto.UsedSlipDays.prototype.setEnrollmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 assignmentID = 3;
- * @return {number}
- */
-proto.UsedSlipDays.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
- */
-proto.UsedSlipDays.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional uint32 usedSlipDays = 4;
- * @return {number}
- */
-proto.UsedSlipDays.prototype.getUsedslipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UsedSlipDays} returns this
- */
-proto.UsedSlipDays.prototype.setUsedslipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Enrollments.repeatedFields_ = [1];
 
 
 
@@ -4415,8 +11278,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Enrollments.prototype.toObject = function(opt_includeInstance) {
-  return proto.Enrollments.toObject(opt_includeInstance, this);
+proto.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.GetGroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4425,14 +11288,13 @@ proto.Enrollments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Enrollments} msg The msg instance to transform.
+ * @param {!proto.GetGroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollments.toObject = function(includeInstance, msg) {
+proto.GetGroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.Enrollment.toObject, includeInstance)
+    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -4446,23 +11308,23 @@ proto.Enrollments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Enrollments}
+ * @return {!proto.GetGroupRequest}
  */
-proto.Enrollments.deserializeBinary = function(bytes) {
+proto.GetGroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Enrollments;
-  return proto.Enrollments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GetGroupRequest;
+  return proto.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Enrollments} msg The message object to deserialize into.
+ * @param {!proto.GetGroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Enrollments}
+ * @return {!proto.GetGroupRequest}
  */
-proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4470,9 +11332,8 @@ proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
       break;
     default:
       reader.skipField();
@@ -4487,9 +11348,9 @@ proto.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Enrollments.prototype.serializeBinary = function() {
+proto.GetGroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Enrollments.serializeBinaryToWriter(this, writer);
+  proto.GetGroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4497,58 +11358,37 @@ proto.Enrollments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Enrollments} message
+ * @param {!proto.GetGroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Enrollments.serializeBinaryToWriter = function(message, writer) {
+proto.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated Enrollment enrollments = 1;
- * @return {!Array<!proto.Enrollment>}
- */
-proto.Enrollments.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Enrollment, 1));
-};
-
-
-/**
- * @param {!Array<!proto.Enrollment>} value
- * @return {!proto.Enrollments} returns this
-*/
-proto.Enrollments.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Enrollment}
+ * optional uint64 groupID = 1;
+ * @return {number}
  */
-proto.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Enrollment, opt_index);
+proto.GetGroupRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Enrollments} returns this
+ * @param {number} value
+ * @return {!proto.GetGroupRequest} returns this
  */
-proto.Enrollments.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.GetGroupRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -4568,8 +11408,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionLink.toObject(opt_includeInstance, this);
+proto.GroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.GroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4578,14 +11418,15 @@ proto.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionLink} msg The msg instance to transform.
+ * @param {!proto.GroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionLink.toObject = function(includeInstance, msg) {
+proto.GroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignment: (f = msg.getAssignment()) && proto.Assignment.toObject(includeInstance, f),
-    submission: (f = msg.getSubmission()) && proto.Submission.toObject(includeInstance, f)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -4599,23 +11440,23 @@ proto.SubmissionLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionLink}
+ * @return {!proto.GroupRequest}
  */
-proto.SubmissionLink.deserializeBinary = function(bytes) {
+proto.GroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionLink;
-  return proto.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.GroupRequest;
+  return proto.GroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionLink} msg The message object to deserialize into.
+ * @param {!proto.GroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionLink}
+ * @return {!proto.GroupRequest}
  */
-proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4623,14 +11464,16 @@ proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Assignment;
-      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
-      msg.setAssignment(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
       break;
     case 2:
-      var value = new proto.Submission;
-      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
-      msg.setSubmission(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -4645,9 +11488,9 @@ proto.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionLink.prototype.serializeBinary = function() {
+proto.GroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionLink.serializeBinaryToWriter(this, writer);
+  proto.GroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4655,113 +11498,91 @@ proto.SubmissionLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionLink} message
+ * @param {!proto.GroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+proto.GroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignment();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Assignment.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSubmission();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
-      f,
-      proto.Submission.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * optional Assignment assignment = 1;
- * @return {?proto.Assignment}
+ * optional uint64 userID = 1;
+ * @return {number}
  */
-proto.SubmissionLink.prototype.getAssignment = function() {
-  return /** @type{?proto.Assignment} */ (
-    jspb.Message.getWrapperField(this, proto.Assignment, 1));
-};
-
-
-/**
- * @param {?proto.Assignment|undefined} value
- * @return {!proto.SubmissionLink} returns this
-*/
-proto.SubmissionLink.prototype.setAssignment = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.GroupRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.SubmissionLink} returns this
+ * @param {number} value
+ * @return {!proto.GroupRequest} returns this
  */
-proto.SubmissionLink.prototype.clearAssignment = function() {
-  return this.setAssignment(undefined);
+proto.GroupRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint64 groupID = 2;
+ * @return {number}
  */
-proto.SubmissionLink.prototype.hasAssignment = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.GroupRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional Submission submission = 2;
- * @return {?proto.Submission}
+ * @param {number} value
+ * @return {!proto.GroupRequest} returns this
  */
-proto.SubmissionLink.prototype.getSubmission = function() {
-  return /** @type{?proto.Submission} */ (
-    jspb.Message.getWrapperField(this, proto.Submission, 2));
-};
-
-
-/**
- * @param {?proto.Submission|undefined} value
- * @return {!proto.SubmissionLink} returns this
-*/
-proto.SubmissionLink.prototype.setSubmission = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.GroupRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.SubmissionLink} returns this
+ * optional uint64 courseID = 3;
+ * @return {number}
  */
-proto.SubmissionLink.prototype.clearSubmission = function() {
-  return this.setSubmission(undefined);
+proto.GroupRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.GroupRequest} returns this
  */
-proto.SubmissionLink.prototype.hasSubmission = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.GroupRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.EnrollmentLink.repeatedFields_ = [3];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4777,8 +11598,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.EnrollmentLink.toObject(opt_includeInstance, this);
+proto.Provider.prototype.toObject = function(opt_includeInstance) {
+  return proto.Provider.toObject(opt_includeInstance, this);
 };
 
 
@@ -4787,15 +11608,13 @@ proto.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.EnrollmentLink} msg The msg instance to transform.
+ * @param {!proto.Provider} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentLink.toObject = function(includeInstance, msg) {
+proto.Provider.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollment: (f = msg.getEnrollment()) && proto.Enrollment.toObject(includeInstance, f),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.SubmissionLink.toObject, includeInstance)
+    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4809,38 +11628,32 @@ proto.EnrollmentLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.EnrollmentLink}
+ * @return {!proto.Provider}
  */
-proto.EnrollmentLink.deserializeBinary = function(bytes) {
+proto.Provider.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.EnrollmentLink;
-  return proto.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Provider;
+  return proto.Provider.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!proto.Provider} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.EnrollmentLink}
+ * @return {!proto.Provider}
  */
-proto.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 2:
-      var value = new proto.Enrollment;
-      reader.readMessage(value,proto.Enrollment.deserializeBinaryFromReader);
-      msg.setEnrollment(value);
-      break;
-    case 3:
-      var value = new proto.SubmissionLink;
-      reader.readMessage(value,proto.SubmissionLink.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
       break;
     default:
       reader.skipField();
@@ -4855,9 +11668,9 @@ proto.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.EnrollmentLink.prototype.serializeBinary = function() {
+proto.Provider.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  proto.Provider.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4865,114 +11678,41 @@ proto.EnrollmentLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.EnrollmentLink} message
+ * @param {!proto.Provider} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+proto.Provider.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollment();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.Enrollment.serializeBinaryToWriter
-    );
-  }
-  f = message.getSubmissionsList();
+  f = message.getProvider();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.SubmissionLink.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional Enrollment enrollment = 2;
- * @return {?proto.Enrollment}
- */
-proto.EnrollmentLink.prototype.getEnrollment = function() {
-  return /** @type{?proto.Enrollment} */ (
-    jspb.Message.getWrapperField(this, proto.Enrollment, 2));
-};
-
-
-/**
- * @param {?proto.Enrollment|undefined} value
- * @return {!proto.EnrollmentLink} returns this
-*/
-proto.EnrollmentLink.prototype.setEnrollment = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.EnrollmentLink} returns this
- */
-proto.EnrollmentLink.prototype.clearEnrollment = function() {
-  return this.setEnrollment(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.EnrollmentLink.prototype.hasEnrollment = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * repeated SubmissionLink submissions = 3;
- * @return {!Array<!proto.SubmissionLink>}
- */
-proto.EnrollmentLink.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.SubmissionLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.SubmissionLink, 3));
-};
-
-
-/**
- * @param {!Array<!proto.SubmissionLink>} value
- * @return {!proto.EnrollmentLink} returns this
-*/
-proto.EnrollmentLink.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.SubmissionLink=} opt_value
- * @param {number=} opt_index
- * @return {!proto.SubmissionLink}
+ * optional string provider = 1;
+ * @return {string}
  */
-proto.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.SubmissionLink, opt_index);
+proto.Provider.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.EnrollmentLink} returns this
+ * @param {string} value
+ * @return {!proto.Provider} returns this
  */
-proto.EnrollmentLink.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.Provider.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.CourseSubmissions.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4988,8 +11728,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.CourseSubmissions.toObject(opt_includeInstance, this);
+proto.OrgRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.OrgRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4998,15 +11738,13 @@ proto.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.CourseSubmissions} msg The msg instance to transform.
+ * @param {!proto.OrgRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseSubmissions.toObject = function(includeInstance, msg) {
+proto.OrgRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    course: (f = msg.getCourse()) && proto.Course.toObject(includeInstance, f),
-    linksList: jspb.Message.toObjectList(msg.getLinksList(),
-    proto.EnrollmentLink.toObject, includeInstance)
+    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5020,23 +11758,23 @@ proto.CourseSubmissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.CourseSubmissions}
+ * @return {!proto.OrgRequest}
  */
-proto.CourseSubmissions.deserializeBinary = function(bytes) {
+proto.OrgRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.CourseSubmissions;
-  return proto.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.OrgRequest;
+  return proto.OrgRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!proto.OrgRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.CourseSubmissions}
+ * @return {!proto.OrgRequest}
  */
-proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5044,14 +11782,8 @@ proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Course;
-      reader.readMessage(value,proto.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
-      break;
-    case 2:
-      var value = new proto.EnrollmentLink;
-      reader.readMessage(value,proto.EnrollmentLink.deserializeBinaryFromReader);
-      msg.addLinks(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrgname(value);
       break;
     default:
       reader.skipField();
@@ -5066,9 +11798,9 @@ proto.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.CourseSubmissions.prototype.serializeBinary = function() {
+proto.OrgRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  proto.OrgRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5076,114 +11808,41 @@ proto.CourseSubmissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.CourseSubmissions} message
+ * @param {!proto.OrgRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+proto.OrgRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getLinksList();
+  f = message.getOrgname();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.EnrollmentLink.serializeBinaryToWriter
+    writer.writeString(
+      1,
+      f
     );
   }
 };
 
 
 /**
- * optional Course course = 1;
- * @return {?proto.Course}
- */
-proto.CourseSubmissions.prototype.getCourse = function() {
-  return /** @type{?proto.Course} */ (
-    jspb.Message.getWrapperField(this, proto.Course, 1));
-};
-
-
-/**
- * @param {?proto.Course|undefined} value
- * @return {!proto.CourseSubmissions} returns this
-*/
-proto.CourseSubmissions.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.CourseSubmissions} returns this
- */
-proto.CourseSubmissions.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.CourseSubmissions.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * repeated EnrollmentLink links = 2;
- * @return {!Array<!proto.EnrollmentLink>}
- */
-proto.CourseSubmissions.prototype.getLinksList = function() {
-  return /** @type{!Array<!proto.EnrollmentLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.EnrollmentLink, 2));
-};
-
-
-/**
- * @param {!Array<!proto.EnrollmentLink>} value
- * @return {!proto.CourseSubmissions} returns this
-*/
-proto.CourseSubmissions.prototype.setLinksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
-};
-
-
-/**
- * @param {!proto.EnrollmentLink=} opt_value
- * @param {number=} opt_index
- * @return {!proto.EnrollmentLink}
+ * optional string orgName = 1;
+ * @return {string}
  */
-proto.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.EnrollmentLink, opt_index);
+proto.OrgRequest.prototype.getOrgname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.CourseSubmissions} returns this
+ * @param {string} value
+ * @return {!proto.OrgRequest} returns this
  */
-proto.CourseSubmissions.prototype.clearLinksList = function() {
-  return this.setLinksList([]);
+proto.OrgRequest.prototype.setOrgname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Assignment.repeatedFields_ = [12,13];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5199,8 +11858,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Assignment.prototype.toObject = function(opt_includeInstance) {
-  return proto.Assignment.toObject(opt_includeInstance, this);
+proto.Organization.prototype.toObject = function(opt_includeInstance) {
+  return proto.Organization.toObject(opt_includeInstance, this);
 };
 
 
@@ -5209,28 +11868,16 @@ proto.Assignment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Assignment} msg The msg instance to transform.
+ * @param {!proto.Organization} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.toObject = function(includeInstance, msg) {
+proto.Organization.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    skiptests: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.Submission.toObject, includeInstance),
-    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
-    proto.GradingBenchmark.toObject, includeInstance),
-    containertimeout: jspb.Message.getFieldWithDefault(msg, 14, 0)
+    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -5244,23 +11891,23 @@ proto.Assignment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Assignment}
+ * @return {!proto.Organization}
  */
-proto.Assignment.deserializeBinary = function(bytes) {
+proto.Organization.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Assignment;
-  return proto.Assignment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Organization;
+  return proto.Organization.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Assignment} msg The message object to deserialize into.
+ * @param {!proto.Organization} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Assignment}
+ * @return {!proto.Organization}
  */
-proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5272,58 +11919,16 @@ proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setScriptfile(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeadline(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAutoapprove(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setOrder(value);
-      break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsgrouplab(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setReviewers(value);
-      break;
-    case 11:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSkiptests(value);
-      break;
-    case 12:
-      var value = new proto.Submission;
-      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
-      break;
-    case 13:
-      var value = new proto.GradingBenchmark;
-      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addGradingbenchmarks(value);
+      msg.setPath(value);
       break;
-    case 14:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setContainertimeout(value);
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatar(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPaymentplan(value);
       break;
     default:
       reader.skipField();
@@ -5338,9 +11943,9 @@ proto.Assignment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Assignment.prototype.serializeBinary = function() {
+proto.Organization.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Assignment.serializeBinaryToWriter(this, writer);
+  proto.Organization.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5348,11 +11953,11 @@ proto.Assignment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Assignment} message
+ * @param {!proto.Organization} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.serializeBinaryToWriter = function(message, writer) {
+proto.Organization.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -5361,99 +11966,27 @@ proto.Assignment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getName();
+  f = message.getAvatar();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getScriptfile();
+  f = message.getPaymentplan();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getDeadline();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getAutoapprove();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getOrder();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
-      f
-    );
-  }
-  f = message.getIsgrouplab();
-  if (f) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getScorelimit();
-  if (f !== 0) {
-    writer.writeUint32(
-      9,
-      f
-    );
-  }
-  f = message.getReviewers();
-  if (f !== 0) {
-    writer.writeUint32(
-      10,
-      f
-    );
-  }
-  f = message.getSkiptests();
-  if (f) {
-    writer.writeBool(
-      11,
-      f
-    );
-  }
-  f = message.getSubmissionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.Submission.serializeBinaryToWriter
-    );
-  }
-  f = message.getGradingbenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      13,
-      f,
-      proto.GradingBenchmark.serializeBinaryToWriter
-    );
-  }
-  f = message.getContainertimeout();
-  if (f !== 0) {
-    writer.writeUint32(
-      14,
-      f
-    );
-  }
 };
 
 
@@ -5461,291 +11994,477 @@ proto.Assignment.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.Assignment.prototype.getId = function() {
+proto.Organization.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Assignment} returns this
+ * @return {!proto.Organization} returns this
  */
-proto.Assignment.prototype.setId = function(value) {
+proto.Organization.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
- * @return {number}
+ * optional string path = 2;
+ * @return {string}
  */
-proto.Assignment.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.Organization.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * @param {string} value
+ * @return {!proto.Organization} returns this
  */
-proto.Assignment.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.Organization.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string name = 3;
+ * optional string avatar = 3;
  * @return {string}
  */
-proto.Assignment.prototype.getName = function() {
+proto.Organization.prototype.getAvatar = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.Assignment} returns this
+ * @return {!proto.Organization} returns this
  */
-proto.Assignment.prototype.setName = function(value) {
+proto.Organization.prototype.setAvatar = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string scriptFile = 4;
+ * optional string paymentPlan = 4;
  * @return {string}
  */
-proto.Assignment.prototype.getScriptfile = function() {
+proto.Organization.prototype.getPaymentplan = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.Assignment} returns this
+ * @return {!proto.Organization} returns this
  */
-proto.Assignment.prototype.setScriptfile = function(value) {
+proto.Organization.prototype.setPaymentplan = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
+
 /**
- * optional string deadline = 5;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.Assignment.prototype.getDeadline = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.Organizations.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.Organizations.prototype.toObject = function(opt_includeInstance) {
+  return proto.Organizations.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Organizations} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Organizations.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
+    proto.Organization.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Organizations}
+ */
+proto.Organizations.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Organizations;
+  return proto.Organizations.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Organizations} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Organizations}
+ */
+proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.Organization;
+      reader.readMessage(value,proto.Organization.deserializeBinaryFromReader);
+      msg.addOrganizations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.Organizations.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Organizations.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Organizations} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Organizations.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOrganizationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.Organization.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Assignment} returns this
+ * repeated Organization organizations = 1;
+ * @return {!Array<!proto.Organization>}
  */
-proto.Assignment.prototype.setDeadline = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.Organizations.prototype.getOrganizationsList = function() {
+  return /** @type{!Array<!proto.Organization>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Organization, 1));
 };
 
 
 /**
- * optional bool autoApprove = 6;
- * @return {boolean}
- */
-proto.Assignment.prototype.getAutoapprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+ * @param {!Array<!proto.Organization>} value
+ * @return {!proto.Organizations} returns this
+*/
+proto.Organizations.prototype.setOrganizationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Assignment} returns this
+ * @param {!proto.Organization=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Organization}
  */
-proto.Assignment.prototype.setAutoapprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Organization, opt_index);
 };
 
 
 /**
- * optional uint32 order = 7;
- * @return {number}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Organizations} returns this
  */
-proto.Assignment.prototype.getOrder = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.Organizations.prototype.clearOrganizationsList = function() {
+  return this.setOrganizationsList([]);
 };
 
 
-/**
- * @param {number} value
- * @return {!proto.Assignment} returns this
- */
-proto.Assignment.prototype.setOrder = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
-};
-
 
 /**
- * optional bool isGroupLab = 8;
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.Assignment.prototype.getIsgrouplab = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
-};
-
+proto.EnrollmentRequest.repeatedFields_ = [4];
 
-/**
- * @param {boolean} value
- * @return {!proto.Assignment} returns this
- */
-proto.Assignment.prototype.setIsgrouplab = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint32 scoreLimit = 9;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Assignment.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.EnrollmentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.EnrollmentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 reviewers = 10;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.EnrollmentRequest}
  */
-proto.Assignment.prototype.getReviewers = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.EnrollmentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.EnrollmentRequest;
+  return proto.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.EnrollmentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.EnrollmentRequest}
  */
-proto.Assignment.prototype.setReviewers = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoregroupmembers(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithactivity(value);
+      break;
+    case 4:
+      var value = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.readPackedEnum());
+      msg.setStatusesList(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool skipTests = 11;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Assignment.prototype.getSkiptests = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+proto.EnrollmentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.EnrollmentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Assignment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.EnrollmentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignment.prototype.setSkiptests = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 11, value);
+proto.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getIgnoregroupmembers();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getWithactivity();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated Submission submissions = 12;
- * @return {!Array<!proto.Submission>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Assignment.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 12));
+proto.EnrollmentRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Submission>} value
- * @return {!proto.Assignment} returns this
-*/
-proto.Assignment.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+ * @param {number} value
+ * @return {!proto.EnrollmentRequest} returns this
+ */
+proto.EnrollmentRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Submission}
+ * optional bool ignoreGroupMembers = 2;
+ * @return {boolean}
  */
-proto.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Submission, opt_index);
+proto.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Assignment} returns this
+ * @param {boolean} value
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.Assignment.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * repeated GradingBenchmark gradingBenchmarks = 13;
- * @return {!Array<!proto.GradingBenchmark>}
+ * optional bool withActivity = 3;
+ * @return {boolean}
  */
-proto.Assignment.prototype.getGradingbenchmarksList = function() {
-  return /** @type{!Array<!proto.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 13));
+proto.EnrollmentRequest.prototype.getWithactivity = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {!Array<!proto.GradingBenchmark>} value
- * @return {!proto.Assignment} returns this
-*/
-proto.Assignment.prototype.setGradingbenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+ * @param {boolean} value
+ * @return {!proto.EnrollmentRequest} returns this
+ */
+proto.EnrollmentRequest.prototype.setWithactivity = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.GradingBenchmark}
+ * repeated Enrollment.UserStatus statuses = 4;
+ * @return {!Array<!proto.Enrollment.UserStatus>}
  */
-proto.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.GradingBenchmark, opt_index);
+proto.EnrollmentRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Assignment} returns this
+ * @param {!Array<!proto.Enrollment.UserStatus>} value
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.Assignment.prototype.clearGradingbenchmarksList = function() {
-  return this.setGradingbenchmarksList([]);
+proto.EnrollmentRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * optional uint32 containerTimeout = 14;
- * @return {number}
+ * @param {!proto.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.Assignment.prototype.getContainertimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+proto.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Assignment} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentRequest} returns this
  */
-proto.Assignment.prototype.setContainertimeout = function(value) {
-  return jspb.Message.setProto3IntField(this, 14, value);
+proto.EnrollmentRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
 };
 
 
@@ -5755,7 +12474,7 @@ proto.Assignment.prototype.setContainertimeout = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.Assignments.repeatedFields_ = [1];
+proto.EnrollmentStatusRequest.repeatedFields_ = [2];
 
 
 
@@ -5772,8 +12491,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Assignments.prototype.toObject = function(opt_includeInstance) {
-  return proto.Assignments.toObject(opt_includeInstance, this);
+proto.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5782,14 +12501,14 @@ proto.Assignments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Assignments} msg The msg instance to transform.
+ * @param {!proto.EnrollmentStatusRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignments.toObject = function(includeInstance, msg) {
+proto.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.Assignment.toObject, includeInstance)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -5803,23 +12522,23 @@ proto.Assignments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Assignments}
+ * @return {!proto.EnrollmentStatusRequest}
  */
-proto.Assignments.deserializeBinary = function(bytes) {
+proto.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Assignments;
-  return proto.Assignments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.EnrollmentStatusRequest;
+  return proto.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Assignments} msg The message object to deserialize into.
+ * @param {!proto.EnrollmentStatusRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Assignments}
+ * @return {!proto.EnrollmentStatusRequest}
  */
-proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5827,9 +12546,12 @@ proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Assignment;
-      reader.readMessage(value,proto.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 2:
+      var value = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.readPackedEnum());
+      msg.setStatusesList(value);
       break;
     default:
       reader.skipField();
@@ -5844,9 +12566,9 @@ proto.Assignments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Assignments.prototype.serializeBinary = function() {
+proto.EnrollmentStatusRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Assignments.serializeBinaryToWriter(this, writer);
+  proto.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5854,68 +12576,84 @@ proto.Assignments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Assignments} message
+ * @param {!proto.EnrollmentStatusRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Assignments.serializeBinaryToWriter = function(message, writer) {
+proto.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Assignment.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated Assignment assignments = 1;
- * @return {!Array<!proto.Assignment>}
+ * optional uint64 userID = 1;
+ * @return {number}
  */
-proto.Assignments.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Assignment, 1));
+proto.EnrollmentStatusRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Assignment>} value
- * @return {!proto.Assignments} returns this
-*/
-proto.Assignments.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.EnrollmentStatusRequest} returns this
+ */
+proto.EnrollmentStatusRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Assignment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Assignment}
+ * repeated Enrollment.UserStatus statuses = 2;
+ * @return {!Array<!proto.Enrollment.UserStatus>}
  */
-proto.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Assignment, opt_index);
+proto.EnrollmentStatusRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Assignments} returns this
+ * @param {!Array<!proto.Enrollment.UserStatus>} value
+ * @return {!proto.EnrollmentStatusRequest} returns this
  */
-proto.Assignments.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
+/**
+ * @param {!proto.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.EnrollmentStatusRequest} returns this
+ */
+proto.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.EnrollmentStatusRequest} returns this
  */
-proto.Submission.repeatedFields_ = [12];
+proto.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
 
 
 
@@ -5932,8 +12670,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Submission.prototype.toObject = function(opt_includeInstance) {
-  return proto.Submission.toObject(opt_includeInstance, this);
+proto.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5942,25 +12680,15 @@ proto.Submission.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Submission} msg The msg instance to transform.
+ * @param {!proto.SubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.toObject = function(includeInstance, msg) {
+proto.SubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    scoreobjects: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    buildinfo: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    commithash: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
-    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    approveddate: jspb.Message.getFieldWithDefault(msg, 11, ""),
-    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
-    proto.Review.toObject, includeInstance)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -5974,23 +12702,23 @@ proto.Submission.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Submission}
+ * @return {!proto.SubmissionRequest}
  */
-proto.Submission.deserializeBinary = function(bytes) {
+proto.SubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Submission;
-  return proto.Submission.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionRequest;
+  return proto.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Submission} msg The message object to deserialize into.
+ * @param {!proto.SubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Submission}
+ * @return {!proto.SubmissionRequest}
  */
-proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5999,52 +12727,15 @@ proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setUserid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      msg.setGroupid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setScoreobjects(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBuildinfo(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommithash(value);
-      break;
-    case 9:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApproveddate(value);
-      break;
-    case 12:
-      var value = new proto.Review;
-      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
-      msg.addReviews(value);
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -6059,9 +12750,9 @@ proto.Submission.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Submission.prototype.serializeBinary = function() {
+proto.SubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Submission.serializeBinaryToWriter(this, writer);
+  proto.SubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6069,354 +12760,341 @@ proto.Submission.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Submission} message
+ * @param {!proto.SubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
   f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
-      3,
+      1,
       f
     );
   }
   f = message.getGroupid();
   if (f !== 0) {
     writer.writeUint64(
-      4,
+      2,
       f
     );
   }
-  f = message.getScore();
+  f = message.getCourseid();
   if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getScoreobjects();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getBuildinfo();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getCommithash();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      9,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
-    );
-  }
-  f = message.getApproveddate();
-  if (f.length > 0) {
-    writer.writeString(
-      11,
+    writer.writeUint64(
+      3,
       f
     );
   }
-  f = message.getReviewsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.Review.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * @enum {number}
- */
-proto.Submission.Status = {
-  NONE: 0,
-  APPROVED: 1,
-  REJECTED: 2,
-  REVISION: 3
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.Submission.prototype.getId = function() {
+proto.SubmissionRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Submission} returns this
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.Submission.prototype.setId = function(value) {
+proto.SubmissionRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
+ * optional uint64 groupID = 2;
  * @return {number}
  */
-proto.Submission.prototype.getAssignmentid = function() {
+proto.SubmissionRequest.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Submission} returns this
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.Submission.prototype.setAssignmentid = function(value) {
+proto.SubmissionRequest.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 userID = 3;
+ * optional uint64 courseID = 3;
  * @return {number}
  */
-proto.Submission.prototype.getUserid = function() {
+proto.SubmissionRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Submission} returns this
+ * @return {!proto.SubmissionRequest} returns this
  */
-proto.Submission.prototype.setUserid = function(value) {
+proto.SubmissionRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * optional uint64 groupID = 4;
- * @return {number}
- */
-proto.Submission.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
 
-/**
- * @param {number} value
- * @return {!proto.Submission} returns this
- */
-proto.Submission.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint32 score = 5;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Submission.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Submission} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.UpdateSubmissionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
-
+proto.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
 
-/**
- * optional string scoreObjects = 6;
- * @return {string}
- */
-proto.Submission.prototype.getScoreobjects = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @return {!proto.Submission} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.UpdateSubmissionRequest}
  */
-proto.Submission.prototype.setScoreobjects = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.UpdateSubmissionRequest;
+  return proto.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional string buildInfo = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.UpdateSubmissionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.UpdateSubmissionRequest}
  */
-proto.Submission.prototype.getBuildinfo = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Submission} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Submission.prototype.setBuildinfo = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.UpdateSubmissionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string commitHash = 8;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.UpdateSubmissionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submission.prototype.getCommithash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Submission} returns this
+ * optional uint64 submissionID = 1;
+ * @return {number}
  */
-proto.Submission.prototype.setCommithash = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional bool released = 9;
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Submission.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+proto.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.Submission} returns this
+ * optional uint64 courseID = 2;
+ * @return {number}
  */
-proto.Submission.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 9, value);
+proto.UpdateSubmissionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional Status status = 10;
- * @return {!proto.Submission.Status}
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Submission.prototype.getStatus = function() {
-  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.Submission.Status} value
- * @return {!proto.Submission} returns this
+ * optional uint32 score = 3;
+ * @return {number}
  */
-proto.Submission.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.UpdateSubmissionRequest.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional string approvedDate = 11;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Submission.prototype.getApproveddate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.UpdateSubmissionRequest.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Submission} returns this
+ * optional bool released = 4;
+ * @return {boolean}
  */
-proto.Submission.prototype.setApproveddate = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
+proto.UpdateSubmissionRequest.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * repeated Review reviews = 12;
- * @return {!Array<!proto.Review>}
+ * @param {boolean} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Submission.prototype.getReviewsList = function() {
-  return /** @type{!Array<!proto.Review>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Review, 12));
-};
-
-
-/**
- * @param {!Array<!proto.Review>} value
- * @return {!proto.Submission} returns this
-*/
-proto.Submission.prototype.setReviewsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+proto.UpdateSubmissionRequest.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.Review=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Review}
+ * optional Submission.Status status = 5;
+ * @return {!proto.Submission.Status}
  */
-proto.Submission.prototype.addReviews = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.Review, opt_index);
+proto.UpdateSubmissionRequest.prototype.getStatus = function() {
+  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Submission} returns this
+ * @param {!proto.Submission.Status} value
+ * @return {!proto.UpdateSubmissionRequest} returns this
  */
-proto.Submission.prototype.clearReviewsList = function() {
-  return this.setReviewsList([]);
+proto.UpdateSubmissionRequest.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Submissions.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6432,8 +13110,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Submissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.Submissions.toObject(opt_includeInstance, this);
+proto.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6442,14 +13120,17 @@ proto.Submissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Submissions} msg The msg instance to transform.
+ * @param {!proto.UpdateSubmissionsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submissions.toObject = function(includeInstance, msg) {
+proto.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.Submission.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -6463,23 +13144,23 @@ proto.Submissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Submissions}
+ * @return {!proto.UpdateSubmissionsRequest}
  */
-proto.Submissions.deserializeBinary = function(bytes) {
+proto.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Submissions;
-  return proto.Submissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.UpdateSubmissionsRequest;
+  return proto.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Submissions} msg The message object to deserialize into.
+ * @param {!proto.UpdateSubmissionsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Submissions}
+ * @return {!proto.UpdateSubmissionsRequest}
  */
-proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6487,9 +13168,24 @@ proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Submission;
-      reader.readMessage(value,proto.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRelease(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApprove(value);
       break;
     default:
       reader.skipField();
@@ -6504,9 +13200,9 @@ proto.Submissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Submissions.prototype.serializeBinary = function() {
+proto.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Submissions.serializeBinaryToWriter(this, writer);
+  proto.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6514,68 +13210,140 @@ proto.Submissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Submissions} message
+ * @param {!proto.UpdateSubmissionsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Submissions.serializeBinaryToWriter = function(message, writer) {
+proto.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Submission.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getRelease();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getApprove();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated Submission submissions = 1;
- * @return {!Array<!proto.Submission>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.Submissions.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Submission, 1));
+proto.UpdateSubmissionsRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 3;
+ * @return {number}
+ */
+proto.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
+ */
+proto.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!Array<!proto.Submission>} value
- * @return {!proto.Submissions} returns this
-*/
-proto.Submissions.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * optional bool release = 4;
+ * @return {boolean}
+ */
+proto.UpdateSubmissionsRequest.prototype.getRelease = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {!proto.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Submission}
+ * @param {boolean} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
  */
-proto.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Submission, opt_index);
+proto.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Submissions} returns this
+ * optional bool approve = 5;
+ * @return {boolean}
  */
-proto.Submissions.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.UpdateSubmissionsRequest.prototype.getApprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.UpdateSubmissionsRequest} returns this
  */
-proto.GradingBenchmark.repeatedFields_ = [5];
+proto.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 
 
 
@@ -6592,8 +13360,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
-  return proto.GradingBenchmark.toObject(opt_includeInstance, this);
+proto.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6602,18 +13370,14 @@ proto.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GradingBenchmark} msg The msg instance to transform.
+ * @param {!proto.SubmissionReviewersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingBenchmark.toObject = function(includeInstance, msg) {
+proto.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    heading: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
-    proto.GradingCriterion.toObject, includeInstance)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -6627,23 +13391,23 @@ proto.GradingBenchmark.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GradingBenchmark}
+ * @return {!proto.SubmissionReviewersRequest}
  */
-proto.GradingBenchmark.deserializeBinary = function(bytes) {
+proto.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GradingBenchmark;
-  return proto.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionReviewersRequest;
+  return proto.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GradingBenchmark} msg The message object to deserialize into.
+ * @param {!proto.SubmissionReviewersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GradingBenchmark}
+ * @return {!proto.SubmissionReviewersRequest}
  */
-proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6652,24 +13416,11 @@ proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHeading(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
-      break;
-    case 5:
-      var value = new proto.GradingCriterion;
-      reader.readMessage(value,proto.GradingCriterion.deserializeBinaryFromReader);
-      msg.addCriteria(value);
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -6684,9 +13435,9 @@ proto.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GradingBenchmark.prototype.serializeBinary = function() {
+proto.SubmissionReviewersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GradingBenchmark.serializeBinaryToWriter(this, writer);
+  proto.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6694,168 +13445,72 @@ proto.GradingBenchmark.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GradingBenchmark} message
+ * @param {!proto.SubmissionReviewersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getHeading();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getComment();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getCriteriaList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      proto.GradingCriterion.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.GradingBenchmark.prototype.getId = function() {
+proto.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GradingBenchmark} returns this
+ * @return {!proto.SubmissionReviewersRequest} returns this
  */
-proto.GradingBenchmark.prototype.setId = function(value) {
+proto.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
+ * optional uint64 courseID = 2;
  * @return {number}
  */
-proto.GradingBenchmark.prototype.getAssignmentid = function() {
+proto.SubmissionReviewersRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GradingBenchmark} returns this
+ * @return {!proto.SubmissionReviewersRequest} returns this
  */
-proto.GradingBenchmark.prototype.setAssignmentid = function(value) {
+proto.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
-/**
- * optional string heading = 3;
- * @return {string}
- */
-proto.GradingBenchmark.prototype.getHeading = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.GradingBenchmark} returns this
- */
-proto.GradingBenchmark.prototype.setHeading = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string comment = 4;
- * @return {string}
- */
-proto.GradingBenchmark.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.GradingBenchmark} returns this
- */
-proto.GradingBenchmark.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * repeated GradingCriterion criteria = 5;
- * @return {!Array<!proto.GradingCriterion>}
- */
-proto.GradingBenchmark.prototype.getCriteriaList = function() {
-  return /** @type{!Array<!proto.GradingCriterion>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingCriterion, 5));
-};
-
-
-/**
- * @param {!Array<!proto.GradingCriterion>} value
- * @return {!proto.GradingBenchmark} returns this
-*/
-proto.GradingBenchmark.prototype.setCriteriaList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
-};
-
-
-/**
- * @param {!proto.GradingCriterion=} opt_value
- * @param {number=} opt_index
- * @return {!proto.GradingCriterion}
- */
-proto.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.GradingCriterion, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.GradingBenchmark} returns this
- */
-proto.GradingBenchmark.prototype.clearCriteriaList = function() {
-  return this.setCriteriaList([]);
-};
-
-
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.Benchmarks.repeatedFields_ = [1];
+proto.Providers.repeatedFields_ = [1];
 
 
 
@@ -6872,8 +13527,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Benchmarks.prototype.toObject = function(opt_includeInstance) {
-  return proto.Benchmarks.toObject(opt_includeInstance, this);
+proto.Providers.prototype.toObject = function(opt_includeInstance) {
+  return proto.Providers.toObject(opt_includeInstance, this);
 };
 
 
@@ -6882,14 +13537,13 @@ proto.Benchmarks.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Benchmarks} msg The msg instance to transform.
+ * @param {!proto.Providers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Benchmarks.toObject = function(includeInstance, msg) {
+proto.Providers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.GradingBenchmark.toObject, includeInstance)
+    providersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6903,23 +13557,23 @@ proto.Benchmarks.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Benchmarks}
+ * @return {!proto.Providers}
  */
-proto.Benchmarks.deserializeBinary = function(bytes) {
+proto.Providers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Benchmarks;
-  return proto.Benchmarks.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Providers;
+  return proto.Providers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Benchmarks} msg The message object to deserialize into.
+ * @param {!proto.Providers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Benchmarks}
+ * @return {!proto.Providers}
  */
-proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
+proto.Providers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6927,9 +13581,8 @@ proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.GradingBenchmark;
-      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addProviders(value);
       break;
     default:
       reader.skipField();
@@ -6944,9 +13597,9 @@ proto.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Benchmarks.prototype.serializeBinary = function() {
+proto.Providers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Benchmarks.serializeBinaryToWriter(this, writer);
+  proto.Providers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6954,62 +13607,67 @@ proto.Benchmarks.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Benchmarks} message
+ * @param {!proto.Providers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Benchmarks.serializeBinaryToWriter = function(message, writer) {
+proto.Providers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBenchmarksList();
+  f = message.getProvidersList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeRepeatedString(
       1,
-      f,
-      proto.GradingBenchmark.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated GradingBenchmark benchmarks = 1;
- * @return {!Array<!proto.GradingBenchmark>}
+ * repeated string providers = 1;
+ * @return {!Array<string>}
  */
-proto.Benchmarks.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 1));
+proto.Providers.prototype.getProvidersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<!proto.GradingBenchmark>} value
- * @return {!proto.Benchmarks} returns this
-*/
-proto.Benchmarks.prototype.setBenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.Providers} returns this
+ */
+proto.Providers.prototype.setProvidersList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.GradingBenchmark=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.GradingBenchmark}
+ * @return {!proto.Providers} returns this
  */
-proto.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.GradingBenchmark, opt_index);
+proto.Providers.prototype.addProviders = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.Benchmarks} returns this
+ * @return {!proto.Providers} returns this
  */
-proto.Benchmarks.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
+proto.Providers.prototype.clearProvidersList = function() {
+  return this.setProvidersList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.URLRequest.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7025,8 +13683,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
-  return proto.GradingCriterion.toObject(opt_includeInstance, this);
+proto.URLRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.URLRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7035,18 +13693,14 @@ proto.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GradingCriterion} msg The msg instance to transform.
+ * @param {!proto.URLRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingCriterion.toObject = function(includeInstance, msg) {
+proto.URLRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    points: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    benchmarkid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -7060,23 +13714,23 @@ proto.GradingCriterion.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GradingCriterion}
+ * @return {!proto.URLRequest}
  */
-proto.GradingCriterion.deserializeBinary = function(bytes) {
+proto.URLRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GradingCriterion;
-  return proto.GradingCriterion.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.URLRequest;
+  return proto.URLRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GradingCriterion} msg The message object to deserialize into.
+ * @param {!proto.URLRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GradingCriterion}
+ * @return {!proto.URLRequest}
  */
-proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
+proto.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7085,27 +13739,11 @@ proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setPoints(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setBenchmarkid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.GradingCriterion.Grade} */ (reader.readEnum());
-      msg.setGrade(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
+      var value = /** @type {!Array<!proto.Repository.Type>} */ (reader.readPackedEnum());
+      msg.setRepotypesList(value);
       break;
     default:
       reader.skipField();
@@ -7120,9 +13758,9 @@ proto.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GradingCriterion.prototype.serializeBinary = function() {
+proto.URLRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GradingCriterion.serializeBinaryToWriter(this, writer);
+  proto.URLRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7130,51 +13768,23 @@ proto.GradingCriterion.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GradingCriterion} message
+ * @param {!proto.URLRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
+proto.URLRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPoints();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getBenchmarkid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGrade();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getComment();
+  f = message.getRepotypesList();
   if (f.length > 0) {
-    writer.writeString(
-      6,
+    writer.writePackedEnum(
+      2,
       f
     );
   }
@@ -7182,130 +13792,61 @@ proto.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * @enum {number}
- */
-proto.GradingCriterion.Grade = {
-  NONE: 0,
-  FAILED: 1,
-  PASSED: 2
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.GradingCriterion.prototype.getId = function() {
+proto.URLRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GradingCriterion} returns this
+ * @return {!proto.URLRequest} returns this
  */
-proto.GradingCriterion.prototype.setId = function(value) {
+proto.URLRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 points = 2;
- * @return {number}
- */
-proto.GradingCriterion.prototype.getPoints = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.GradingCriterion} returns this
- */
-proto.GradingCriterion.prototype.setPoints = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 benchmarkID = 3;
- * @return {number}
- */
-proto.GradingCriterion.prototype.getBenchmarkid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.GradingCriterion} returns this
- */
-proto.GradingCriterion.prototype.setBenchmarkid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional string description = 4;
- * @return {string}
- */
-proto.GradingCriterion.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.GradingCriterion} returns this
- */
-proto.GradingCriterion.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional Grade grade = 5;
- * @return {!proto.GradingCriterion.Grade}
+ * repeated Repository.Type repoTypes = 2;
+ * @return {!Array<!proto.Repository.Type>}
  */
-proto.GradingCriterion.prototype.getGrade = function() {
-  return /** @type {!proto.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.URLRequest.prototype.getRepotypesList = function() {
+  return /** @type {!Array<!proto.Repository.Type>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {!proto.GradingCriterion.Grade} value
- * @return {!proto.GradingCriterion} returns this
+ * @param {!Array<!proto.Repository.Type>} value
+ * @return {!proto.URLRequest} returns this
  */
-proto.GradingCriterion.prototype.setGrade = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.URLRequest.prototype.setRepotypesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional string comment = 6;
- * @return {string}
+ * @param {!proto.Repository.Type} value
+ * @param {number=} opt_index
+ * @return {!proto.URLRequest} returns this
  */
-proto.GradingCriterion.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.URLRequest.prototype.addRepotypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.GradingCriterion} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.URLRequest} returns this
  */
-proto.GradingCriterion.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.URLRequest.prototype.clearRepotypesList = function() {
+  return this.setRepotypesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Review.repeatedFields_ = [8];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7321,8 +13862,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Review.prototype.toObject = function(opt_includeInstance) {
-  return proto.Review.toObject(opt_includeInstance, this);
+proto.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.RepositoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7331,21 +13872,15 @@ proto.Review.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Review} msg The msg instance to transform.
+ * @param {!proto.RepositoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Review.toObject = function(includeInstance, msg) {
+proto.RepositoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    review: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    feedback: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    ready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    score: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.GradingBenchmark.toObject, includeInstance)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -7359,23 +13894,23 @@ proto.Review.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Review}
+ * @return {!proto.RepositoryRequest}
  */
-proto.Review.deserializeBinary = function(bytes) {
+proto.RepositoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Review;
-  return proto.Review.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.RepositoryRequest;
+  return proto.RepositoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Review} msg The message object to deserialize into.
+ * @param {!proto.RepositoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Review}
+ * @return {!proto.RepositoryRequest}
  */
-proto.Review.deserializeBinaryFromReader = function(msg, reader) {
+proto.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7384,36 +13919,15 @@ proto.Review.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setUserid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setGroupid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setReviewerid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setReview(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFeedback(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReady(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setScore(value);
-      break;
-    case 8:
-      var value = new proto.GradingBenchmark;
-      reader.readMessage(value,proto.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -7428,9 +13942,9 @@ proto.Review.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Review.prototype.serializeBinary = function() {
+proto.RepositoryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Review.serializeBinaryToWriter(this, writer);
+  proto.RepositoryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7438,243 +13952,223 @@ proto.Review.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Review} message
+ * @param {!proto.RepositoryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Review.serializeBinaryToWriter = function(message, writer) {
+proto.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getSubmissionid();
+  f = message.getGroupid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getReviewerid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getReview();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getFeedback();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getReady();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint64(
-      7,
-      f
-    );
-  }
-  f = message.getBenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      8,
-      f,
-      proto.GradingBenchmark.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.Review.prototype.getId = function() {
+proto.RepositoryRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Review} returns this
+ * @return {!proto.RepositoryRequest} returns this
  */
-proto.Review.prototype.setId = function(value) {
+proto.RepositoryRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 submissionID = 2;
+ * optional uint64 groupID = 2;
  * @return {number}
  */
-proto.Review.prototype.getSubmissionid = function() {
+proto.RepositoryRequest.prototype.getGroupid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Review} returns this
+ * @return {!proto.RepositoryRequest} returns this
  */
-proto.Review.prototype.setSubmissionid = function(value) {
+proto.RepositoryRequest.prototype.setGroupid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 reviewerID = 3;
+ * optional uint64 courseID = 3;
  * @return {number}
  */
-proto.Review.prototype.getReviewerid = function() {
+proto.RepositoryRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Review} returns this
+ * @return {!proto.RepositoryRequest} returns this
  */
-proto.Review.prototype.setReviewerid = function(value) {
+proto.RepositoryRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * optional string review = 4;
- * @return {string}
- */
-proto.Review.prototype.getReview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.Review} returns this
- */
-proto.Review.prototype.setReview = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
 
-/**
- * optional string feedback = 5;
- * @return {string}
- */
-proto.Review.prototype.getFeedback = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.Review} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.Review.prototype.setFeedback = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.Repositories.prototype.toObject = function(opt_includeInstance) {
+  return proto.Repositories.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional bool ready = 6;
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.Repositories} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Review.prototype.getReady = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
-};
-
+proto.Repositories.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urlsMap: (f = msg.getUrlsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
 
-/**
- * @param {boolean} value
- * @return {!proto.Review} returns this
- */
-proto.Review.prototype.setReady = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint64 score = 7;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.Repositories}
  */
-proto.Review.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.Repositories.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.Repositories;
+  return proto.Repositories.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.Review} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.Repositories} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.Repositories}
  */
-proto.Review.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.Repositories.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getUrlsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated GradingBenchmark benchmarks = 8;
- * @return {!Array<!proto.GradingBenchmark>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.Review.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.GradingBenchmark, 8));
+proto.Repositories.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.Repositories.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<!proto.GradingBenchmark>} value
- * @return {!proto.Review} returns this
-*/
-proto.Review.prototype.setBenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.Repositories} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.Repositories.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrlsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * @param {!proto.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.GradingBenchmark}
+ * map<string, string> URLs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.Review.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.GradingBenchmark, opt_index);
+proto.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Review} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.Repositories} returns this
  */
-proto.Review.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
-};
-
+proto.Repositories.prototype.clearUrlsMap = function() {
+  this.getUrlsMap().clear();
+  return this;};
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Reviewers.repeatedFields_ = [1];
 
 
 
@@ -7691,8 +14185,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Reviewers.prototype.toObject = function(opt_includeInstance) {
-  return proto.Reviewers.toObject(opt_includeInstance, this);
+proto.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.AuthorizationResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7701,14 +14195,13 @@ proto.Reviewers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Reviewers} msg The msg instance to transform.
+ * @param {!proto.AuthorizationResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Reviewers.toObject = function(includeInstance, msg) {
+proto.AuthorizationResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
-    proto.User.toObject, includeInstance)
+    isauthorized: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -7722,23 +14215,23 @@ proto.Reviewers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Reviewers}
+ * @return {!proto.AuthorizationResponse}
  */
-proto.Reviewers.deserializeBinary = function(bytes) {
+proto.AuthorizationResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Reviewers;
-  return proto.Reviewers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AuthorizationResponse;
+  return proto.AuthorizationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Reviewers} msg The message object to deserialize into.
+ * @param {!proto.AuthorizationResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Reviewers}
+ * @return {!proto.AuthorizationResponse}
  */
-proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
+proto.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7746,9 +14239,8 @@ proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.User;
-      reader.readMessage(value,proto.User.deserializeBinaryFromReader);
-      msg.addReviewers(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsauthorized(value);
       break;
     default:
       reader.skipField();
@@ -7763,9 +14255,9 @@ proto.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Reviewers.prototype.serializeBinary = function() {
+proto.AuthorizationResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Reviewers.serializeBinaryToWriter(this, writer);
+  proto.AuthorizationResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7773,58 +14265,37 @@ proto.Reviewers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Reviewers} message
+ * @param {!proto.AuthorizationResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Reviewers.serializeBinaryToWriter = function(message, writer) {
+proto.AuthorizationResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReviewersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getIsauthorized();
+  if (f) {
+    writer.writeBool(
       1,
-      f,
-      proto.User.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated User reviewers = 1;
- * @return {!Array<!proto.User>}
- */
-proto.Reviewers.prototype.getReviewersList = function() {
-  return /** @type{!Array<!proto.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.User, 1));
-};
-
-
-/**
- * @param {!Array<!proto.User>} value
- * @return {!proto.Reviewers} returns this
-*/
-proto.Reviewers.prototype.setReviewersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.User}
+ * optional bool IsAuthorized = 1;
+ * @return {boolean}
  */
-proto.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.User, opt_index);
+proto.AuthorizationResponse.prototype.getIsauthorized = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Reviewers} returns this
+ * @param {boolean} value
+ * @return {!proto.AuthorizationResponse} returns this
  */
-proto.Reviewers.prototype.clearReviewersList = function() {
-  return this.setReviewersList([]);
+proto.AuthorizationResponse.prototype.setIsauthorized = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -7844,8 +14315,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ReviewRequest.toObject(opt_includeInstance, this);
+proto.Status.prototype.toObject = function(opt_includeInstance) {
+  return proto.Status.toObject(opt_includeInstance, this);
 };
 
 
@@ -7854,14 +14325,14 @@ proto.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ReviewRequest} msg The msg instance to transform.
+ * @param {!proto.Status} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ReviewRequest.toObject = function(includeInstance, msg) {
+proto.Status.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    review: (f = msg.getReview()) && proto.Review.toObject(includeInstance, f)
+    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    error: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7875,23 +14346,23 @@ proto.ReviewRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ReviewRequest}
+ * @return {!proto.Status}
  */
-proto.ReviewRequest.deserializeBinary = function(bytes) {
+proto.Status.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ReviewRequest;
-  return proto.ReviewRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Status;
+  return proto.Status.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ReviewRequest} msg The message object to deserialize into.
+ * @param {!proto.Status} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ReviewRequest}
+ * @return {!proto.Status}
  */
-proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.Status.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7900,12 +14371,11 @@ proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setCode(value);
       break;
     case 2:
-      var value = new proto.Review;
-      reader.readMessage(value,proto.Review.deserializeBinaryFromReader);
-      msg.setReview(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -7920,9 +14390,9 @@ proto.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ReviewRequest.prototype.serializeBinary = function() {
+proto.Status.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ReviewRequest.serializeBinaryToWriter(this, writer);
+  proto.Status.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7930,82 +14400,62 @@ proto.ReviewRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ReviewRequest} message
+ * @param {!proto.Status} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
+proto.Status.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
+  f = message.getCode();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getReview();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.Review.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional uint64 courseID = 1;
+ * optional uint64 Code = 1;
  * @return {number}
  */
-proto.ReviewRequest.prototype.getCourseid = function() {
+proto.Status.prototype.getCode = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ReviewRequest} returns this
+ * @return {!proto.Status} returns this
  */
-proto.ReviewRequest.prototype.setCourseid = function(value) {
+proto.Status.prototype.setCode = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Review review = 2;
- * @return {?proto.Review}
- */
-proto.ReviewRequest.prototype.getReview = function() {
-  return /** @type{?proto.Review} */ (
-    jspb.Message.getWrapperField(this, proto.Review, 2));
-};
-
-
-/**
- * @param {?proto.Review|undefined} value
- * @return {!proto.ReviewRequest} returns this
-*/
-proto.ReviewRequest.prototype.setReview = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ReviewRequest} returns this
+ * optional string Error = 2;
+ * @return {string}
  */
-proto.ReviewRequest.prototype.clearReview = function() {
-  return this.setReview(undefined);
+proto.Status.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.Status} returns this
  */
-proto.ReviewRequest.prototype.hasReview = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.Status.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -8025,8 +14475,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.CourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.CourseRequest.toObject(opt_includeInstance, this);
+proto.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.SubmissionsForCourseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8035,13 +14485,14 @@ proto.CourseRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.CourseRequest} msg The msg instance to transform.
+ * @param {!proto.SubmissionsForCourseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseRequest.toObject = function(includeInstance, msg) {
+proto.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -8055,23 +14506,23 @@ proto.CourseRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.CourseRequest}
+ * @return {!proto.SubmissionsForCourseRequest}
  */
-proto.CourseRequest.deserializeBinary = function(bytes) {
+proto.SubmissionsForCourseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.CourseRequest;
-  return proto.CourseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.SubmissionsForCourseRequest;
+  return proto.SubmissionsForCourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.CourseRequest} msg The message object to deserialize into.
+ * @param {!proto.SubmissionsForCourseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.CourseRequest}
+ * @return {!proto.SubmissionsForCourseRequest}
  */
-proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8082,6 +14533,10 @@ proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readUint64());
       msg.setCourseid(value);
       break;
+    case 2:
+      var value = /** @type {!proto.SubmissionsForCourseRequest.Type} */ (reader.readEnum());
+      msg.setType(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8095,9 +14550,9 @@ proto.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.CourseRequest.prototype.serializeBinary = function() {
+proto.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.CourseRequest.serializeBinaryToWriter(this, writer);
+  proto.SubmissionsForCourseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8105,11 +14560,11 @@ proto.CourseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.CourseRequest} message
+ * @param {!proto.SubmissionsForCourseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -8118,27 +14573,61 @@ proto.CourseRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.SubmissionsForCourseRequest.Type = {
+  ALL: 0,
+  INDIVIDUAL: 1,
+  GROUP: 2
+};
+
 /**
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.CourseRequest.prototype.getCourseid = function() {
+proto.SubmissionsForCourseRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.CourseRequest} returns this
+ * @return {!proto.SubmissionsForCourseRequest} returns this
  */
-proto.CourseRequest.prototype.setCourseid = function(value) {
+proto.SubmissionsForCourseRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional Type type = 2;
+ * @return {!proto.SubmissionsForCourseRequest.Type}
+ */
+proto.SubmissionsForCourseRequest.prototype.getType = function() {
+  return /** @type {!proto.SubmissionsForCourseRequest.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.SubmissionsForCourseRequest.Type} value
+ * @return {!proto.SubmissionsForCourseRequest} returns this
+ */
+proto.SubmissionsForCourseRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
 
 
 
@@ -8155,8 +14644,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.UserRequest.toObject(opt_includeInstance, this);
+proto.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.RebuildRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8165,13 +14654,16 @@ proto.UserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UserRequest} msg The msg instance to transform.
+ * @param {!proto.RebuildRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UserRequest.toObject = function(includeInstance, msg) {
+proto.RebuildRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    latesttests: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    nocache: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -8185,23 +14677,23 @@ proto.UserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UserRequest}
+ * @return {!proto.RebuildRequest}
  */
-proto.UserRequest.deserializeBinary = function(bytes) {
+proto.RebuildRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UserRequest;
-  return proto.UserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.RebuildRequest;
+  return proto.RebuildRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UserRequest} msg The message object to deserialize into.
+ * @param {!proto.RebuildRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UserRequest}
+ * @return {!proto.RebuildRequest}
  */
-proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8210,7 +14702,19 @@ proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setSubmissionid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLatesttests(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNocache(value);
       break;
     default:
       reader.skipField();
@@ -8225,9 +14729,9 @@ proto.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UserRequest.prototype.serializeBinary = function() {
+proto.RebuildRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UserRequest.serializeBinaryToWriter(this, writer);
+  proto.RebuildRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8235,40 +14739,115 @@ proto.UserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UserRequest} message
+ * @param {!proto.RebuildRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLatesttests();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getNocache();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.UserRequest.prototype.getUserid = function() {
+proto.RebuildRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UserRequest} returns this
+ * @return {!proto.RebuildRequest} returns this
  */
-proto.UserRequest.prototype.setUserid = function(value) {
+proto.RebuildRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.RebuildRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.RebuildRequest} returns this
+ */
+proto.RebuildRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bool latestTests = 3;
+ * @return {boolean}
+ */
+proto.RebuildRequest.prototype.getLatesttests = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.RebuildRequest} returns this
+ */
+proto.RebuildRequest.prototype.setLatesttests = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool noCache = 4;
+ * @return {boolean}
+ */
+proto.RebuildRequest.prototype.getNocache = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.RebuildRequest} returns this
+ */
+proto.RebuildRequest.prototype.setNocache = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
 
 
 
@@ -8285,8 +14864,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.GetGroupRequest.toObject(opt_includeInstance, this);
+proto.AssignmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8295,13 +14874,14 @@ proto.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GetGroupRequest} msg The msg instance to transform.
+ * @param {!proto.AssignmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GetGroupRequest.toObject = function(includeInstance, msg) {
+proto.AssignmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -8315,23 +14895,23 @@ proto.GetGroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GetGroupRequest}
+ * @return {!proto.AssignmentRequest}
  */
-proto.GetGroupRequest.deserializeBinary = function(bytes) {
+proto.AssignmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GetGroupRequest;
-  return proto.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AssignmentRequest;
+  return proto.AssignmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GetGroupRequest} msg The message object to deserialize into.
+ * @param {!proto.AssignmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GetGroupRequest}
+ * @return {!proto.AssignmentRequest}
  */
-proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.AssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8340,7 +14920,11 @@ proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -8355,9 +14939,9 @@ proto.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GetGroupRequest.prototype.serializeBinary = function() {
+proto.AssignmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GetGroupRequest.serializeBinaryToWriter(this, writer);
+  proto.AssignmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8365,40 +14949,72 @@ proto.GetGroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GetGroupRequest} message
+ * @param {!proto.AssignmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.AssignmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGroupid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 groupID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.GetGroupRequest.prototype.getGroupid = function() {
+proto.AssignmentRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GetGroupRequest} returns this
+ * @return {!proto.AssignmentRequest} returns this
  */
-proto.GetGroupRequest.prototype.setGroupid = function(value) {
+proto.AssignmentRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.AssignmentRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AssignmentRequest} returns this
+ */
+proto.AssignmentRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.RebuildAssignmentRequest.repeatedFields_ = [3];
 
 
 
@@ -8415,8 +15031,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.GroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.GroupRequest.toObject(opt_includeInstance, this);
+proto.RebuildAssignmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.RebuildAssignmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8425,15 +15041,18 @@ proto.GroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.GroupRequest} msg The msg instance to transform.
+ * @param {!proto.RebuildAssignmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GroupRequest.toObject = function(includeInstance, msg) {
+proto.RebuildAssignmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    scorebelow: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    latesttests: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    nocache: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -8447,23 +15066,23 @@ proto.GroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.GroupRequest}
+ * @return {!proto.RebuildAssignmentRequest}
  */
-proto.GroupRequest.deserializeBinary = function(bytes) {
+proto.RebuildAssignmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.GroupRequest;
-  return proto.GroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.RebuildAssignmentRequest;
+  return proto.RebuildAssignmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.GroupRequest} msg The message object to deserialize into.
+ * @param {!proto.RebuildAssignmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.GroupRequest}
+ * @return {!proto.RebuildAssignmentRequest}
  */
-proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.RebuildAssignmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8472,15 +15091,27 @@ proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setAssignmentid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {!Array<!proto.Submission.Status>} */ (reader.readPackedEnum());
+      msg.setStatusesList(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorebelow(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLatesttests(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNocache(value);
       break;
     default:
       reader.skipField();
@@ -8495,9 +15126,9 @@ proto.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.GroupRequest.prototype.serializeBinary = function() {
+proto.RebuildAssignmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.GroupRequest.serializeBinaryToWriter(this, writer);
+  proto.RebuildAssignmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8505,87 +15136,181 @@ proto.GroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.GroupRequest} message
+ * @param {!proto.RebuildAssignmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.GroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.RebuildAssignmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
       3,
       f
     );
   }
+  f = message.getScorebelow();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getLatesttests();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getNocache();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.GroupRequest.prototype.getUserid = function() {
+proto.RebuildAssignmentRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GroupRequest} returns this
+ * @return {!proto.RebuildAssignmentRequest} returns this
  */
-proto.GroupRequest.prototype.setUserid = function(value) {
+proto.RebuildAssignmentRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
+ * optional uint64 assignmentID = 2;
  * @return {number}
  */
-proto.GroupRequest.prototype.getGroupid = function() {
+proto.RebuildAssignmentRequest.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GroupRequest} returns this
+ * @return {!proto.RebuildAssignmentRequest} returns this
  */
-proto.GroupRequest.prototype.setGroupid = function(value) {
+proto.RebuildAssignmentRequest.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * repeated Submission.Status statuses = 3;
+ * @return {!Array<!proto.Submission.Status>}
+ */
+proto.RebuildAssignmentRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.Submission.Status>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.Submission.Status>} value
+ * @return {!proto.RebuildAssignmentRequest} returns this
+ */
+proto.RebuildAssignmentRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!proto.Submission.Status} value
+ * @param {number=} opt_index
+ * @return {!proto.RebuildAssignmentRequest} returns this
+ */
+proto.RebuildAssignmentRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.RebuildAssignmentRequest} returns this
+ */
+proto.RebuildAssignmentRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
+/**
+ * optional uint32 scoreBelow = 4;
  * @return {number}
  */
-proto.GroupRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.RebuildAssignmentRequest.prototype.getScorebelow = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.GroupRequest} returns this
+ * @return {!proto.RebuildAssignmentRequest} returns this
  */
-proto.GroupRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.RebuildAssignmentRequest.prototype.setScorebelow = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool latestTests = 5;
+ * @return {boolean}
+ */
+proto.RebuildAssignmentRequest.prototype.getLatesttests = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.RebuildAssignmentRequest} returns this
+ */
+proto.RebuildAssignmentRequest.prototype.setLatesttests = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional bool noCache = 6;
+ * @return {boolean}
+ */
+proto.RebuildAssignmentRequest.prototype.getNocache = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.RebuildAssignmentRequest} returns this
+ */
+proto.RebuildAssignmentRequest.prototype.setNocache = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
@@ -8605,8 +15330,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Provider.prototype.toObject = function(opt_includeInstance) {
-  return proto.Provider.toObject(opt_includeInstance, this);
+proto.ScoreChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.ScoreChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -8615,13 +15340,17 @@ proto.Provider.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Provider} msg The msg instance to transform.
+ * @param {!proto.ScoreChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Provider.toObject = function(includeInstance, msg) {
+proto.ScoreChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    oldscore: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    newscore: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -8635,23 +15364,23 @@ proto.Provider.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Provider}
+ * @return {!proto.ScoreChange}
  */
-proto.Provider.deserializeBinary = function(bytes) {
+proto.ScoreChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Provider;
-  return proto.Provider.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ScoreChange;
+  return proto.ScoreChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Provider} msg The message object to deserialize into.
+ * @param {!proto.ScoreChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Provider}
+ * @return {!proto.ScoreChange}
  */
-proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
+proto.ScoreChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8659,8 +15388,24 @@ proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOldscore(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNewscore(value);
       break;
     default:
       reader.skipField();
@@ -8675,9 +15420,9 @@ proto.Provider.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Provider.prototype.serializeBinary = function() {
+proto.ScoreChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Provider.serializeBinaryToWriter(this, writer);
+  proto.ScoreChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8685,170 +15430,147 @@ proto.Provider.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Provider} message
+ * @param {!proto.ScoreChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Provider.serializeBinaryToWriter = function(message, writer) {
+proto.ScoreChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getOldscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getNewscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string provider = 1;
- * @return {string}
+ * optional uint64 submissionID = 1;
+ * @return {number}
  */
-proto.Provider.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ScoreChange.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Provider} returns this
+ * @param {number} value
+ * @return {!proto.ScoreChange} returns this
  */
-proto.Provider.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ScoreChange.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 userID = 2;
+ * @return {number}
+ */
+proto.ScoreChange.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.ScoreChange} returns this
  */
-proto.OrgRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.OrgRequest.toObject(opt_includeInstance, this);
+proto.ScoreChange.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.OrgRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint64 groupID = 3;
+ * @return {number}
  */
-proto.OrgRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.ScoreChange.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.OrgRequest}
+ * @param {number} value
+ * @return {!proto.ScoreChange} returns this
  */
-proto.OrgRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.OrgRequest;
-  return proto.OrgRequest.deserializeBinaryFromReader(msg, reader);
+proto.ScoreChange.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.OrgRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.OrgRequest}
+ * optional uint32 oldScore = 4;
+ * @return {number}
  */
-proto.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrgname(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.ScoreChange.prototype.getOldscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {number} value
+ * @return {!proto.ScoreChange} returns this
  */
-proto.OrgRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.OrgRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ScoreChange.prototype.setOldscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.OrgRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint32 newScore = 5;
+ * @return {number}
  */
-proto.OrgRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getOrgname();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.ScoreChange.prototype.getNewscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * optional string orgName = 1;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.ScoreChange} returns this
  */
-proto.OrgRequest.prototype.getOrgname = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ScoreChange.prototype.setNewscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
+
 /**
- * @param {string} value
- * @return {!proto.OrgRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.OrgRequest.prototype.setOrgname = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
+proto.AssignmentRebuild.repeatedFields_ = [8];
 
 
 
@@ -8865,8 +15587,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Organization.prototype.toObject = function(opt_includeInstance) {
-  return proto.Organization.toObject(opt_includeInstance, this);
+proto.AssignmentRebuild.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignmentRebuild.toObject(opt_includeInstance, this);
 };
 
 
@@ -8875,16 +15597,21 @@ proto.Organization.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Organization} msg The msg instance to transform.
+ * @param {!proto.AssignmentRebuild} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organization.toObject = function(includeInstance, msg) {
+proto.AssignmentRebuild.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    total: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    rebuilt: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    failed: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    done: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    increased: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    decreased: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    scorechangesList: jspb.Message.toObjectList(msg.getScorechangesList(),
+    proto.ScoreChange.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -8898,23 +15625,23 @@ proto.Organization.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Organization}
+ * @return {!proto.AssignmentRebuild}
  */
-proto.Organization.deserializeBinary = function(bytes) {
+proto.AssignmentRebuild.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Organization;
-  return proto.Organization.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AssignmentRebuild;
+  return proto.AssignmentRebuild.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Organization} msg The message object to deserialize into.
+ * @param {!proto.AssignmentRebuild} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Organization}
+ * @return {!proto.AssignmentRebuild}
  */
-proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
+proto.AssignmentRebuild.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8923,19 +15650,36 @@ proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setAssignmentid(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotal(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatar(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRebuilt(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPaymentplan(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFailed(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDone(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setIncreased(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setDecreased(value);
+      break;
+    case 8:
+      var value = new proto.ScoreChange;
+      reader.readMessage(value,proto.ScoreChange.deserializeBinaryFromReader);
+      msg.addScorechanges(value);
       break;
     default:
       reader.skipField();
@@ -8950,9 +15694,9 @@ proto.Organization.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Organization.prototype.serializeBinary = function() {
+proto.AssignmentRebuild.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Organization.serializeBinaryToWriter(this, writer);
+  proto.AssignmentRebuild.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8960,122 +15704,236 @@ proto.Organization.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Organization} message
+ * @param {!proto.AssignmentRebuild} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organization.serializeBinaryToWriter = function(message, writer) {
+proto.AssignmentRebuild.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getAvatar();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRebuilt();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getPaymentplan();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFailed();
+  if (f !== 0) {
+    writer.writeUint32(
       4,
       f
     );
   }
+  f = message.getDone();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getIncreased();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getDecreased();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getScorechangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.ScoreChange.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 assignmentID = 1;
  * @return {number}
  */
-proto.Organization.prototype.getId = function() {
+proto.AssignmentRebuild.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Organization} returns this
+ * @return {!proto.AssignmentRebuild} returns this
  */
-proto.Organization.prototype.setId = function(value) {
+proto.AssignmentRebuild.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string path = 2;
- * @return {string}
+ * optional uint32 total = 2;
+ * @return {number}
  */
-proto.Organization.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.AssignmentRebuild.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Organization} returns this
+ * @param {number} value
+ * @return {!proto.AssignmentRebuild} returns this
  */
-proto.Organization.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.AssignmentRebuild.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string avatar = 3;
- * @return {string}
+ * optional uint32 rebuilt = 3;
+ * @return {number}
  */
-proto.Organization.prototype.getAvatar = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.AssignmentRebuild.prototype.getRebuilt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Organization} returns this
+ * @param {number} value
+ * @return {!proto.AssignmentRebuild} returns this
  */
-proto.Organization.prototype.setAvatar = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.AssignmentRebuild.prototype.setRebuilt = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string paymentPlan = 4;
- * @return {string}
+ * optional uint32 failed = 4;
+ * @return {number}
  */
-proto.Organization.prototype.getPaymentplan = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.AssignmentRebuild.prototype.getFailed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.Organization} returns this
+ * @param {number} value
+ * @return {!proto.AssignmentRebuild} returns this
  */
-proto.Organization.prototype.setPaymentplan = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.AssignmentRebuild.prototype.setFailed = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool done = 5;
+ * @return {boolean}
+ */
+proto.AssignmentRebuild.prototype.getDone = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
+/**
+ * @param {boolean} value
+ * @return {!proto.AssignmentRebuild} returns this
+ */
+proto.AssignmentRebuild.prototype.setDone = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional uint32 increased = 6;
+ * @return {number}
  */
-proto.Organizations.repeatedFields_ = [1];
+proto.AssignmentRebuild.prototype.getIncreased = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AssignmentRebuild} returns this
+ */
+proto.AssignmentRebuild.prototype.setIncreased = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 decreased = 7;
+ * @return {number}
+ */
+proto.AssignmentRebuild.prototype.getDecreased = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.AssignmentRebuild} returns this
+ */
+proto.AssignmentRebuild.prototype.setDecreased = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * repeated ScoreChange scoreChanges = 8;
+ * @return {!Array<!proto.ScoreChange>}
+ */
+proto.AssignmentRebuild.prototype.getScorechangesList = function() {
+  return /** @type{!Array<!proto.ScoreChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ScoreChange, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.ScoreChange>} value
+ * @return {!proto.AssignmentRebuild} returns this
+*/
+proto.AssignmentRebuild.prototype.setScorechangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.ScoreChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ScoreChange}
+ */
+proto.AssignmentRebuild.prototype.addScorechanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ScoreChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.AssignmentRebuild} returns this
+ */
+proto.AssignmentRebuild.prototype.clearScorechangesList = function() {
+  return this.setScorechangesList([]);
+};
+
+
 
 
 
@@ -9092,8 +15950,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Organizations.prototype.toObject = function(opt_includeInstance) {
-  return proto.Organizations.toObject(opt_includeInstance, this);
+proto.TestOutcome.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestOutcome.toObject(opt_includeInstance, this);
 };
 
 
@@ -9102,14 +15960,18 @@ proto.Organizations.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Organizations} msg The msg instance to transform.
+ * @param {!proto.TestOutcome} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organizations.toObject = function(includeInstance, msg) {
+proto.TestOutcome.toObject = function(includeInstance, msg) {
   var f, obj = {
-    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
-    proto.Organization.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    commithash: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    testname: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    runs: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    passes: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -9123,23 +15985,23 @@ proto.Organizations.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Organizations}
+ * @return {!proto.TestOutcome}
  */
-proto.Organizations.deserializeBinary = function(bytes) {
+proto.TestOutcome.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Organizations;
-  return proto.Organizations.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.TestOutcome;
+  return proto.TestOutcome.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Organizations} msg The message object to deserialize into.
+ * @param {!proto.TestOutcome} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Organizations}
+ * @return {!proto.TestOutcome}
  */
-proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
+proto.TestOutcome.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9147,9 +16009,28 @@ proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.Organization;
-      reader.readMessage(value,proto.Organization.deserializeBinaryFromReader);
-      msg.addOrganizations(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestname(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRuns(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPasses(value);
       break;
     default:
       reader.skipField();
@@ -9164,9 +16045,9 @@ proto.Organizations.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Organizations.prototype.serializeBinary = function() {
+proto.TestOutcome.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Organizations.serializeBinaryToWriter(this, writer);
+  proto.TestOutcome.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9174,68 +16055,165 @@ proto.Organizations.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Organizations} message
+ * @param {!proto.TestOutcome} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Organizations.serializeBinaryToWriter = function(message, writer) {
+proto.TestOutcome.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOrganizationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.Organization.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTestname();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRuns();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getPasses();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * repeated Organization organizations = 1;
- * @return {!Array<!proto.Organization>}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.Organizations.prototype.getOrganizationsList = function() {
-  return /** @type{!Array<!proto.Organization>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.Organization, 1));
+proto.TestOutcome.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Organization>} value
- * @return {!proto.Organizations} returns this
-*/
-proto.Organizations.prototype.setOrganizationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.TestOutcome} returns this
+ */
+proto.TestOutcome.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.Organization=} opt_value
- * @param {number=} opt_index
- * @return {!proto.Organization}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Organization, opt_index);
+proto.TestOutcome.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Organizations} returns this
+ * @param {number} value
+ * @return {!proto.TestOutcome} returns this
  */
-proto.Organizations.prototype.clearOrganizationsList = function() {
-  return this.setOrganizationsList([]);
+proto.TestOutcome.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string commitHash = 3;
+ * @return {string}
+ */
+proto.TestOutcome.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
+/**
+ * @param {string} value
+ * @return {!proto.TestOutcome} returns this
+ */
+proto.TestOutcome.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string testName = 4;
+ * @return {string}
  */
-proto.EnrollmentRequest.repeatedFields_ = [4];
+proto.TestOutcome.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestOutcome} returns this
+ */
+proto.TestOutcome.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 runs = 5;
+ * @return {number}
+ */
+proto.TestOutcome.prototype.getRuns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestOutcome} returns this
+ */
+proto.TestOutcome.prototype.setRuns = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 passes = 6;
+ * @return {number}
+ */
+proto.TestOutcome.prototype.getPasses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestOutcome} returns this
+ */
+proto.TestOutcome.prototype.setPasses = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
 
 
 
@@ -9252,8 +16230,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.EnrollmentRequest.toObject(opt_includeInstance, this);
+proto.TestStat.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestStat.toObject(opt_includeInstance, this);
 };
 
 
@@ -9262,16 +16240,17 @@ proto.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.EnrollmentRequest} msg The msg instance to transform.
+ * @param {!proto.TestStat} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentRequest.toObject = function(includeInstance, msg) {
+proto.TestStat.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    testname: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    runs: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    passes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    commits: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    flakycommits: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -9285,23 +16264,23 @@ proto.EnrollmentRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.EnrollmentRequest}
+ * @return {!proto.TestStat}
  */
-proto.EnrollmentRequest.deserializeBinary = function(bytes) {
+proto.TestStat.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.EnrollmentRequest;
-  return proto.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.TestStat;
+  return proto.TestStat.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.EnrollmentRequest} msg The message object to deserialize into.
+ * @param {!proto.TestStat} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.EnrollmentRequest}
+ * @return {!proto.TestStat}
  */
-proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.TestStat.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9309,20 +16288,24 @@ proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestname(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIgnoregroupmembers(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRuns(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWithactivity(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPasses(value);
       break;
     case 4:
-      var value = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.readPackedEnum());
-      msg.setStatusesList(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCommits(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFlakycommits(value);
       break;
     default:
       reader.skipField();
@@ -9337,9 +16320,9 @@ proto.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.EnrollmentRequest.prototype.serializeBinary = function() {
+proto.TestStat.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.EnrollmentRequest.serializeBinaryToWriter(this, writer);
+  proto.TestStat.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9347,131 +16330,137 @@ proto.EnrollmentRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.EnrollmentRequest} message
+ * @param {!proto.TestStat} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.TestStat.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getTestname();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getIgnoregroupmembers();
-  if (f) {
-    writer.writeBool(
+  f = message.getRuns();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getWithactivity();
-  if (f) {
-    writer.writeBool(
+  f = message.getPasses();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getCommits();
+  if (f !== 0) {
+    writer.writeUint32(
       4,
       f
     );
   }
+  f = message.getFlakycommits();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * optional string testName = 1;
+ * @return {string}
  */
-proto.EnrollmentRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.TestStat.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {string} value
+ * @return {!proto.TestStat} returns this
  */
-proto.EnrollmentRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.TestStat.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool ignoreGroupMembers = 2;
- * @return {boolean}
+ * optional uint32 runs = 2;
+ * @return {number}
  */
-proto.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.TestStat.prototype.getRuns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {number} value
+ * @return {!proto.TestStat} returns this
  */
-proto.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.TestStat.prototype.setRuns = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bool withActivity = 3;
- * @return {boolean}
+ * optional uint32 passes = 3;
+ * @return {number}
  */
-proto.EnrollmentRequest.prototype.getWithactivity = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.TestStat.prototype.getPasses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {number} value
+ * @return {!proto.TestStat} returns this
  */
-proto.EnrollmentRequest.prototype.setWithactivity = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.TestStat.prototype.setPasses = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * repeated Enrollment.UserStatus statuses = 4;
- * @return {!Array<!proto.Enrollment.UserStatus>}
+ * optional uint32 commits = 4;
+ * @return {number}
  */
-proto.EnrollmentRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.TestStat.prototype.getCommits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment.UserStatus>} value
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {number} value
+ * @return {!proto.TestStat} returns this
  */
-proto.EnrollmentRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.TestStat.prototype.setCommits = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.Enrollment.UserStatus} value
- * @param {number=} opt_index
- * @return {!proto.EnrollmentRequest} returns this
+ * optional uint32 flakyCommits = 5;
+ * @return {number}
  */
-proto.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.TestStat.prototype.getFlakycommits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.EnrollmentRequest} returns this
+ * @param {number} value
+ * @return {!proto.TestStat} returns this
  */
-proto.EnrollmentRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.TestStat.prototype.setFlakycommits = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
@@ -9481,7 +16470,7 @@ proto.EnrollmentRequest.prototype.clearStatusesList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.EnrollmentStatusRequest.repeatedFields_ = [2];
+proto.TestStats.repeatedFields_ = [2];
 
 
 
@@ -9498,8 +16487,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
+proto.TestStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestStats.toObject(opt_includeInstance, this);
 };
 
 
@@ -9508,14 +16497,15 @@ proto.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.EnrollmentStatusRequest} msg The msg instance to transform.
+ * @param {!proto.TestStats} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
+proto.TestStats.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    statsList: jspb.Message.toObjectList(msg.getStatsList(),
+    proto.TestStat.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9529,23 +16519,23 @@ proto.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.EnrollmentStatusRequest}
+ * @return {!proto.TestStats}
  */
-proto.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
+proto.TestStats.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.EnrollmentStatusRequest;
-  return proto.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.TestStats;
+  return proto.TestStats.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.EnrollmentStatusRequest} msg The message object to deserialize into.
+ * @param {!proto.TestStats} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.EnrollmentStatusRequest}
+ * @return {!proto.TestStats}
  */
-proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.TestStats.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9554,11 +16544,12 @@ proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setAssignmentid(value);
       break;
     case 2:
-      var value = /** @type {!Array<!proto.Enrollment.UserStatus>} */ (reader.readPackedEnum());
-      msg.setStatusesList(value);
+      var value = new proto.TestStat;
+      reader.readMessage(value,proto.TestStat.deserializeBinaryFromReader);
+      msg.addStats(value);
       break;
     default:
       reader.skipField();
@@ -9573,9 +16564,9 @@ proto.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.EnrollmentStatusRequest.prototype.serializeBinary = function() {
+proto.TestStats.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
+  proto.TestStats.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9583,81 +16574,83 @@ proto.EnrollmentStatusRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.EnrollmentStatusRequest} message
+ * @param {!proto.TestStats} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
+proto.TestStats.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getStatusesList();
+  f = message.getStatsList();
   if (f.length > 0) {
-    writer.writePackedEnum(
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.TestStat.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 assignmentID = 1;
  * @return {number}
  */
-proto.EnrollmentStatusRequest.prototype.getUserid = function() {
+proto.TestStats.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * @return {!proto.TestStats} returns this
  */
-proto.EnrollmentStatusRequest.prototype.setUserid = function(value) {
+proto.TestStats.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated Enrollment.UserStatus statuses = 2;
- * @return {!Array<!proto.Enrollment.UserStatus>}
+ * repeated TestStat stats = 2;
+ * @return {!Array<!proto.TestStat>}
  */
-proto.EnrollmentStatusRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.TestStats.prototype.getStatsList = function() {
+  return /** @type{!Array<!proto.TestStat>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.TestStat, 2));
 };
 
 
 /**
- * @param {!Array<!proto.Enrollment.UserStatus>} value
- * @return {!proto.EnrollmentStatusRequest} returns this
- */
-proto.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+ * @param {!Array<!proto.TestStat>} value
+ * @return {!proto.TestStats} returns this
+*/
+proto.TestStats.prototype.setStatsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.Enrollment.UserStatus} value
+ * @param {!proto.TestStat=} opt_value
  * @param {number=} opt_index
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * @return {!proto.TestStat}
  */
-proto.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.TestStats.prototype.addStats = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.TestStat, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.EnrollmentStatusRequest} returns this
+ * @return {!proto.TestStats} returns this
  */
-proto.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.TestStats.prototype.clearStatsList = function() {
+  return this.setStatsList([]);
 };
 
 
@@ -9677,8 +16670,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionRequest.toObject(opt_includeInstance, this);
+proto.ArtifactsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ArtifactsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9687,15 +16680,13 @@ proto.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.ArtifactsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionRequest.toObject = function(includeInstance, msg) {
+proto.ArtifactsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -9709,23 +16700,23 @@ proto.SubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionRequest}
+ * @return {!proto.ArtifactsRequest}
  */
-proto.SubmissionRequest.deserializeBinary = function(bytes) {
+proto.ArtifactsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionRequest;
-  return proto.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ArtifactsRequest;
+  return proto.ArtifactsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.ArtifactsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionRequest}
+ * @return {!proto.ArtifactsRequest}
  */
-proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ArtifactsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9734,15 +16725,7 @@ proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setSubmissionid(value);
       break;
     default:
       reader.skipField();
@@ -9757,9 +16740,9 @@ proto.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionRequest.prototype.serializeBinary = function() {
+proto.ArtifactsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.ArtifactsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9767,90 +16750,47 @@ proto.SubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionRequest} message
+ * @param {!proto.ArtifactsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ArtifactsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.SubmissionRequest.prototype.getUserid = function() {
+proto.ArtifactsRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.SubmissionRequest} returns this
+ * @return {!proto.ArtifactsRequest} returns this
  */
-proto.SubmissionRequest.prototype.setUserid = function(value) {
+proto.ArtifactsRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
-/**
- * optional uint64 groupID = 2;
- * @return {number}
- */
-proto.SubmissionRequest.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.SubmissionRequest} returns this
- */
-proto.SubmissionRequest.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 courseID = 3;
- * @return {number}
- */
-proto.SubmissionRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
 
 /**
- * @param {number} value
- * @return {!proto.SubmissionRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.SubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
+proto.Leaderboard.repeatedFields_ = [2];
 
 
 
@@ -9867,8 +16807,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
+proto.Leaderboard.prototype.toObject = function(opt_includeInstance) {
+  return proto.Leaderboard.toObject(opt_includeInstance, this);
 };
 
 
@@ -9877,17 +16817,15 @@ proto.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UpdateSubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.Leaderboard} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
+proto.Leaderboard.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.LeaderboardEntry.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9901,23 +16839,23 @@ proto.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UpdateSubmissionRequest}
+ * @return {!proto.Leaderboard}
  */
-proto.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
+proto.Leaderboard.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UpdateSubmissionRequest;
-  return proto.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Leaderboard;
+  return proto.Leaderboard.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UpdateSubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.Leaderboard} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UpdateSubmissionRequest}
+ * @return {!proto.Leaderboard}
  */
-proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.Leaderboard.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9926,23 +16864,12 @@ proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setAssignmentid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = new proto.LeaderboardEntry;
+      reader.readMessage(value,proto.LeaderboardEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
       break;
     default:
       reader.skipField();
@@ -9957,9 +16884,9 @@ proto.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UpdateSubmissionRequest.prototype.serializeBinary = function() {
+proto.Leaderboard.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.Leaderboard.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9967,137 +16894,83 @@ proto.UpdateSubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UpdateSubmissionRequest} message
+ * @param {!proto.Leaderboard} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.Leaderboard.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
-      f
-    );
-  }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
+      f,
+      proto.LeaderboardEntry.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 assignmentID = 1;
  * @return {number}
  */
-proto.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
+proto.Leaderboard.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UpdateSubmissionRequest} returns this
+ * @return {!proto.Leaderboard} returns this
  */
-proto.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
+proto.Leaderboard.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
- * @return {number}
- */
-proto.UpdateSubmissionRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UpdateSubmissionRequest} returns this
- */
-proto.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint32 score = 3;
- * @return {number}
- */
-proto.UpdateSubmissionRequest.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.UpdateSubmissionRequest} returns this
- */
-proto.UpdateSubmissionRequest.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional bool released = 4;
- * @return {boolean}
+ * repeated LeaderboardEntry entries = 2;
+ * @return {!Array<!proto.LeaderboardEntry>}
  */
-proto.UpdateSubmissionRequest.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.Leaderboard.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.LeaderboardEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.LeaderboardEntry, 2));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.UpdateSubmissionRequest} returns this
- */
-proto.UpdateSubmissionRequest.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+ * @param {!Array<!proto.LeaderboardEntry>} value
+ * @return {!proto.Leaderboard} returns this
+*/
+proto.Leaderboard.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * optional Submission.Status status = 5;
- * @return {!proto.Submission.Status}
+ * @param {!proto.LeaderboardEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.LeaderboardEntry}
  */
-proto.UpdateSubmissionRequest.prototype.getStatus = function() {
-  return /** @type {!proto.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.Leaderboard.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.LeaderboardEntry, opt_index);
 };
 
 
-/**
- * @param {!proto.Submission.Status} value
- * @return {!proto.UpdateSubmissionRequest} returns this
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Leaderboard} returns this
  */
-proto.UpdateSubmissionRequest.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.Leaderboard.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
 };
 
 
@@ -10117,8 +16990,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
+proto.LeaderboardEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.LeaderboardEntry.toObject(opt_includeInstance, this);
 };
 
 
@@ -10127,17 +17000,18 @@ proto.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.UpdateSubmissionsRequest} msg The msg instance to transform.
+ * @param {!proto.LeaderboardEntry} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
+proto.LeaderboardEntry.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    benchmark: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rank: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    nsperop: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    allocsperop: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    bytesperop: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    own: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -10151,23 +17025,23 @@ proto.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.UpdateSubmissionsRequest}
+ * @return {!proto.LeaderboardEntry}
  */
-proto.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
+proto.LeaderboardEntry.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.UpdateSubmissionsRequest;
-  return proto.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.LeaderboardEntry;
+  return proto.LeaderboardEntry.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.UpdateSubmissionsRequest} msg The message object to deserialize into.
+ * @param {!proto.LeaderboardEntry} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.UpdateSubmissionsRequest}
+ * @return {!proto.LeaderboardEntry}
  */
-proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.LeaderboardEntry.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10175,24 +17049,28 @@ proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBenchmark(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRank(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNsperop(value);
       break;
     case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRelease(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAllocsperop(value);
       break;
     case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesperop(value);
+      break;
+    case 6:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setApprove(value);
+      msg.setOwn(value);
       break;
     default:
       reader.skipField();
@@ -10207,9 +17085,9 @@ proto.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
+proto.LeaderboardEntry.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
+  proto.LeaderboardEntry.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10217,44 +17095,51 @@ proto.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.UpdateSubmissionsRequest} message
+ * @param {!proto.LeaderboardEntry} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.LeaderboardEntry.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getBenchmark();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
+  f = message.getRank();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getScorelimit();
+  f = message.getNsperop();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeInt64(
       3,
       f
     );
   }
-  f = message.getRelease();
-  if (f) {
-    writer.writeBool(
+  f = message.getAllocsperop();
+  if (f !== 0) {
+    writer.writeInt64(
       4,
       f
     );
   }
-  f = message.getApprove();
+  f = message.getBytesperop();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getOwn();
   if (f) {
     writer.writeBool(
-      5,
+      6,
       f
     );
   }
@@ -10262,92 +17147,110 @@ proto.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, write
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * optional string benchmark = 1;
+ * @return {string}
  */
-proto.UpdateSubmissionsRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.LeaderboardEntry.prototype.getBenchmark = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
+ * @param {string} value
+ * @return {!proto.LeaderboardEntry} returns this
  */
-proto.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.LeaderboardEntry.prototype.setBenchmark = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
+ * optional uint32 rank = 2;
  * @return {number}
  */
-proto.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
+proto.LeaderboardEntry.prototype.getRank = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
+ * @return {!proto.LeaderboardEntry} returns this
  */
-proto.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
+proto.LeaderboardEntry.prototype.setRank = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint32 scoreLimit = 3;
+ * optional int64 nsPerOp = 3;
  * @return {number}
  */
-proto.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
+proto.LeaderboardEntry.prototype.getNsperop = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
+ * @return {!proto.LeaderboardEntry} returns this
  */
-proto.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
+proto.LeaderboardEntry.prototype.setNsperop = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional bool release = 4;
- * @return {boolean}
+ * optional int64 allocsPerOp = 4;
+ * @return {number}
  */
-proto.UpdateSubmissionsRequest.prototype.getRelease = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.LeaderboardEntry.prototype.getAllocsperop = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
+ * @param {number} value
+ * @return {!proto.LeaderboardEntry} returns this
  */
-proto.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.LeaderboardEntry.prototype.setAllocsperop = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional bool approve = 5;
+ * optional int64 bytesPerOp = 5;
+ * @return {number}
+ */
+proto.LeaderboardEntry.prototype.getBytesperop = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.LeaderboardEntry} returns this
+ */
+proto.LeaderboardEntry.prototype.setBytesperop = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bool own = 6;
  * @return {boolean}
  */
-proto.UpdateSubmissionsRequest.prototype.getApprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.LeaderboardEntry.prototype.getOwn = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.UpdateSubmissionsRequest} returns this
+ * @return {!proto.LeaderboardEntry} returns this
  */
-proto.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.LeaderboardEntry.prototype.setOwn = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
@@ -10367,8 +17270,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
+proto.RegradesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.RegradesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10377,14 +17280,14 @@ proto.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionReviewersRequest} msg The msg instance to transform.
+ * @param {!proto.RegradesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
+proto.RegradesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -10398,23 +17301,23 @@ proto.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionReviewersRequest}
+ * @return {!proto.RegradesRequest}
  */
-proto.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
+proto.RegradesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionReviewersRequest;
-  return proto.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.RegradesRequest;
+  return proto.RegradesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionReviewersRequest} msg The message object to deserialize into.
+ * @param {!proto.RegradesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionReviewersRequest}
+ * @return {!proto.RegradesRequest}
  */
-proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.RegradesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10423,11 +17326,11 @@ proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setCourseid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setSubmissionid(value);
       break;
     default:
       reader.skipField();
@@ -10442,9 +17345,9 @@ proto.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionReviewersRequest.prototype.serializeBinary = function() {
+proto.RegradesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
+  proto.RegradesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10452,20 +17355,20 @@ proto.SubmissionReviewersRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionReviewersRequest} message
+ * @param {!proto.RegradesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
+proto.RegradesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       2,
@@ -10476,49 +17379,42 @@ proto.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
+proto.RegradesRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.SubmissionReviewersRequest} returns this
+ * @return {!proto.RegradesRequest} returns this
  */
-proto.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
+proto.RegradesRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
+ * optional uint64 submissionID = 2;
  * @return {number}
  */
-proto.SubmissionReviewersRequest.prototype.getCourseid = function() {
+proto.RegradesRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.SubmissionReviewersRequest} returns this
+ * @return {!proto.RegradesRequest} returns this
  */
-proto.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
+proto.RegradesRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.Providers.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10534,8 +17430,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Providers.prototype.toObject = function(opt_includeInstance) {
-  return proto.Providers.toObject(opt_includeInstance, this);
+proto.UpdateRegradeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.UpdateRegradeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10544,13 +17440,17 @@ proto.Providers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Providers} msg The msg instance to transform.
+ * @param {!proto.UpdateRegradeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Providers.toObject = function(includeInstance, msg) {
+proto.UpdateRegradeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    providersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    regradeid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    state: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    response: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -10564,23 +17464,23 @@ proto.Providers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Providers}
+ * @return {!proto.UpdateRegradeRequest}
  */
-proto.Providers.deserializeBinary = function(bytes) {
+proto.UpdateRegradeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Providers;
-  return proto.Providers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.UpdateRegradeRequest;
+  return proto.UpdateRegradeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Providers} msg The message object to deserialize into.
+ * @param {!proto.UpdateRegradeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Providers}
+ * @return {!proto.UpdateRegradeRequest}
  */
-proto.Providers.deserializeBinaryFromReader = function(msg, reader) {
+proto.UpdateRegradeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10588,8 +17488,24 @@ proto.Providers.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRegradeid(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.Regrade.State} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.addProviders(value);
+      msg.setResponse(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
       break;
     default:
       reader.skipField();
@@ -10604,76 +17520,150 @@ proto.Providers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Providers.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.Providers.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.UpdateRegradeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.UpdateRegradeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.UpdateRegradeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.UpdateRegradeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getRegradeid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getResponse();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 courseID = 1;
+ * @return {number}
+ */
+proto.UpdateRegradeRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateRegradeRequest} returns this
+ */
+proto.UpdateRegradeRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 regradeID = 2;
+ * @return {number}
+ */
+proto.UpdateRegradeRequest.prototype.getRegradeid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.UpdateRegradeRequest} returns this
+ */
+proto.UpdateRegradeRequest.prototype.setRegradeid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.Providers} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional Regrade.State state = 3;
+ * @return {!proto.Regrade.State}
  */
-proto.Providers.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getProvidersList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.UpdateRegradeRequest.prototype.getState = function() {
+  return /** @type {!proto.Regrade.State} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * repeated string providers = 1;
- * @return {!Array<string>}
+ * @param {!proto.Regrade.State} value
+ * @return {!proto.UpdateRegradeRequest} returns this
  */
-proto.Providers.prototype.getProvidersList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.UpdateRegradeRequest.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.Providers} returns this
+ * optional string response = 4;
+ * @return {string}
  */
-proto.Providers.prototype.setProvidersList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.UpdateRegradeRequest.prototype.getResponse = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.Providers} returns this
+ * @return {!proto.UpdateRegradeRequest} returns this
  */
-proto.Providers.prototype.addProviders = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.UpdateRegradeRequest.prototype.setResponse = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.Providers} returns this
+ * optional uint32 score = 5;
+ * @return {number}
  */
-proto.Providers.prototype.clearProvidersList = function() {
-  return this.setProvidersList([]);
+proto.UpdateRegradeRequest.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.UpdateRegradeRequest} returns this
  */
-proto.URLRequest.repeatedFields_ = [2];
+proto.UpdateRegradeRequest.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
 
 
 
@@ -10690,8 +17680,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.URLRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.URLRequest.toObject(opt_includeInstance, this);
+proto.BuildLogRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.BuildLogRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10700,14 +17690,13 @@ proto.URLRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.URLRequest} msg The msg instance to transform.
+ * @param {!proto.BuildLogRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.URLRequest.toObject = function(includeInstance, msg) {
+proto.BuildLogRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -10721,23 +17710,23 @@ proto.URLRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.URLRequest}
+ * @return {!proto.BuildLogRequest}
  */
-proto.URLRequest.deserializeBinary = function(bytes) {
+proto.BuildLogRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.URLRequest;
-  return proto.URLRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.BuildLogRequest;
+  return proto.BuildLogRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.URLRequest} msg The message object to deserialize into.
+ * @param {!proto.BuildLogRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.URLRequest}
+ * @return {!proto.BuildLogRequest}
  */
-proto.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.BuildLogRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10746,11 +17735,7 @@ proto.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 2:
-      var value = /** @type {!Array<!proto.Repository.Type>} */ (reader.readPackedEnum());
-      msg.setRepotypesList(value);
+      msg.setSubmissionid(value);
       break;
     default:
       reader.skipField();
@@ -10765,9 +17750,9 @@ proto.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.URLRequest.prototype.serializeBinary = function() {
+proto.BuildLogRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.URLRequest.serializeBinaryToWriter(this, writer);
+  proto.BuildLogRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10775,84 +17760,40 @@ proto.URLRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.URLRequest} message
+ * @param {!proto.BuildLogRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.URLRequest.serializeBinaryToWriter = function(message, writer) {
+proto.BuildLogRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getRepotypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 courseID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.URLRequest.prototype.getCourseid = function() {
+proto.BuildLogRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.URLRequest} returns this
+ * @return {!proto.BuildLogRequest} returns this
  */
-proto.URLRequest.prototype.setCourseid = function(value) {
+proto.BuildLogRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
-/**
- * repeated Repository.Type repoTypes = 2;
- * @return {!Array<!proto.Repository.Type>}
- */
-proto.URLRequest.prototype.getRepotypesList = function() {
-  return /** @type {!Array<!proto.Repository.Type>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<!proto.Repository.Type>} value
- * @return {!proto.URLRequest} returns this
- */
-proto.URLRequest.prototype.setRepotypesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {!proto.Repository.Type} value
- * @param {number=} opt_index
- * @return {!proto.URLRequest} returns this
- */
-proto.URLRequest.prototype.addRepotypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.URLRequest} returns this
- */
-proto.URLRequest.prototype.clearRepotypesList = function() {
-  return this.setRepotypesList([]);
-};
-
-
 
 
 
@@ -10869,8 +17810,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.RepositoryRequest.toObject(opt_includeInstance, this);
+proto.CancelBuildRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CancelBuildRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10879,15 +17820,16 @@ proto.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.RepositoryRequest} msg The msg instance to transform.
+ * @param {!proto.CancelBuildRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RepositoryRequest.toObject = function(includeInstance, msg) {
+proto.CancelBuildRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -10901,23 +17843,23 @@ proto.RepositoryRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.RepositoryRequest}
+ * @return {!proto.CancelBuildRequest}
  */
-proto.RepositoryRequest.deserializeBinary = function(bytes) {
+proto.CancelBuildRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.RepositoryRequest;
-  return proto.RepositoryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.CancelBuildRequest;
+  return proto.CancelBuildRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.RepositoryRequest} msg The message object to deserialize into.
+ * @param {!proto.CancelBuildRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.RepositoryRequest}
+ * @return {!proto.CancelBuildRequest}
  */
-proto.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.CancelBuildRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10926,15 +17868,19 @@ proto.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
       break;
     default:
       reader.skipField();
@@ -10949,9 +17895,9 @@ proto.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.RepositoryRequest.prototype.serializeBinary = function() {
+proto.CancelBuildRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.RepositoryRequest.serializeBinaryToWriter(this, writer);
+  proto.CancelBuildRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10959,90 +17905,115 @@ proto.RepositoryRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.RepositoryRequest} message
+ * @param {!proto.CancelBuildRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
+proto.CancelBuildRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.RepositoryRequest.prototype.getUserid = function() {
+proto.CancelBuildRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.RepositoryRequest} returns this
+ * @return {!proto.CancelBuildRequest} returns this
  */
-proto.RepositoryRequest.prototype.setUserid = function(value) {
+proto.CancelBuildRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
+ * optional uint64 assignmentID = 2;
  * @return {number}
  */
-proto.RepositoryRequest.prototype.getGroupid = function() {
+proto.CancelBuildRequest.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.RepositoryRequest} returns this
+ * @return {!proto.CancelBuildRequest} returns this
  */
-proto.RepositoryRequest.prototype.setGroupid = function(value) {
+proto.CancelBuildRequest.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * optional uint64 userID = 3;
  * @return {number}
  */
-proto.RepositoryRequest.prototype.getCourseid = function() {
+proto.CancelBuildRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.RepositoryRequest} returns this
+ * @return {!proto.CancelBuildRequest} returns this
  */
-proto.RepositoryRequest.prototype.setCourseid = function(value) {
+proto.CancelBuildRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.CancelBuildRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.CancelBuildRequest} returns this
+ */
+proto.CancelBuildRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
 
 
 
@@ -11059,8 +18030,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Repositories.prototype.toObject = function(opt_includeInstance) {
-  return proto.Repositories.toObject(opt_includeInstance, this);
+proto.Artifact.prototype.toObject = function(opt_includeInstance) {
+  return proto.Artifact.toObject(opt_includeInstance, this);
 };
 
 
@@ -11069,13 +18040,14 @@ proto.Repositories.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Repositories} msg The msg instance to transform.
+ * @param {!proto.Artifact} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Repositories.toObject = function(includeInstance, msg) {
+proto.Artifact.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urlsMap: (f = msg.getUrlsMap()) ? f.toObject(includeInstance, undefined) : []
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    filesize: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -11089,23 +18061,23 @@ proto.Repositories.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Repositories}
+ * @return {!proto.Artifact}
  */
-proto.Repositories.deserializeBinary = function(bytes) {
+proto.Artifact.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Repositories;
-  return proto.Repositories.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Artifact;
+  return proto.Artifact.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Repositories} msg The message object to deserialize into.
+ * @param {!proto.Artifact} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Repositories}
+ * @return {!proto.Artifact}
  */
-proto.Repositories.deserializeBinaryFromReader = function(msg, reader) {
+proto.Artifact.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11113,10 +18085,12 @@ proto.Repositories.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getUrlsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFilesize(value);
       break;
     default:
       reader.skipField();
@@ -11131,9 +18105,9 @@ proto.Repositories.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Repositories.prototype.serializeBinary = function() {
+proto.Artifact.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Repositories.serializeBinaryToWriter(this, writer);
+  proto.Artifact.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11141,42 +18115,73 @@ proto.Repositories.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Repositories} message
+ * @param {!proto.Artifact} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Repositories.serializeBinaryToWriter = function(message, writer) {
+proto.Artifact.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrlsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFilesize();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, string> URLs = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.Artifact.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Artifact} returns this
+ */
+proto.Artifact.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 fileSize = 2;
+ * @return {number}
  */
-proto.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.Artifact.prototype.getFilesize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.Repositories} returns this
+ * @param {number} value
+ * @return {!proto.Artifact} returns this
  */
-proto.Repositories.prototype.clearUrlsMap = function() {
-  this.getUrlsMap().clear();
-  return this;};
+proto.Artifact.prototype.setFilesize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.Artifacts.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11192,8 +18197,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.AuthorizationResponse.toObject(opt_includeInstance, this);
+proto.Artifacts.prototype.toObject = function(opt_includeInstance) {
+  return proto.Artifacts.toObject(opt_includeInstance, this);
 };
 
 
@@ -11202,13 +18207,14 @@ proto.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.AuthorizationResponse} msg The msg instance to transform.
+ * @param {!proto.Artifacts} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.AuthorizationResponse.toObject = function(includeInstance, msg) {
+proto.Artifacts.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isauthorized: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    artifactsList: jspb.Message.toObjectList(msg.getArtifactsList(),
+    proto.Artifact.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -11222,23 +18228,23 @@ proto.AuthorizationResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.AuthorizationResponse}
+ * @return {!proto.Artifacts}
  */
-proto.AuthorizationResponse.deserializeBinary = function(bytes) {
+proto.Artifacts.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.AuthorizationResponse;
-  return proto.AuthorizationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Artifacts;
+  return proto.Artifacts.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.AuthorizationResponse} msg The message object to deserialize into.
+ * @param {!proto.Artifacts} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.AuthorizationResponse}
+ * @return {!proto.Artifacts}
  */
-proto.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.Artifacts.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11246,8 +18252,9 @@ proto.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsauthorized(value);
+      var value = new proto.Artifact;
+      reader.readMessage(value,proto.Artifact.deserializeBinaryFromReader);
+      msg.addArtifacts(value);
       break;
     default:
       reader.skipField();
@@ -11262,9 +18269,9 @@ proto.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.AuthorizationResponse.prototype.serializeBinary = function() {
+proto.Artifacts.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.AuthorizationResponse.serializeBinaryToWriter(this, writer);
+  proto.Artifacts.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11272,37 +18279,58 @@ proto.AuthorizationResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.AuthorizationResponse} message
+ * @param {!proto.Artifacts} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.AuthorizationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.Artifacts.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsauthorized();
-  if (f) {
-    writer.writeBool(
+  f = message.getArtifactsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.Artifact.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional bool IsAuthorized = 1;
- * @return {boolean}
+ * repeated Artifact artifacts = 1;
+ * @return {!Array<!proto.Artifact>}
  */
-proto.AuthorizationResponse.prototype.getIsauthorized = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.Artifacts.prototype.getArtifactsList = function() {
+  return /** @type{!Array<!proto.Artifact>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Artifact, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.AuthorizationResponse} returns this
+ * @param {!Array<!proto.Artifact>} value
+ * @return {!proto.Artifacts} returns this
+*/
+proto.Artifacts.prototype.setArtifactsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.Artifact=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Artifact}
  */
-proto.AuthorizationResponse.prototype.setIsauthorized = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.Artifacts.prototype.addArtifacts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.Artifact, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.Artifacts} returns this
+ */
+proto.Artifacts.prototype.clearArtifactsList = function() {
+  return this.setArtifactsList([]);
 };
 
 
@@ -11322,8 +18350,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.Status.prototype.toObject = function(opt_includeInstance) {
-  return proto.Status.toObject(opt_includeInstance, this);
+proto.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CourseUserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11332,14 +18360,15 @@ proto.Status.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.Status} msg The msg instance to transform.
+ * @param {!proto.CourseUserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Status.toObject = function(includeInstance, msg) {
+proto.CourseUserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    error: jspb.Message.getFieldWithDefault(msg, 2, "")
+    coursecode: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    courseyear: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userlogin: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -11353,23 +18382,23 @@ proto.Status.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.Status}
+ * @return {!proto.CourseUserRequest}
  */
-proto.Status.deserializeBinary = function(bytes) {
+proto.CourseUserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.Status;
-  return proto.Status.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.CourseUserRequest;
+  return proto.CourseUserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.Status} msg The message object to deserialize into.
+ * @param {!proto.CourseUserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.Status}
+ * @return {!proto.CourseUserRequest}
  */
-proto.Status.deserializeBinaryFromReader = function(msg, reader) {
+proto.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11377,12 +18406,16 @@ proto.Status.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCode(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCoursecode(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCourseyear(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setError(value);
+      msg.setUserlogin(value);
       break;
     default:
       reader.skipField();
@@ -11397,9 +18430,9 @@ proto.Status.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.Status.prototype.serializeBinary = function() {
+proto.CourseUserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.Status.serializeBinaryToWriter(this, writer);
+  proto.CourseUserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11407,23 +18440,30 @@ proto.Status.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.Status} message
+ * @param {!proto.CourseUserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.Status.serializeBinaryToWriter = function(message, writer) {
+proto.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCode();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getCoursecode();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getError();
+  f = message.getCourseyear();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getUserlogin();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      3,
       f
     );
   }
@@ -11431,38 +18471,56 @@ proto.Status.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 Code = 1;
+ * optional string courseCode = 1;
+ * @return {string}
+ */
+proto.CourseUserRequest.prototype.getCoursecode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.CourseUserRequest} returns this
+ */
+proto.CourseUserRequest.prototype.setCoursecode = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 courseYear = 2;
  * @return {number}
  */
-proto.Status.prototype.getCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.CourseUserRequest.prototype.getCourseyear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.Status} returns this
+ * @return {!proto.CourseUserRequest} returns this
  */
-proto.Status.prototype.setCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.CourseUserRequest.prototype.setCourseyear = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string Error = 2;
+ * optional string userLogin = 3;
  * @return {string}
  */
-proto.Status.prototype.getError = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.CourseUserRequest.prototype.getUserlogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.Status} returns this
+ * @return {!proto.CourseUserRequest} returns this
  */
-proto.Status.prototype.setError = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.CourseUserRequest.prototype.setUserlogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -11482,8 +18540,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.SubmissionsForCourseRequest.toObject(opt_includeInstance, this);
+proto.LoadCriteriaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.LoadCriteriaRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11492,14 +18550,14 @@ proto.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.SubmissionsForCourseRequest} msg The msg instance to transform.
+ * @param {!proto.LoadCriteriaRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
+proto.LoadCriteriaRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    type: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -11513,23 +18571,23 @@ proto.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.SubmissionsForCourseRequest}
+ * @return {!proto.LoadCriteriaRequest}
  */
-proto.SubmissionsForCourseRequest.deserializeBinary = function(bytes) {
+proto.LoadCriteriaRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.SubmissionsForCourseRequest;
-  return proto.SubmissionsForCourseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.LoadCriteriaRequest;
+  return proto.LoadCriteriaRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.SubmissionsForCourseRequest} msg The message object to deserialize into.
+ * @param {!proto.LoadCriteriaRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.SubmissionsForCourseRequest}
+ * @return {!proto.LoadCriteriaRequest}
  */
-proto.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11541,8 +18599,8 @@ proto.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, re
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {!proto.SubmissionsForCourseRequest.Type} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -11557,9 +18615,9 @@ proto.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
+proto.LoadCriteriaRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.SubmissionsForCourseRequest.serializeBinaryToWriter(this, writer);
+  proto.LoadCriteriaRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11567,11 +18625,11 @@ proto.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.SubmissionsForCourseRequest} message
+ * @param {!proto.LoadCriteriaRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.LoadCriteriaRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -11580,9 +18638,9 @@ proto.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, wr
       f
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
@@ -11590,48 +18648,39 @@ proto.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, wr
 };
 
 
-/**
- * @enum {number}
- */
-proto.SubmissionsForCourseRequest.Type = {
-  ALL: 0,
-  INDIVIDUAL: 1,
-  GROUP: 2
-};
-
 /**
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.SubmissionsForCourseRequest.prototype.getCourseid = function() {
+proto.LoadCriteriaRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.SubmissionsForCourseRequest} returns this
+ * @return {!proto.LoadCriteriaRequest} returns this
  */
-proto.SubmissionsForCourseRequest.prototype.setCourseid = function(value) {
+proto.LoadCriteriaRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Type type = 2;
- * @return {!proto.SubmissionsForCourseRequest.Type}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.SubmissionsForCourseRequest.prototype.getType = function() {
-  return /** @type {!proto.SubmissionsForCourseRequest.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.LoadCriteriaRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.SubmissionsForCourseRequest.Type} value
- * @return {!proto.SubmissionsForCourseRequest} returns this
+ * @param {number} value
+ * @return {!proto.LoadCriteriaRequest} returns this
  */
-proto.SubmissionsForCourseRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.LoadCriteriaRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -11651,8 +18700,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.RebuildRequest.toObject(opt_includeInstance, this);
+proto.TestsValidationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestsValidationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11661,14 +18710,14 @@ proto.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.RebuildRequest} msg The msg instance to transform.
+ * @param {!proto.TestsValidationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RebuildRequest.toObject = function(includeInstance, msg) {
+proto.TestsValidationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    solutionsrepo: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -11682,23 +18731,23 @@ proto.RebuildRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.RebuildRequest}
+ * @return {!proto.TestsValidationRequest}
  */
-proto.RebuildRequest.deserializeBinary = function(bytes) {
+proto.TestsValidationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.RebuildRequest;
-  return proto.RebuildRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.TestsValidationRequest;
+  return proto.TestsValidationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.RebuildRequest} msg The message object to deserialize into.
+ * @param {!proto.TestsValidationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.RebuildRequest}
+ * @return {!proto.TestsValidationRequest}
  */
-proto.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.TestsValidationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11707,11 +18756,11 @@ proto.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSolutionsrepo(value);
       break;
     default:
       reader.skipField();
@@ -11726,9 +18775,9 @@ proto.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.RebuildRequest.prototype.serializeBinary = function() {
+proto.TestsValidationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.RebuildRequest.serializeBinaryToWriter(this, writer);
+  proto.TestsValidationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11736,22 +18785,22 @@ proto.RebuildRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.RebuildRequest} message
+ * @param {!proto.TestsValidationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
+proto.TestsValidationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getSolutionsrepo();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -11760,42 +18809,49 @@ proto.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.RebuildRequest.prototype.getSubmissionid = function() {
+proto.TestsValidationRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.RebuildRequest} returns this
+ * @return {!proto.TestsValidationRequest} returns this
  */
-proto.RebuildRequest.prototype.setSubmissionid = function(value) {
+proto.TestsValidationRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * optional string solutionsRepo = 2;
+ * @return {string}
  */
-proto.RebuildRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.TestsValidationRequest.prototype.getSolutionsrepo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.RebuildRequest} returns this
+ * @param {string} value
+ * @return {!proto.TestsValidationRequest} returns this
  */
-proto.RebuildRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.TestsValidationRequest.prototype.setSolutionsrepo = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.AssignmentValidation.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11811,8 +18867,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.CourseUserRequest.toObject(opt_includeInstance, this);
+proto.AssignmentValidation.prototype.toObject = function(opt_includeInstance) {
+  return proto.AssignmentValidation.toObject(opt_includeInstance, this);
 };
 
 
@@ -11821,15 +18877,17 @@ proto.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.CourseUserRequest} msg The msg instance to transform.
+ * @param {!proto.AssignmentValidation} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseUserRequest.toObject = function(includeInstance, msg) {
+proto.AssignmentValidation.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursecode: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    courseyear: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userlogin: jspb.Message.getFieldWithDefault(msg, 3, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    errorsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    tested: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    score: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -11843,23 +18901,23 @@ proto.CourseUserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.CourseUserRequest}
+ * @return {!proto.AssignmentValidation}
  */
-proto.CourseUserRequest.deserializeBinary = function(bytes) {
+proto.AssignmentValidation.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.CourseUserRequest;
-  return proto.CourseUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.AssignmentValidation;
+  return proto.AssignmentValidation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.CourseUserRequest} msg The message object to deserialize into.
+ * @param {!proto.AssignmentValidation} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.CourseUserRequest}
+ * @return {!proto.AssignmentValidation}
  */
-proto.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.AssignmentValidation.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11868,15 +18926,23 @@ proto.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCoursecode(value);
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCourseyear(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addErrors(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUserlogin(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTested(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
       break;
     default:
       reader.skipField();
@@ -11891,9 +18957,9 @@ proto.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.CourseUserRequest.prototype.serializeBinary = function() {
+proto.AssignmentValidation.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.CourseUserRequest.serializeBinaryToWriter(this, writer);
+  proto.AssignmentValidation.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11901,91 +18967,167 @@ proto.CourseUserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.CourseUserRequest} message
+ * @param {!proto.AssignmentValidation} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.AssignmentValidation.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursecode();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getCourseyear();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getUserlogin();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTested();
+  if (f) {
+    writer.writeBool(
       3,
       f
     );
   }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string courseCode = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.CourseUserRequest.prototype.getCoursecode = function() {
+proto.AssignmentValidation.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.CourseUserRequest} returns this
+ * @return {!proto.AssignmentValidation} returns this
  */
-proto.CourseUserRequest.prototype.setCoursecode = function(value) {
+proto.AssignmentValidation.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 courseYear = 2;
+ * repeated string errors = 2;
+ * @return {!Array<string>}
+ */
+proto.AssignmentValidation.prototype.getErrorsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.AssignmentValidation} returns this
+ */
+proto.AssignmentValidation.prototype.setErrorsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.AssignmentValidation} returns this
+ */
+proto.AssignmentValidation.prototype.addErrors = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.AssignmentValidation} returns this
+ */
+proto.AssignmentValidation.prototype.clearErrorsList = function() {
+  return this.setErrorsList([]);
+};
+
+
+/**
+ * optional bool tested = 3;
+ * @return {boolean}
+ */
+proto.AssignmentValidation.prototype.getTested = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.AssignmentValidation} returns this
+ */
+proto.AssignmentValidation.prototype.setTested = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 score = 4;
  * @return {number}
  */
-proto.CourseUserRequest.prototype.getCourseyear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.AssignmentValidation.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.CourseUserRequest} returns this
+ * @return {!proto.AssignmentValidation} returns this
  */
-proto.CourseUserRequest.prototype.setCourseyear = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.AssignmentValidation.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional string userLogin = 3;
- * @return {string}
+ * optional uint32 scoreLimit = 5;
+ * @return {number}
  */
-proto.CourseUserRequest.prototype.getUserlogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.AssignmentValidation.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.CourseUserRequest} returns this
+ * @param {number} value
+ * @return {!proto.AssignmentValidation} returns this
  */
-proto.CourseUserRequest.prototype.setUserlogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.AssignmentValidation.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.TestsValidation.repeatedFields_ = [1,2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -12001,8 +19143,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.LoadCriteriaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.LoadCriteriaRequest.toObject(opt_includeInstance, this);
+proto.TestsValidation.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestsValidation.toObject(opt_includeInstance, this);
 };
 
 
@@ -12011,14 +19153,15 @@ proto.LoadCriteriaRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.LoadCriteriaRequest} msg The msg instance to transform.
+ * @param {!proto.TestsValidation} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.LoadCriteriaRequest.toObject = function(includeInstance, msg) {
+proto.TestsValidation.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    errorsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.AssignmentValidation.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -12032,23 +19175,23 @@ proto.LoadCriteriaRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.LoadCriteriaRequest}
+ * @return {!proto.TestsValidation}
  */
-proto.LoadCriteriaRequest.deserializeBinary = function(bytes) {
+proto.TestsValidation.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.LoadCriteriaRequest;
-  return proto.LoadCriteriaRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.TestsValidation;
+  return proto.TestsValidation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.LoadCriteriaRequest} msg The message object to deserialize into.
+ * @param {!proto.TestsValidation} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.LoadCriteriaRequest}
+ * @return {!proto.TestsValidation}
  */
-proto.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.TestsValidation.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -12056,12 +19199,13 @@ proto.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addErrors(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = new proto.AssignmentValidation;
+      reader.readMessage(value,proto.AssignmentValidation.deserializeBinaryFromReader);
+      msg.addAssignments(value);
       break;
     default:
       reader.skipField();
@@ -12076,9 +19220,9 @@ proto.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.LoadCriteriaRequest.prototype.serializeBinary = function() {
+proto.TestsValidation.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.LoadCriteriaRequest.serializeBinaryToWriter(this, writer);
+  proto.TestsValidation.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12086,62 +19230,102 @@ proto.LoadCriteriaRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.LoadCriteriaRequest} message
+ * @param {!proto.TestsValidation} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.LoadCriteriaRequest.serializeBinaryToWriter = function(message, writer) {
+proto.TestsValidation.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.AssignmentValidation.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * repeated string errors = 1;
+ * @return {!Array<string>}
  */
-proto.LoadCriteriaRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.TestsValidation.prototype.getErrorsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.LoadCriteriaRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.TestsValidation} returns this
  */
-proto.LoadCriteriaRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.TestsValidation.prototype.setErrorsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.TestsValidation} returns this
  */
-proto.LoadCriteriaRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.TestsValidation.prototype.addErrors = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.LoadCriteriaRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestsValidation} returns this
  */
-proto.LoadCriteriaRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.TestsValidation.prototype.clearErrorsList = function() {
+  return this.setErrorsList([]);
+};
+
+
+/**
+ * repeated AssignmentValidation assignments = 2;
+ * @return {!Array<!proto.AssignmentValidation>}
+ */
+proto.TestsValidation.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.AssignmentValidation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.AssignmentValidation, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.AssignmentValidation>} value
+ * @return {!proto.TestsValidation} returns this
+*/
+proto.TestsValidation.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.AssignmentValidation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.AssignmentValidation}
+ */
+proto.TestsValidation.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.AssignmentValidation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestsValidation} returns this
+ */
+proto.TestsValidation.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
 };
 
 
@@ -1,5 +1,6 @@
 import * as grpcWeb from "grpc-web";
 import {
+    AssignmentChanges,
     Assignments,
     AuthorizationResponse,
     Benchmarks,
@@ -121,10 +122,10 @@ export class GrpcManager {
         return this.grpcSend<Assignments>(this.agService.getAssignments, request);
     }
 
-    public updateAssignments(courseID: number): Promise<IGrpcResponse<Void>> {
+    public updateAssignments(courseID: number): Promise<IGrpcResponse<AssignmentChanges>> {
         const request = new CourseRequest();
         request.setCourseid(courseID);
-        return this.grpcSend<Void>(this.agService.updateAssignments, request);
+        return this.grpcSend<AssignmentChanges>(this.agService.updateAssignments, request);
     }
 
     // /* ENROLLMENTS */ //
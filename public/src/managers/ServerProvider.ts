@@ -472,7 +472,8 @@ export class ServerProvider implements IUserProvider, ICourseProvider {
             buildId: buildInfo.buildid,
             buildDate: bDate,
             executionTime: buildInfo.execTime,
-            buildLog: buildInfo.buildlog,
+            // a pending build's message is shown above the log of the last build
+            buildLog: buildInfo.pendinglog ? buildInfo.pendinglog + "\n\n" + buildInfo.buildlog : buildInfo.buildlog,
             testCases: scoreObj,
             reviews: sbm.getReviewsList(),
             released: sbm.getReleased(),
@@ -35,6 +35,7 @@ export interface IBuildInfo {
     builddate: Date;
     buildlog: string;
     execTime: number;
+    pendinglog?: string;
 }
 
 // A single test case object
@@ -118,6 +118,45 @@ func (s *AutograderService) isValidSubmission(submissionID uint64) bool {
 	return s.isValidSubmissionRequest(request)
 }
 
+// hasSubmissionAccess returns true if the given user is teacher of the submission's course,
+// or enrolled in the course and the owner of the submission or member of the submission's group.
+func (s *AutograderService) hasSubmissionAccess(usr *pb.User, submissionID uint64) bool {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return false
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return false
+	}
+	return s.hasCourseAccess(usr.GetID(), assignment.GetCourseID(), func(e *pb.Enrollment) bool {
+		if e.Status == pb.Enrollment_TEACHER {
+			return true
+		}
+		if e.Status != pb.Enrollment_STUDENT {
+			return false
+		}
+		if submission.GetGroupID() > 0 {
+			group, err := s.db.GetGroup(submission.GetGroupID())
+			return err == nil && group.Contains(usr)
+		}
+		return usr.IsOwner(submission.GetUserID())
+	})
+}
+
+// isSubmissionTeacher returns true if the given user is teacher for the course of the given submission.
+func (s *AutograderService) isSubmissionTeacher(usr *pb.User, submissionID uint64) bool {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return false
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return false
+	}
+	return s.isTeacher(usr.GetID(), assignment.GetCourseID())
+}
+
 // isTeacher returns true if the given user is teacher for the given course.
 func (s *AutograderService) isTeacher(userID, courseID uint64) bool {
 	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
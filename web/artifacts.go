@@ -0,0 +1,51 @@
+package web
+
+import (
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/labstack/echo/v4"
+)
+
+// getArtifacts returns the build artifacts of the given submission.
+func (s *AutograderService) getArtifacts(submissionID uint64) (*pb.Artifacts, error) {
+	if s.artifacts == nil {
+		return &pb.Artifacts{}, nil
+	}
+	artifacts, err := s.artifacts.List(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Artifacts{Artifacts: artifacts}, nil
+}
+
+// GetArtifact returns a handler that serves a build artifact of a submission.
+// Artifacts are created by student code and are therefore always served as
+// attachments, so that browsers do not render them as part of the site.
+// Access policy: Teacher of the submission's course,
+// Current User if Owner of submission,
+// Current User if member of group for group submission.
+func GetArtifact(ags *AutograderService) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// If type assertions fails, the recover middleware will catch the panic and log a stack trace.
+		usr := c.Get("user").(*pb.User)
+
+		submissionID, err := strconv.ParseUint(c.Param("sid"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid submission ID")
+		}
+		if !ags.hasSubmissionAccess(usr, submissionID) {
+			return echo.NewHTTPError(http.StatusForbidden, "only owner and teachers can get artifacts")
+		}
+		if ags.artifacts == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "artifact not found")
+		}
+		file, err := ags.artifacts.Path(submissionID, c.Param("*"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound, "artifact not found")
+		}
+		return c.Attachment(file, filepath.Base(file))
+	}
+}
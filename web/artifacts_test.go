@@ -0,0 +1,154 @@
+package web_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// artifactRunner is a runner that outputs the score lines of the job's script
+// without running it, and leaves a report in the job's artifacts folder.
+type artifactRunner struct {
+	scoreRunner
+}
+
+func (r artifactRunner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	report := []byte("all tests passed")
+	if err := tw.WriteHeader(&tar.Header{Name: "artifacts/report.txt", Mode: 0644, Size: int64(len(report)), Typeflag: tar.TypeReg}); err != nil {
+		return "", err
+	}
+	if _, err := tw.Write(report); err != nil {
+		return "", err
+	}
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	if job.CollectArtifacts != nil {
+		job.CollectArtifacts(&archive)
+	}
+	return r.scoreRunner.Run(ctx, job)
+}
+
+func TestArtifacts(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:       course.ID,
+		Name:           "lab1",
+		ScriptFile:     "go.sh",
+		ScriptTemplate: "#image/quickfeed:go\n" + `{"Secret":"{{ .RandomSecret }}","TestName":"TestA","Score":8,"MaxScore":10,"Weight":1}`,
+		Deadline:       "2030-11-11T13:00:00",
+		Order:          1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	students := make([]*pb.User, 2)
+	for i := range students {
+		students[i] = createFakeUser(t, db, uint64(i+2))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: students[i].ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: students[i].ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   1,
+		UserID:         students[0].ID,
+		RepoType:       pb.Repository_USER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: students[0].ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	store, err := ci.NewArtifactStore(root, 1<<20, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, artifactRunner{})
+	ags.SetArtifactStore(store)
+
+	teacherCtx := withUserContext(context.Background(), teacher)
+	if _, err := ags.RebuildSubmission(teacherCtx, &pb.RebuildRequest{SubmissionID: submission.ID, AssignmentID: assignment.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	request := &pb.ArtifactsRequest{SubmissionID: submission.ID}
+	want := &pb.Artifacts{Artifacts: []*pb.Artifact{{Name: "report.txt", FileSize: 16}}}
+	for _, user := range []*pb.User{teacher, students[0]} {
+		artifacts, err := ags.GetArtifacts(withUserContext(context.Background(), user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, artifacts); diff != "" {
+			t.Errorf("GetArtifacts() mismatch (-want +got):\n%s", diff)
+		}
+	}
+	if _, err := ags.GetArtifacts(withUserContext(context.Background(), students[1]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetArtifacts() by other student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	getArtifact := func(user *pb.User, name string) *httptest.ResponseRecorder {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		c.SetParamNames("sid", "*")
+		c.SetParamValues(strconv.FormatUint(submission.GetID(), 10), name)
+		c.Set("user", user)
+		if err := web.GetArtifact(ags)(c); err != nil {
+			if he, ok := err.(*echo.HTTPError); ok {
+				rec.Code = he.Code
+			}
+		}
+		return rec
+	}
+	rec := getArtifact(students[0], "report.txt")
+	if rec.Code != http.StatusOK || rec.Body.String() != "all tests passed" {
+		t.Errorf("GetArtifact(report.txt) = %d %q, want %d %q", rec.Code, rec.Body.String(), http.StatusOK, "all tests passed")
+	}
+	if got := rec.Header().Get(echo.HeaderContentDisposition); got != `attachment; filename="report.txt"` {
+		t.Errorf("GetArtifact(report.txt) content disposition = %q, want attachment", got)
+	}
+	if rec := getArtifact(students[1], "report.txt"); rec.Code != http.StatusForbidden {
+		t.Errorf("GetArtifact(report.txt) by other student = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	// names cannot escape the submission's artifacts folder
+	escape := "../" + strconv.FormatUint(submission.GetID(), 10) + "/report.txt"
+	if rec := getArtifact(students[0], escape); rec.Code != http.StatusNotFound {
+		t.Errorf("GetArtifact(%s) = %d, want %d", escape, rec.Code, http.StatusNotFound)
+	}
+}
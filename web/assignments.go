@@ -8,17 +8,22 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/scm"
 )
 
 var criteriaFile = "criteria.json"
 
 // getAssignments lists the assignments for the provided course.
-func (s *AutograderService) getAssignments(courseID uint64) (*pb.Assignments, error) {
+// Hidden assignments are only included if withHidden is true.
+func (s *AutograderService) getAssignments(courseID uint64, withHidden bool) (*pb.Assignments, error) {
 	allAssignments, err := s.db.GetAssignmentsByCourse(courseID, true)
 	if err != nil {
 		return nil, err
 	}
+	if !withHidden {
+		allAssignments = visibleAssignments(allAssignments)
+	}
 	// Hack to ensure that assignments stored in database with wrong format
 	// is displayed correctly in the frontend. This should ideally be removed
 	// when the database no longer contains any incorrectly formatted dates.
@@ -28,20 +33,53 @@ func (s *AutograderService) getAssignments(courseID uint64) (*pb.Assignments, er
 	return &pb.Assignments{Assignments: allAssignments}, nil
 }
 
-// updateAssignments updates the assignments for the given course.
-func (s *AutograderService) updateAssignments(ctx context.Context, sc scm.SCM, courseID uint64) error {
+// visibleAssignments returns the assignments that are neither hidden nor archived,
+// without the course's script templates.
+func visibleAssignments(assignments []*pb.Assignment) []*pb.Assignment {
+	visible := make([]*pb.Assignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		if !assignment.GetHidden() && !assignment.GetArchived() {
+			assignment.ScriptTemplate = ""
+			visible = append(visible, assignment)
+		}
+	}
+	return visible
+}
+
+// updateAssignments updates the assignments and docker image for the given course,
+// and returns the changes made.
+func (s *AutograderService) updateAssignments(ctx context.Context, sc scm.SCM, courseID uint64) (*pb.AssignmentChanges, error) {
 	course, err := s.db.GetCourse(courseID, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	assignments, err := assignments.FetchAssignments(ctx, sc, course)
+	return assignments.UpdateCourse(ctx, s.logger, s.db, sc, s.runner, course)
+}
+
+// validateTests validates the assignments in the tests repository for the given course.
+func (s *AutograderService) validateTests(ctx context.Context, sc scm.SCM, courseID uint64, solutionsRepo string) (*pb.TestsValidation, error) {
+	course, err := s.db.GetCourse(courseID, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if err = s.db.UpdateAssignments(assignments); err != nil {
-		return err
+	return assignments.ValidateTestsRepo(ctx, s.logger, sc, s.runner, course, solutionsRepo)
+}
+
+// getTestStats returns the pass rate of each test of the given assignment, and on how
+// many commits each test both passed and failed. The assignment must belong to the course.
+func (s *AutograderService) getTestStats(request *pb.AssignmentRequest) (*pb.TestStats, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	outcomes, err := s.db.GetTestOutcomes(assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TestStats{AssignmentID: assignment.GetID(), Stats: ci.TestStats(outcomes)}, nil
 }
 
 func (s *AutograderService) createBenchmark(query *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
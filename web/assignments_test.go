@@ -0,0 +1,59 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTestStats(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RecordTestOutcomes([]*pb.TestOutcome{
+		{AssignmentID: assignment.ID, CommitHash: "abc", TestName: "TestA", Runs: 3, Passes: 2},
+		{AssignmentID: assignment.ID, CommitHash: "def", TestName: "TestA", Runs: 1, Passes: 1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, scoreRunner{})
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+	if _, err := ags.GetTestStats(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetTestStats() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	stats, err := ags.GetTestStats(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.TestStats{
+		AssignmentID: assignment.ID,
+		Stats:        []*pb.TestStat{{TestName: "TestA", Runs: 4, Passes: 3, Commits: 2, FlakyCommits: 1}},
+	}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("GetTestStats() mismatch (-want +got):\n%s", diff)
+	}
+}
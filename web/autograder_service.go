@@ -18,24 +18,41 @@ import (
 // AutograderService holds references to the database and
 // other shared data structures.
 type AutograderService struct {
-	logger *zap.SugaredLogger
-	db     *database.GormDB
-	scms   *auth.Scms
-	bh     BaseHookOptions
-	runner ci.Runner
+	logger    *zap.SugaredLogger
+	db        *database.GormDB
+	scms      *auth.Scms
+	bh        BaseHookOptions
+	runner    ci.Runner
+	rebuilds  *rebuilds
+	builds    *ci.Builds
+	artifacts *ci.ArtifactStore
 }
 
 // NewAutograderService returns an AutograderService object.
 func NewAutograderService(logger *zap.Logger, db *database.GormDB, scms *auth.Scms, bh BaseHookOptions, runner ci.Runner) *AutograderService {
 	return &AutograderService{
-		logger: logger.Sugar(),
-		db:     db,
-		scms:   scms,
-		bh:     bh,
-		runner: runner,
+		logger:   logger.Sugar(),
+		db:       db,
+		scms:     scms,
+		bh:       bh,
+		runner:   runner,
+		rebuilds: newRebuilds(),
+		builds:   ci.NewBuilds(0, 0),
 	}
 }
 
+// SetArtifactStore sets the store for the build artifacts of submissions.
+// Build artifacts are not kept unless a store is set.
+func (s *AutograderService) SetArtifactStore(artifacts *ci.ArtifactStore) {
+	s.artifacts = artifacts
+}
+
+// SetBuilds sets the tracker that queues and cancels builds.
+// By default, builds are not queued.
+func (s *AutograderService) SetBuilds(builds *ci.Builds) {
+	s.builds = builds
+}
+
 // GetUser will return current user with active course enrollments
 // to use in separating teacher and admin roles
 // Access policy: everyone
@@ -520,7 +537,7 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 		s.logger.Error("GetSubmissions failed: user is not teacher or submission author")
 		return nil, status.Errorf(codes.PermissionDenied, "only owner and teachers can get submissions")
 	}
-	submissions, err := s.getSubmissions(in)
+	submissions, err := s.getSubmissions(in, s.isTeacher(usr.GetID(), in.GetCourseID()))
 	if err != nil {
 		s.logger.Errorf("GetSubmissions failed: %w", err)
 		return nil, status.Errorf(codes.NotFound, "no submissions found")
@@ -567,9 +584,12 @@ func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateS
 		s.logger.Error("UpdateSubmission failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can approve submissions")
 	}
-	err = s.updateSubmission(in.GetCourseID(), in.GetSubmissionID(), in.GetStatus(), in.GetReleased(), in.GetScore())
+	err = s.updateSubmission(in.GetCourseID(), in.GetSubmissionID(), in.GetStatus(), in.GetReleased(), in.GetScore(), usr.GetID())
 	if err != nil {
 		s.logger.Errorf("UpdateSubmission failed: %w", err)
+		if errors.Is(err, ErrMissingPrerequisites) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		err = status.Errorf(codes.InvalidArgument, "failed to approve submission")
 	}
 	return &pb.Void{}, err
@@ -588,6 +608,159 @@ func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.Rebuil
 	return submission, nil
 }
 
+// RebuildAssignment starts rebuilding the latest submissions for the given assignment,
+// optionally only those with a given status or a score below a given limit.
+// The rebuild runs in the background; its progress is returned by GetAssignmentRebuild.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) RebuildAssignment(ctx context.Context, in *pb.RebuildAssignmentRequest) (*pb.AssignmentRebuild, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RebuildAssignment failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.ID, in.GetCourseID()) {
+		s.logger.Error("RebuildAssignment failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can rebuild assignments")
+	}
+	rebuild, err := s.rebuildAssignment(in)
+	if err != nil {
+		s.logger.Errorf("RebuildAssignment failed: %w", err)
+		if errors.Is(err, ErrRebuildInProgress) || errors.Is(err, ErrNoTests) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to rebuild assignment")
+	}
+	return rebuild, nil
+}
+
+// GetAssignmentRebuild returns the progress of the latest rebuild of the given assignment,
+// and a summary of the score changes when the rebuild is done.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetAssignmentRebuild(ctx context.Context, in *pb.AssignmentRequest) (*pb.AssignmentRebuild, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAssignmentRebuild failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.ID, in.GetCourseID()) {
+		s.logger.Error("GetAssignmentRebuild failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get assignment rebuilds")
+	}
+	rebuild, err := s.getAssignmentRebuild(in)
+	if err != nil {
+		s.logger.Errorf("GetAssignmentRebuild failed: %w", err)
+		return nil, status.Errorf(codes.NotFound, "no rebuild found for assignment")
+	}
+	return rebuild, nil
+}
+
+// GetArtifacts returns the build artifacts of the given submission.
+// Access policy: Teacher of the submission's course,
+// Current User if Owner of submission,
+// Current User if member of group for group submission.
+func (s *AutograderService) GetArtifacts(ctx context.Context, in *pb.ArtifactsRequest) (*pb.Artifacts, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetArtifacts failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.hasSubmissionAccess(usr, in.GetSubmissionID()) {
+		s.logger.Error("GetArtifacts failed: user is not teacher or submission author")
+		return nil, status.Errorf(codes.PermissionDenied, "only owner and teachers can get artifacts")
+	}
+	artifacts, err := s.getArtifacts(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetArtifacts failed: %w", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get artifacts")
+	}
+	return artifacts, nil
+}
+
+// GetTestStats returns the pass rate of each test of the given assignment across all
+// test runs, and flags tests that both passed and failed on the same commit.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetTestStats(ctx context.Context, in *pb.AssignmentRequest) (*pb.TestStats, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetTestStats failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.ID, in.GetCourseID()) {
+		s.logger.Error("GetTestStats failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get test statistics")
+	}
+	stats, err := s.getTestStats(in)
+	if err != nil {
+		s.logger.Errorf("GetTestStats failed: %w", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get test statistics")
+	}
+	return stats, nil
+}
+
+// GetBuildLog returns the complete, gzip compressed log of the latest build of the given submission.
+// Access policy: Teacher of the submission's course
+func (s *AutograderService) GetBuildLog(ctx context.Context, in *pb.BuildLogRequest) (*pb.BuildLog, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetBuildLog failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isSubmissionTeacher(usr, in.GetSubmissionID()) {
+		s.logger.Error("GetBuildLog failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can get build logs")
+	}
+	buildLog, err := s.db.GetBuildLog(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetBuildLog failed: %w", err)
+		return nil, status.Errorf(codes.NotFound, "no build log found")
+	}
+	return buildLog, nil
+}
+
+// GetLeaderboard returns the anonymized leaderboard of the given assignment's benchmarks.
+// Access policy: Teacher of CourseID,
+// Student of CourseID if the assignment's leaderboard is enabled.
+func (s *AutograderService) GetLeaderboard(ctx context.Context, in *pb.AssignmentRequest) (*pb.Leaderboard, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetLeaderboard failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	isTeacher := s.isTeacher(usr.ID, in.GetCourseID())
+	if !isTeacher && !s.isEnrolled(usr.ID, in.GetCourseID()) {
+		s.logger.Error("GetLeaderboard failed: user is not enrolled in course")
+		return nil, status.Errorf(codes.PermissionDenied, "only enrolled users can get leaderboards")
+	}
+	leaderboard, err := s.getLeaderboard(usr, in, isTeacher)
+	if err != nil {
+		s.logger.Errorf("GetLeaderboard failed: %w", err)
+		if errors.Is(err, ErrNoLeaderboard) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.NotFound, "failed to get leaderboard")
+	}
+	return leaderboard, nil
+}
+
+// CancelBuild cancels the running builds of the given assignment for a user or group.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) CancelBuild(ctx context.Context, in *pb.CancelBuildRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CancelBuild failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.ID, in.GetCourseID()) {
+		s.logger.Error("CancelBuild failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can cancel builds")
+	}
+	if err := s.cancelBuild(in); err != nil {
+		s.logger.Errorf("CancelBuild failed: %w", err)
+		return nil, status.Errorf(codes.NotFound, "failed to cancel build")
+	}
+	return &pb.Void{}, nil
+}
+
 // CreateBenchmark adds a new grading benchmark for an assignment
 // Access policy: Teacher of CourseID
 func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
@@ -769,11 +942,95 @@ func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionR
 	return &pb.Reviewers{Reviewers: reviewers}, err
 }
 
+// CreateRegrade asks for a regrade of the given submission, giving a reason
+// and optionally the test whose score is disputed.
+// Access policy: Current User if Owner of submission,
+// Current User if member of group for group submission.
+func (s *AutograderService) CreateRegrade(ctx context.Context, in *pb.Regrade) (*pb.Regrade, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateRegrade failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.hasSubmissionAccess(usr, in.GetSubmissionID()) || s.isSubmissionTeacher(usr, in.GetSubmissionID()) {
+		s.logger.Error("CreateRegrade failed: user is not submission author")
+		return nil, status.Errorf(codes.PermissionDenied, "only submission owners can ask for regrades")
+	}
+	regrade, err := s.createRegrade(usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateRegrade failed: %w", err)
+		if errors.Is(err, ErrRegradeOpen) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create regrade")
+	}
+	return regrade, nil
+}
+
+// GetRegrades returns the regrades of the given submission, or of all submissions
+// of the given course, with the score updates made for each regrade.
+// Access policy: Reviewers of the submission, or all teachers of CourseID if the
+// submission has no reviewers,
+// Current User if Owner of submission,
+// Current User if member of group for group submission.
+func (s *AutograderService) GetRegrades(ctx context.Context, in *pb.RegradesRequest) (*pb.Regrades, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetRegrades failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetRegrades failed: user is not enrolled in course")
+		return nil, status.Errorf(codes.PermissionDenied, "only enrolled users can get regrades")
+	}
+	regrades, err := s.getRegrades(usr, in)
+	if err != nil {
+		s.logger.Errorf("GetRegrades failed: %w", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get regrades")
+	}
+	return regrades, nil
+}
+
+// UpdateRegrade accepts or rejects an open regrade. A regrade can be accepted with
+// a new score for the regrade's submission, which is recorded for the regrade.
+// Access policy: Reviewers of the submission, or all teachers of CourseID if the
+// submission has no reviewers.
+func (s *AutograderService) UpdateRegrade(ctx context.Context, in *pb.UpdateRegradeRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateRegrade failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("UpdateRegrade failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can resolve regrades")
+	}
+	if err := s.updateRegrade(usr, in); err != nil {
+		s.logger.Errorf("UpdateRegrade failed: %w", err)
+		switch {
+		case errors.Is(err, ErrNotRegradeReviewer):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, ErrRegradeResolved):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update regrade")
+	}
+	return &pb.Void{}, nil
+}
+
 // GetAssignments returns a list of all assignments for the given course.
+// Hidden and archived assignments are only returned to teachers of the course.
+// The prerequisites of each assignment describe the dependency graph
+// between the assignments.
 // Access policy: Any User.
 func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Assignments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAssignments failed: authentication error: %w", err)
+		return nil, ErrInvalidUserInfo
+	}
 	courseID := in.GetCourseID()
-	assignments, err := s.getAssignments(courseID)
+	assignments, err := s.getAssignments(courseID, s.isTeacher(usr.GetID(), courseID))
 	if err != nil {
 		s.logger.Errorf("GetAssignments failed: %w", err)
 		return nil, status.Errorf(codes.NotFound, "no assignments found for course")
@@ -783,8 +1040,9 @@ func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseReq
 
 // UpdateAssignments updates the assignments record in the database
 // by fetching assignment information from the course's test repository.
+// The changes made to the course's assignments are returned.
 // Access policy: Teacher of CourseID.
-func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
+func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.AssignmentChanges, error) {
 	courseID := in.GetCourseID()
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, courseID)
 	if err != nil {
@@ -795,7 +1053,7 @@ func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.Course
 		s.logger.Error("UpdateAssignments failed: user is not teacher")
 		return nil, status.Errorf(codes.PermissionDenied, "only teachers can update course assignments")
 	}
-	err = s.updateAssignments(ctx, scm, courseID)
+	changes, err := s.updateAssignments(ctx, scm, courseID)
 	if err != nil {
 		s.logger.Errorf("UpdateAssignments failed: %w", err)
 		if contextCanceled(ctx) {
@@ -806,7 +1064,36 @@ func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.Course
 		}
 		return nil, status.Errorf(codes.InvalidArgument, "failed to update course assignments")
 	}
-	return &pb.Void{}, nil
+	return changes, nil
+}
+
+// ValidateTests validates the assignments in the course's tests repository and,
+// if a solutions repository is given, reports the scores obtained by running
+// the tests of each assignment against the solutions.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ValidateTests(ctx context.Context, in *pb.TestsValidationRequest) (*pb.TestsValidation, error) {
+	courseID := in.GetCourseID()
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, courseID)
+	if err != nil {
+		s.logger.Errorf("ValidateTests failed: scm authentication error: %w", err)
+		return nil, err
+	}
+	if !s.isTeacher(usr.ID, courseID) {
+		s.logger.Error("ValidateTests failed: user is not teacher")
+		return nil, status.Errorf(codes.PermissionDenied, "only teachers can validate course tests")
+	}
+	report, err := s.validateTests(ctx, scm, courseID, in.GetSolutionsRepo())
+	if err != nil {
+		s.logger.Errorf("ValidateTests failed: %w", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to validate course tests")
+	}
+	return report, nil
 }
 
 // GetProviders returns a list of SCM providers supported by the backend.
@@ -3,6 +3,7 @@ package web
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -14,6 +15,10 @@ import (
 
 var layout = "2006-01-02T15:04:05"
 
+// ErrMissingPrerequisites indicates that a submission cannot be approved
+// because one or more of the assignment's prerequisites are not approved.
+var ErrMissingPrerequisites = errors.New("prerequisite assignments not approved")
+
 // getCourses returns all courses.
 func (s *AutograderService) getCourses() (*pb.Courses, error) {
 	courses, err := s.db.GetCourses()
@@ -130,7 +135,8 @@ func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
 }
 
 // getSubmissions returns all the latests submissions for a user of the given course.
-func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.Submissions, error) {
+// Submissions for hidden assignments are only included if withHidden is true.
+func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest, withHidden bool) (*pb.Submissions, error) {
 	// only one of user ID and group ID will be set; enforced by IsValid on pb.SubmissionRequest
 	query := &pb.Submission{
 		UserID:  request.GetUserID(),
@@ -140,12 +146,41 @@ func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.S
 	if err != nil {
 		return nil, err
 	}
+	if !withHidden {
+		if submissions, err = s.withoutHiddenAssignments(request.GetCourseID(), submissions); err != nil {
+			return nil, err
+		}
+	}
 	for _, sbm := range submissions {
 		sbm.MakeSubmissionReviews()
+		if err := sbm.MakeTestResults(); err != nil {
+			// the other submissions are still listed; only this submission's test results are missing
+			s.logger.Errorf("Failed to decode test results of submission %d: %v", sbm.GetID(), err)
+		}
 	}
 	return &pb.Submissions{Submissions: submissions}, nil
 }
 
+// withoutHiddenAssignments returns the given submissions, except those for
+// assignments that are hidden from students or archived.
+func (s *AutograderService) withoutHiddenAssignments(courseID uint64, submissions []*pb.Submission) ([]*pb.Submission, error) {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[uint64]bool)
+	for _, assignment := range assignments {
+		hidden[assignment.GetID()] = assignment.GetHidden() || assignment.GetArchived()
+	}
+	visible := make([]*pb.Submission, 0, len(submissions))
+	for _, submission := range submissions {
+		if !hidden[submission.GetAssignmentID()] {
+			visible = append(visible, submission)
+		}
+	}
+	return visible, nil
+}
+
 // getAllCourseSubmissions returns all individual lab submissions by students enrolled in the specified course.
 func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
 	assignments, err := s.db.GetCourseAssignmentsWithSubmissions(request.GetCourseID(), request.Type)
@@ -245,7 +280,7 @@ func (s *AutograderService) makeGroupResults(course *pb.Course, assignments []*p
 }
 
 // updateSubmission updates submission status or sets a submission score based on a manual review.
-func (s *AutograderService) updateSubmission(courseID, submissionID uint64, status pb.Submission_Status, released bool, score uint32) error {
+func (s *AutograderService) updateSubmission(courseID, submissionID uint64, status pb.Submission_Status, released bool, score uint32, updatedByID uint64) error {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
 	if err != nil {
 		return err
@@ -253,6 +288,17 @@ func (s *AutograderService) updateSubmission(courseID, submissionID uint64, stat
 
 	// if approving previously unapproved submission
 	if status == pb.Submission_APPROVED && submission.Status != pb.Submission_APPROVED {
+		assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+		if err != nil {
+			return err
+		}
+		missing, err := ci.MissingPrerequisites(s.db, assignment, submission.GetUserID(), submission.GetGroupID())
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%w: %v", ErrMissingPrerequisites, missing)
+		}
 		submission.ApprovedDate = time.Now().Format(layout)
 		if err := s.setLastApprovedAssignment(submission, courseID); err != nil {
 			return err
@@ -260,10 +306,17 @@ func (s *AutograderService) updateSubmission(courseID, submissionID uint64, stat
 	}
 	submission.Status = status
 	submission.Released = released
+	oldScore := submission.GetScore()
 	if score > 0 {
 		submission.Score = score
 	}
-	return s.db.UpdateSubmission(submission)
+	if err := s.db.UpdateSubmission(submission); err != nil {
+		return err
+	}
+	if submission.GetScore() != oldScore {
+		return s.recordScoreUpdate(submission, oldScore, updatedByID)
+	}
+	return nil
 }
 
 // updateSubmissions updates status and release state of multiple submissions for the
@@ -454,6 +507,7 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 		if err := s.db.CreateRepository(&userRepo); err != nil {
 			return err
 		}
+		s.seedRepository(sc, course, repo.Path)
 	}
 
 	return s.db.UpdateEnrollment(userEnrolQuery)
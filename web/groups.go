@@ -3,6 +3,7 @@ package web
 import (
 	"context"
 	"fmt"
+	"path"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/scm"
@@ -146,6 +147,7 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 		if err := s.db.CreateRepository(repo); err != nil {
 			return err
 		}
+		s.seedRepository(sc, course, path.Base(repo.GetHTMLURL()))
 		newGroup.TeamID = team.ID
 		// when updating a group for an existing team, name changes are not allowed.
 		// this to avoid a mismatch between database group name and SCM team name
@@ -16,15 +16,19 @@ import (
 
 // GitHubWebHook holds references and data for handling webhook events.
 type GitHubWebHook struct {
-	logger *zap.SugaredLogger
-	db     database.Database
-	runner ci.Runner
-	secret string
+	logger    *zap.SugaredLogger
+	db        database.Database
+	runner    ci.Runner
+	artifacts *ci.ArtifactStore
+	builds    *ci.Builds
+	secret    string
 }
 
 // NewGitHubWebHook creates a new webhook to handle POST requests from GitHub to the Autograder server.
-func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, secret string) *GitHubWebHook {
-	return &GitHubWebHook{logger: logger, db: db, runner: runner, secret: secret}
+// The build artifacts of the test runs are kept in the given artifact store, unless it is nil.
+// Builds superseded by a newer push are canceled, if tracked by the given builds.
+func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, artifacts *ci.ArtifactStore, builds *ci.Builds, secret string) *GitHubWebHook {
+	return &GitHubWebHook{logger: logger, db: db, runner: runner, artifacts: artifacts, builds: builds, secret: secret}
 }
 
 // Handle take POST requests from GitHub, representing Push events
@@ -53,6 +57,9 @@ func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
 }
 
 func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
+	// builds are ordered by the time at which their pushes are received,
+	// since the timestamps of the pushed commits are set by the students
+	pushTime := time.Now()
 	wh.logger.Debugf("Received push event for branch reference: %s (user's default branch: %s)",
 		payload.GetRef(), payload.GetRepo().GetDefaultBranch())
 	if !strings.HasSuffix(payload.GetRef(), payload.GetRepo().GetDefaultBranch()) {
@@ -77,8 +84,19 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	switch {
 	case repo.IsTestsRepo():
 		// the push event is for the 'tests' repo, which means that we
-		// should update the course data (assignments) in the database
-		assignments.UpdateFromTestsRepo(wh.logger, wh.db, repo, course)
+		// should update the course data (assignments) in the database;
+		// this may build the course's docker image, so it is done in the background
+		go assignments.UpdateFromTestsRepo(wh.logger, wh.db, wh.runner, repo, course)
+
+	case repo.IsAssignmentsRepo():
+		// the push event is for the 'assignments' repo, which means that we
+		// may need to push new assignments to the student and group repos;
+		// this clones many repositories, so it is done in the background
+		go assignments.PushToStudentRepos(wh.logger, wh.db, course)
+
+	case (repo.IsUserRepo() || repo.IsGroupRepo()) && isQuickFeedPush(payload, wh.tokenUser(course)):
+		// starter code pushed by QuickFeed is not a submission
+		wh.logger.Debugf("Ignoring QuickFeed's push to repo %s", payload.GetRepo().GetName())
 
 	case repo.IsUserRepo():
 		wh.logger.Debugf("Processing push event for user repo %s", payload.GetRepo().GetName())
@@ -86,8 +104,9 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 		assignments := wh.extractAssignments(payload, course)
 		for _, assignment := range assignments {
 			if !assignment.IsGroupLab {
-				// only run non-group assignments
-				wh.runAssignmentTests(assignment, repo, course, payload)
+				// only run non-group assignments; builds may wait for a worker,
+				// so they are run in the background
+				go wh.runAssignmentTests(assignment, repo, course, payload, pushTime)
 			} else {
 				wh.logger.Debugf("Ignoring assignment: %s, pushed to user repo: %s", assignment.GetName(), payload.GetRepo().GetName())
 			}
@@ -104,8 +123,9 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 		assignments := wh.extractAssignments(payload, course)
 		for _, assignment := range assignments {
 			if assignment.IsGroupLab {
-				// only run group assignments
-				wh.runAssignmentTests(assignment, repo, course, payload)
+				// only run group assignments; builds may wait for a worker,
+				// so they are run in the background
+				go wh.runAssignmentTests(assignment, repo, course, payload, pushTime)
 			} else {
 				wh.logger.Debugf("Ignoring assignment: %s, pushed to group repo: %s", assignment.GetName(), payload.GetRepo().GetName())
 			}
@@ -116,6 +136,33 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	}
 }
 
+// tokenUser returns the login of the course creator, whose access token QuickFeed
+// uses to push starter code, or the empty string if the creator is not found.
+func (wh GitHubWebHook) tokenUser(course *pb.Course) string {
+	creator, err := wh.db.GetUser(course.GetCourseCreatorID())
+	if err != nil {
+		wh.logger.Errorf("Failed to get course creator from database: %w", err)
+		return ""
+	}
+	return creator.GetLogin()
+}
+
+// isQuickFeedPush returns true if the push event is for QuickFeed's own push of
+// starter code, that is, if the pusher or the author of the head commit is QuickFeed
+// or the given token user, or if the head commit's message has QuickFeed's marker.
+func isQuickFeedPush(payload *github.PushEvent, tokenUser string) bool {
+	author := payload.GetHeadCommit().GetAuthor()
+	switch {
+	case strings.HasSuffix(strings.TrimSpace(payload.GetHeadCommit().GetMessage()), assignments.CommitMarker):
+		return true
+	case author.GetEmail() == assignments.CommitEmail, payload.GetPusher().GetEmail() == assignments.CommitEmail:
+		return true
+	case tokenUser == "":
+		return false
+	}
+	return author.GetLogin() == tokenUser || payload.GetPusher().GetName() == tokenUser || payload.GetPusher().GetLogin() == tokenUser
+}
+
 // extractAssignments extracts information from the push payload from github
 // and determines the assignments that have been changed in this commit by
 // querying the database based on the lab name.
@@ -157,14 +204,25 @@ func extractChanges(changes []string, modifiedAssignments map[string]bool) {
 	}
 }
 
-// runAssignmentTests runs the tests for the given assignment pushed to repo.
-func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, payload *github.PushEvent) {
+// runAssignmentTests runs the tests for the given assignment pushed to repo at the given time.
+func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, payload *github.PushEvent, pushTime time.Time) {
 	runData := &ci.RunData{
 		Course:     course,
 		Assignment: assignment,
 		Repo:       repo,
 		CommitID:   payload.GetHeadCommit().GetID(),
 		JobOwner:   payload.GetSender().GetLogin(),
+		Artifacts:  wh.artifacts,
+		PushTime:   pushTime,
+		Builds:     wh.builds,
+	}
+	if assignment.Hidden {
+		wh.logger.Debugf("Ignoring push for hidden assignment: %s", assignment.GetName())
+		return
+	}
+	if assignment.Archived {
+		wh.logger.Debugf("Ignoring push for archived assignment: %s", assignment.GetName())
+		return
 	}
 	if assignment.SkipTests {
 		wh.recordSubmissionWithoutTests(runData)
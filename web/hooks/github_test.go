@@ -7,10 +7,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/autograde/quickfeed/assignments"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v30/github"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -74,7 +76,7 @@ func TestGitHubWebHook(t *testing.T) {
 
 	var db database.Database
 	var runner ci.Runner
-	webhook := NewGitHubWebHook(logger, db, runner, secret)
+	webhook := NewGitHubWebHook(logger, db, runner, nil, nil, secret)
 
 	log.Println("starting webhook server")
 	http.HandleFunc("/webhook", webhook.Handle)
@@ -121,3 +123,37 @@ func TestExtractChanges(t *testing.T) {
 		t.Fatalf("content mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestIsQuickFeedPush(t *testing.T) {
+	push := func(pusher, authorLogin, authorEmail, message string) *github.PushEvent {
+		return &github.PushEvent{
+			Pusher: &github.User{Name: github.String(pusher)},
+			HeadCommit: &github.HeadCommit{
+				Message: github.String(message),
+				Author: &github.CommitAuthor{
+					Login: github.String(authorLogin),
+					Email: github.String(authorEmail),
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name      string
+		payload   *github.PushEvent
+		tokenUser string
+		want      bool
+	}{
+		{"student", push("student", "student", "student@example.com", "Solve lab1"), "teacher", false},
+		{"student, no token user", push("student", "student", "student@example.com", "Solve lab1"), "", false},
+		{"starter code", push("teacher", "", assignments.CommitEmail, "Add starter code\n\n"+assignments.CommitMarker), "teacher", true},
+		{"marker", push("student", "student", "student@example.com", "Add assignments: lab2\n\n"+assignments.CommitMarker+"\n"), "", true},
+		{"QuickFeed author", push("student", "", assignments.CommitEmail, "Add assignments: lab2"), "", true},
+		{"token user pusher", push("teacher", "student", "student@example.com", "Solve lab1"), "teacher", true},
+		{"token user author", push("student", "teacher", "teacher@example.com", "Fix lab1"), "teacher", true},
+	}
+	for _, test := range tests {
+		if got := isQuickFeedPush(test.payload, test.tokenUser); got != test.want {
+			t.Errorf("isQuickFeedPush(%s) = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+)
+
+// ErrNoLeaderboard is returned when students ask for the leaderboard
+// of an assignment whose leaderboard is not enabled.
+var ErrNoLeaderboard = errors.New("assignment has no leaderboard")
+
+// getLeaderboard returns the requested assignment's anonymized leaderboard,
+// ranking the benchmark results of each submission. The entries of the
+// submission of the given user, or of the user's group, are marked as own.
+// Students only get the leaderboard if it is enabled for the assignment.
+// The leaderboard is opt-in per assignment, not per student: entries carry
+// no user or group, only metrics, so taking part does not reveal a student.
+func (s *AutograderService) getLeaderboard(usr *pb.User, request *pb.AssignmentRequest, isTeacher bool) (*pb.Leaderboard, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	if !isTeacher && (!assignment.GetLeaderboard() || assignment.GetHidden()) {
+		return nil, ErrNoLeaderboard
+	}
+	var groupID uint64
+	if enrollment, err := s.db.GetEnrollmentByCourseAndUser(assignment.GetCourseID(), usr.GetID()); err == nil {
+		groupID = enrollment.GetGroupID()
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string][]*pb.LeaderboardEntry)
+	for _, submission := range submissions {
+		var buildInfo ci.BuildInfo
+		if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err != nil {
+			continue
+		}
+		own := submission.GetUserID() == usr.GetID() || (groupID > 0 && submission.GetGroupID() == groupID)
+		for _, bm := range buildInfo.Benchmarks {
+			results[bm.Name] = append(results[bm.Name], &pb.LeaderboardEntry{
+				Benchmark:   bm.Name,
+				NsPerOp:     bm.NsPerOp,
+				AllocsPerOp: bm.AllocsPerOp,
+				BytesPerOp:  bm.BytesPerOp,
+				Own:         own,
+			})
+		}
+	}
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	leaderboard := &pb.Leaderboard{AssignmentID: assignment.GetID()}
+	for _, name := range names {
+		entries := results[name]
+		sort.SliceStable(entries, func(i, j int) bool {
+			if entries[i].NsPerOp != entries[j].NsPerOp {
+				return entries[i].NsPerOp < entries[j].NsPerOp
+			}
+			return entries[i].AllocsPerOp < entries[j].AllocsPerOp
+		})
+		for i, entry := range entries {
+			entry.Rank = uint32(i + 1)
+			if i > 0 && entry.NsPerOp == entries[i-1].NsPerOp {
+				entry.Rank = entries[i-1].Rank
+			}
+		}
+		leaderboard.Entries = append(leaderboard.Entries, entries...)
+	}
+	return leaderboard, nil
+}
@@ -0,0 +1,86 @@
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetLeaderboard(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Leaderboard: true}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	students := make([]*pb.User, 3)
+	for i := range students {
+		students[i] = createFakeUser(t, db, uint64(i+2))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: students[i].ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: students[i].ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, nsPerOp := range []int64{3000, 1000, 3000} {
+		buildInfo, err := json.Marshal(&ci.BuildInfo{Benchmarks: []*ci.BenchmarkResult{
+			{Name: "TestSort", NsPerOp: nsPerOp, AllocsPerOp: int64(i), BytesPerOp: 16},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab1.ID, UserID: students[i].ID, BuildInfo: string(buildInfo)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	outsider := createFakeUser(t, db, 5)
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, scoreRunner{})
+
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: lab1.ID}
+	leaderboard, err := ags.GetLeaderboard(withUserContext(context.Background(), students[0]), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.Leaderboard{
+		AssignmentID: lab1.ID,
+		Entries: []*pb.LeaderboardEntry{
+			{Benchmark: "TestSort", Rank: 1, NsPerOp: 1000, AllocsPerOp: 1, BytesPerOp: 16},
+			{Benchmark: "TestSort", Rank: 2, NsPerOp: 3000, AllocsPerOp: 0, BytesPerOp: 16, Own: true},
+			{Benchmark: "TestSort", Rank: 2, NsPerOp: 3000, AllocsPerOp: 2, BytesPerOp: 16},
+		},
+	}
+	if diff := cmp.Diff(want, leaderboard); diff != "" {
+		t.Errorf("GetLeaderboard() mismatch (-want +got):\n%s", diff)
+	}
+
+	// teachers can get the leaderboard of any assignment
+	if _, err := ags.GetLeaderboard(withUserContext(context.Background(), teacher), &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: lab2.ID}); err != nil {
+		t.Errorf("GetLeaderboard() by teacher: %v", err)
+	}
+	if _, err := ags.GetLeaderboard(withUserContext(context.Background(), students[0]), &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: lab2.ID}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetLeaderboard() without leaderboard: got error %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.GetLeaderboard(withUserContext(context.Background(), outsider), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetLeaderboard() by user not in course: got error %v, want %v", err, codes.PermissionDenied)
+	}
+}
@@ -2,6 +2,10 @@ package web
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -9,6 +13,9 @@ import (
 )
 
 // rebuildSubmission rebuilds the given assignment and submission.
+// Unless the request asks for the latest tests, the submission is rebuilt
+// with the same tests commit as the original build. The previous result is
+// reused if nothing relevant has changed, unless the request asks for a fresh run.
 func (s *AutograderService) rebuildSubmission(ctx context.Context, request *pb.RebuildRequest) (*pb.Submission, error) {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 	if err != nil {
@@ -34,17 +41,51 @@ func (s *AutograderService) rebuildSubmission(ctx context.Context, request *pb.R
 		return nil, err
 	}
 
+	if !request.GetLatestTests() {
+		// rebuild with the tests commit used for the original build, if known
+		var buildInfo ci.BuildInfo
+		if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err == nil && ci.IsCommit(buildInfo.TestsCommit) &&
+			buildInfo.TestsCommit != assignment.GetTestsCommit() {
+			assignment.TestsCommit = buildInfo.TestsCommit
+			// the course's script template may have changed since the original build
+			if assignment.GetScriptTemplate() != "" {
+				if err := ci.LoadCourseScript(ctx, course, assignment, repo.GetTestURL()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	runData := &ci.RunData{
 		Course:     course,
 		Assignment: assignment,
 		Repo:       repo,
 		CommitID:   submission.GetCommitHash(),
 		JobOwner:   slug.Make(name),
+		NoCache:    request.GetNoCache(),
+		Artifacts:  s.artifacts,
+		Builds:     s.builds,
 	}
 	ci.RunTests(s.logger, s.db, s.runner, runData)
 	return s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 }
 
+// cancelBuild cancels the running builds of the requested assignment for a user or group.
+func (s *AutograderService) cancelBuild(request *pb.CancelBuildRequest) error {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	if s.builds.Cancel(assignment.GetID(), request.GetUserID(), request.GetGroupID()) == 0 {
+		return fmt.Errorf("no running builds of assignment %d for user %d or group %d",
+			assignment.GetID(), request.GetUserID(), request.GetGroupID())
+	}
+	return nil
+}
+
 func (s *AutograderService) lookupName(submission *pb.Submission) string {
 	if submission.GetGroupID() > 0 {
 		group, _ := s.db.GetGroup(submission.GetGroupID())
@@ -53,3 +94,178 @@ func (s *AutograderService) lookupName(submission *pb.Submission) string {
 	user, _ := s.db.GetUser(submission.GetUserID())
 	return user.GetLogin()
 }
+
+var (
+	// ErrRebuildInProgress indicates that the assignment is already being rebuilt.
+	ErrRebuildInProgress = errors.New("assignment rebuild already in progress")
+	// ErrNoTests indicates that the assignment has no tests to run.
+	ErrNoTests = errors.New("assignment has no tests")
+)
+
+// rebuilds holds the progress of the latest rebuild of each assignment.
+type rebuilds struct {
+	mu       sync.Mutex
+	progress map[uint64]*pb.AssignmentRebuild
+}
+
+func newRebuilds() *rebuilds {
+	return &rebuilds{progress: make(map[uint64]*pb.AssignmentRebuild)}
+}
+
+// start records a new rebuild of the given number of submissions for the assignment,
+// unless the assignment is already being rebuilt.
+func (r *rebuilds) start(assignmentID uint64, total int) (*pb.AssignmentRebuild, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if rebuild, ok := r.progress[assignmentID]; ok && !rebuild.GetDone() {
+		return nil, ErrRebuildInProgress
+	}
+	rebuild := &pb.AssignmentRebuild{
+		AssignmentID: assignmentID,
+		Total:        uint32(total),
+		Done:         total == 0,
+	}
+	r.progress[assignmentID] = rebuild
+	return copyRebuild(rebuild), nil
+}
+
+// update records the outcome of rebuilding the given submission.
+// The submission is considered failed if no new build was recorded.
+func (r *rebuilds) update(assignmentID uint64, old, rebuilt *pb.Submission, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rebuild := r.progress[assignmentID]
+	if err != nil || rebuilt.GetBuildInfo() == old.GetBuildInfo() {
+		rebuild.Failed++
+		return
+	}
+	rebuild.Rebuilt++
+	oldScore, newScore := old.GetScore(), rebuilt.GetScore()
+	if oldScore == newScore {
+		return
+	}
+	if newScore > oldScore {
+		rebuild.Increased++
+	} else {
+		rebuild.Decreased++
+	}
+	rebuild.ScoreChanges = append(rebuild.ScoreChanges, &pb.ScoreChange{
+		SubmissionID: old.GetID(),
+		UserID:       old.GetUserID(),
+		GroupID:      old.GetGroupID(),
+		OldScore:     oldScore,
+		NewScore:     newScore,
+	})
+}
+
+// finish marks the rebuild of the given assignment as done.
+func (r *rebuilds) finish(assignmentID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.progress[assignmentID].Done = true
+}
+
+// get returns the progress of the latest rebuild of the given assignment, if any.
+func (r *rebuilds) get(assignmentID uint64) *pb.AssignmentRebuild {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rebuild, ok := r.progress[assignmentID]
+	if !ok {
+		return nil
+	}
+	return copyRebuild(rebuild)
+}
+
+// copyRebuild returns a copy of the given rebuild that can be used without holding the lock.
+func copyRebuild(rebuild *pb.AssignmentRebuild) *pb.AssignmentRebuild {
+	c := *rebuild
+	c.ScoreChanges = append([]*pb.ScoreChange(nil), rebuild.ScoreChanges...)
+	return &c
+}
+
+// rebuildAssignment starts rebuilding the latest submissions for the given assignment
+// that match the request, and returns the initial progress of the rebuild.
+func (s *AutograderService) rebuildAssignment(request *pb.RebuildAssignmentRequest) (*pb.AssignmentRebuild, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	if assignment.GetSkipTests() {
+		return nil, ErrNoTests
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	selected := selectSubmissions(submissions, request.GetStatuses(), request.GetScoreBelow())
+	rebuild, err := s.rebuilds.start(assignment.GetID(), len(selected))
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debugf("Rebuilding %d submissions for assignment %s", len(selected), assignment.GetName())
+	go s.rebuildSubmissions(request, selected)
+	return rebuild, nil
+}
+
+// rebuildSubmissions rebuilds the given submissions one at a time,
+// recording the progress of the assignment's rebuild.
+func (s *AutograderService) rebuildSubmissions(request *pb.RebuildAssignmentRequest, submissions []*pb.Submission) {
+	assignmentID := request.GetAssignmentID()
+	defer s.rebuilds.finish(assignmentID)
+	for _, submission := range submissions {
+		rebuilt, err := s.rebuildSubmission(context.Background(), &pb.RebuildRequest{
+			SubmissionID: submission.GetID(),
+			AssignmentID: assignmentID,
+			LatestTests:  request.GetLatestTests(),
+			NoCache:      request.GetNoCache(),
+		})
+		if err != nil {
+			s.logger.Errorf("Failed to rebuild submission %d: %v", submission.GetID(), err)
+		}
+		s.rebuilds.update(assignmentID, submission, rebuilt, err)
+	}
+}
+
+// selectSubmissions returns the submissions with one of the given statuses and
+// a score below the given limit. Empty statuses and a zero limit match all submissions.
+func selectSubmissions(submissions []*pb.Submission, statuses []pb.Submission_Status, scoreBelow uint32) []*pb.Submission {
+	var selected []*pb.Submission
+	for _, submission := range submissions {
+		if scoreBelow > 0 && submission.GetScore() >= scoreBelow {
+			continue
+		}
+		if len(statuses) > 0 && !hasStatus(statuses, submission.GetStatus()) {
+			continue
+		}
+		selected = append(selected, submission)
+	}
+	return selected
+}
+
+func hasStatus(statuses []pb.Submission_Status, status pb.Submission_Status) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// getAssignmentRebuild returns the progress of the latest rebuild of the requested assignment.
+func (s *AutograderService) getAssignmentRebuild(request *pb.AssignmentRequest) (*pb.AssignmentRebuild, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	rebuild := s.rebuilds.get(assignment.GetID())
+	if rebuild == nil {
+		return nil, fmt.Errorf("assignment %d has not been rebuilt", assignment.GetID())
+	}
+	return rebuild, nil
+}
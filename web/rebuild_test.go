@@ -0,0 +1,230 @@
+package web_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scoreRunner is a runner that outputs the score lines of the job's script without running it.
+type scoreRunner struct{}
+
+func (scoreRunner) Run(_ context.Context, job *ci.Job) (string, error) {
+	var out []string
+	for _, cmd := range job.Commands {
+		if score.HasPrefix(cmd) {
+			out = append(out, cmd)
+		}
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+func TestRebuildAssignment(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:       course.ID,
+		Name:           "lab1",
+		ScriptFile:     "go.sh",
+		ScriptTemplate: "#image/quickfeed:go\n" + `{"Secret":"{{ .RandomSecret }}","TestName":"TestA","Score":8,"MaxScore":10,"Weight":1}`,
+		Deadline:       "2030-11-11T13:00:00",
+		Order:          1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	scores := []uint32{50, 90}
+	students := make([]*pb.User, len(scores))
+	submissions := make([]*pb.Submission, len(scores))
+	for i, score := range scores {
+		students[i] = createFakeUser(t, db, uint64(i+2))
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: students[i].ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: students[i].ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: course.OrganizationID,
+			RepositoryID:   uint64(i + 1),
+			UserID:         students[i].ID,
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		submissions[i] = &pb.Submission{AssignmentID: assignment.ID, UserID: students[i].ID, Score: score, BuildInfo: `{"builddate":"2020-01-01T10:00:00"}`}
+		if err := db.CreateSubmission(submissions[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, scoreRunner{})
+
+	request := &pb.RebuildAssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID, ScoreBelow: 60}
+	if _, err := ags.RebuildAssignment(withUserContext(context.Background(), students[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RebuildAssignment() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	rebuild, err := ags.RebuildAssignment(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rebuild.GetTotal() != 1 {
+		t.Errorf("RebuildAssignment() total = %d, want 1", rebuild.GetTotal())
+	}
+	for !rebuild.GetDone() {
+		time.Sleep(10 * time.Millisecond)
+		if rebuild, err = ags.GetAssignmentRebuild(ctx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := &pb.AssignmentRebuild{
+		AssignmentID: assignment.ID,
+		Total:        1,
+		Rebuilt:      1,
+		Done:         true,
+		Increased:    1,
+		ScoreChanges: []*pb.ScoreChange{
+			{SubmissionID: submissions[0].ID, UserID: students[0].ID, OldScore: 50, NewScore: 80},
+		},
+	}
+	if diff := cmp.Diff(want, rebuild); diff != "" {
+		t.Errorf("GetAssignmentRebuild() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the submission with a score above the limit is not rebuilt
+	unchanged, err := db.GetSubmission(&pb.Submission{ID: submissions[1].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unchanged.GetScore() != 90 {
+		t.Errorf("submission score = %d, want 90", unchanged.GetScore())
+	}
+
+	// the complete log of the rebuild is available to teachers, with the session secret hidden
+	logRequest := &pb.BuildLogRequest{SubmissionID: submissions[0].ID}
+	if _, err := ags.GetBuildLog(withUserContext(context.Background(), students[0]), logRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetBuildLog() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	buildLog, err := ags.GetBuildLog(ctx, logRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log, err := ci.DecompressLog(buildLog.GetLog())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Secret":"hidden","TestName":"TestA","Score":8,"MaxScore":10,"Weight":1}`; log != want {
+		t.Errorf("GetBuildLog() = %q, want %q", log, want)
+	}
+	if _, err := ags.GetBuildLog(ctx, &pb.BuildLogRequest{SubmissionID: submissions[1].ID}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetBuildLog() of submission not rebuilt: got error %v, want %v", err, codes.NotFound)
+	}
+}
+
+// blockingRunner is a runner that signals that a job has started,
+// and blocks until the job is canceled.
+type blockingRunner struct {
+	started chan struct{}
+}
+
+func (r blockingRunner) Run(ctx context.Context, _ *ci.Job) (string, error) {
+	r.started <- struct{}{}
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestCancelBuild(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:       course.ID,
+		Name:           "lab1",
+		ScriptFile:     "go.sh",
+		ScriptTemplate: "#image/quickfeed:go\ngo test ./...",
+		Deadline:       "2030-11-11T13:00:00",
+		Order:          1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   1,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 50}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	runner := blockingRunner{started: make(chan struct{}, 1)}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, runner)
+	ctx := withUserContext(context.Background(), teacher)
+
+	rebuilt := make(chan error, 1)
+	go func() {
+		_, err := ags.RebuildSubmission(ctx, &pb.RebuildRequest{SubmissionID: submission.ID, AssignmentID: assignment.ID})
+		rebuilt <- err
+	}()
+	<-runner.started
+
+	request := &pb.CancelBuildRequest{CourseID: course.ID, AssignmentID: assignment.ID, UserID: student.ID}
+	if _, err := ags.CancelBuild(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CancelBuild() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.CancelBuild(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-rebuilt; err != nil {
+		t.Fatal(err)
+	}
+	// the canceled build is not recorded
+	submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions) != 1 {
+		t.Errorf("GetSubmissions() = %d submissions, want 1", len(submissions))
+	}
+	if _, err := ags.CancelBuild(ctx, request); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelBuild() without running build: got error %v, want %v", err, codes.NotFound)
+	}
+}
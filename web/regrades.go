@@ -0,0 +1,209 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+var (
+	// ErrRegradeOpen is returned when a student asks for a regrade
+	// of a submission that already has an open regrade.
+	ErrRegradeOpen = errors.New("submission already has an open regrade")
+	// ErrRegradeResolved is returned when a regrade that was already
+	// accepted or rejected is resolved again.
+	ErrRegradeResolved = errors.New("regrade is already resolved")
+	// ErrNotRegradeReviewer is returned when a user that is not
+	// a reviewer of the regrade's submission resolves the regrade.
+	ErrNotRegradeReviewer = errors.New("only the submission's reviewers can resolve regrades")
+)
+
+// isRegradeReviewer returns true if the given user is a teacher of the course, and reviews
+// the given submission. If no reviewers are assigned to the submission, all teachers of
+// the course review its regrades.
+func (s *AutograderService) isRegradeReviewer(usr *pb.User, courseID uint64, submission *pb.Submission) bool {
+	if !s.isTeacher(usr.GetID(), courseID) {
+		return false
+	}
+	if len(submission.GetReviews()) == 0 {
+		return true
+	}
+	for _, review := range submission.GetReviews() {
+		if review.GetReviewerID() == usr.GetID() {
+			return true
+		}
+	}
+	return false
+}
+
+// getRegradeSubmission returns the given submission and the course ID of its assignment.
+func (s *AutograderService) getRegradeSubmission(submissionID uint64) (*pb.Submission, uint64, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, 0, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, 0, err
+	}
+	return submission, assignment.GetCourseID(), nil
+}
+
+// createRegrade records the given user's request for a regrade of a submission.
+// A submission can only have one open regrade at a time.
+func (s *AutograderService) createRegrade(usr *pb.User, request *pb.Regrade) (*pb.Regrade, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
+	if err != nil {
+		return nil, err
+	}
+	if request.GetTestName() != "" && !hasTest(submission, request.GetTestName()) {
+		return nil, fmt.Errorf("submission %d has no test %s", submission.GetID(), request.GetTestName())
+	}
+	regrades, err := s.db.GetRegrades(&pb.Regrade{SubmissionID: submission.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	for _, regrade := range regrades {
+		if regrade.GetState() == pb.Regrade_OPEN {
+			return nil, ErrRegradeOpen
+		}
+	}
+	regrade := &pb.Regrade{
+		SubmissionID: submission.GetID(),
+		UserID:       usr.GetID(),
+		Reason:       request.GetReason(),
+		TestName:     request.GetTestName(),
+		State:        pb.Regrade_OPEN,
+		CreatedDate:  time.Now().Format(layout),
+	}
+	if err := s.db.CreateRegrade(regrade); err != nil {
+		return nil, err
+	}
+	return regrade, nil
+}
+
+// hasTest returns true if the given submission has a score for the given test.
+func hasTest(submission *pb.Submission, testName string) bool {
+	if err := submission.MakeTestResults(); err != nil {
+		return false
+	}
+	for _, test := range submission.GetTests() {
+		if test.GetTestName() == testName {
+			return true
+		}
+	}
+	return false
+}
+
+// getRegrades returns the regrades of the requested submission, or of all submissions
+// of the requested course, that are visible to the given user. Regrades are visible
+// to the owners of the submission, and to the submission's reviewers.
+func (s *AutograderService) getRegrades(usr *pb.User, request *pb.RegradesRequest) (*pb.Regrades, error) {
+	var regrades []*pb.Regrade
+	var err error
+	if request.GetSubmissionID() > 0 {
+		regrades, err = s.db.GetRegrades(&pb.Regrade{SubmissionID: request.GetSubmissionID()})
+	} else {
+		regrades, err = s.db.GetRegradesByCourse(request.GetCourseID())
+	}
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[uint64]bool)
+	var result []*pb.Regrade
+	for _, regrade := range regrades {
+		submissionID := regrade.GetSubmissionID()
+		if _, ok := visible[submissionID]; !ok {
+			submission, courseID, err := s.getRegradeSubmission(submissionID)
+			if err != nil {
+				return nil, err
+			}
+			visible[submissionID] = courseID == request.GetCourseID() &&
+				(s.isRegradeReviewer(usr, courseID, submission) || s.isSubmissionOwner(usr, submission))
+		}
+		if visible[submissionID] {
+			result = append(result, regrade)
+		}
+	}
+	return &pb.Regrades{Regrades: result}, nil
+}
+
+// isSubmissionOwner returns true if the given user owns the given submission,
+// or is a member of the submission's group.
+func (s *AutograderService) isSubmissionOwner(usr *pb.User, submission *pb.Submission) bool {
+	if submission.GetGroupID() > 0 {
+		group, err := s.db.GetGroup(submission.GetGroupID())
+		return err == nil && group.Contains(usr)
+	}
+	return usr.IsOwner(submission.GetUserID())
+}
+
+// updateRegrade accepts or rejects the requested open regrade.
+// Only the reviewers of the regrade's submission can resolve it.
+// A regrade can be accepted with a new score for the submission,
+// which is recorded for the regrade together with its acceptance.
+func (s *AutograderService) updateRegrade(usr *pb.User, request *pb.UpdateRegradeRequest) error {
+	regrade, err := s.db.GetRegrade(&pb.Regrade{ID: request.GetRegradeID()})
+	if err != nil {
+		return err
+	}
+	submission, courseID, err := s.getRegradeSubmission(regrade.GetSubmissionID())
+	if err != nil {
+		return err
+	}
+	if courseID != request.GetCourseID() {
+		return fmt.Errorf("regrade %d does not belong to course %d", regrade.GetID(), request.GetCourseID())
+	}
+	if !s.isRegradeReviewer(usr, courseID, submission) {
+		return ErrNotRegradeReviewer
+	}
+	if regrade.GetState() != pb.Regrade_OPEN {
+		return ErrRegradeResolved
+	}
+	if request.GetScore() > 0 && request.GetState() != pb.Regrade_ACCEPTED {
+		return fmt.Errorf("score of regrade %d can only be changed when accepting it", regrade.GetID())
+	}
+	regrade.State = request.GetState()
+	regrade.Response = request.GetResponse()
+	regrade.ResolverID = usr.GetID()
+	regrade.ResolvedDate = time.Now().Format(layout)
+	var update *pb.ScoreUpdate
+	if request.GetScore() > 0 && request.GetScore() != submission.GetScore() {
+		update = &pb.ScoreUpdate{
+			SubmissionID: submission.GetID(),
+			RegradeID:    regrade.GetID(),
+			UpdatedByID:  usr.GetID(),
+			OldScore:     submission.GetScore(),
+			NewScore:     request.GetScore(),
+			UpdatedDate:  regrade.GetResolvedDate(),
+		}
+	}
+	return s.db.UpdateRegrade(regrade, update)
+}
+
+// recordScoreUpdate records a teacher's change of a submission's score in the audit
+// trail. The change is linked to the submission's open regrade, if any; changes made
+// after the regrade was resolved are recorded without a regrade. To link a score
+// change to the acceptance of a regrade, the regrade is accepted with the new score.
+func (s *AutograderService) recordScoreUpdate(submission *pb.Submission, oldScore uint32, updatedByID uint64) error {
+	regrades, err := s.db.GetRegrades(&pb.Regrade{SubmissionID: submission.GetID()})
+	if err != nil {
+		return err
+	}
+	var regradeID uint64
+	for _, regrade := range regrades {
+		if regrade.GetState() == pb.Regrade_OPEN {
+			regradeID = regrade.GetID()
+		}
+	}
+	return s.db.CreateScoreUpdate(&pb.ScoreUpdate{
+		SubmissionID: submission.GetID(),
+		RegradeID:    regradeID,
+		UpdatedByID:  updatedByID,
+		OldScore:     oldScore,
+		NewScore:     submission.GetScore(),
+		UpdatedDate:  time.Now().Format(layout),
+	})
+}
@@ -0,0 +1,169 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegrades(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	enroll := func(user *pb.User, status pb.Enrollment_UserStatus) {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID, Status: status}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reviewer := createFakeUser(t, db, 2)
+	enroll(reviewer, pb.Enrollment_TEACHER)
+	students := make([]*pb.User, 2)
+	for i := range students {
+		students[i] = createFakeUser(t, db, uint64(i+3))
+		enroll(students[i], pb.Enrollment_STUDENT)
+	}
+	submission := &pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       students[0].ID,
+		Score:        50,
+		ScoreObjects: `[{"TestName":"TestA","Score":5,"MaxScore":10,"Weight":1}]`,
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, scoreRunner{})
+	ctx := func(user *pb.User) context.Context {
+		return withUserContext(context.Background(), user)
+	}
+
+	request := &pb.Regrade{SubmissionID: submission.ID, Reason: "TestA fails on a correct answer", TestName: "TestA"}
+	for _, user := range []*pb.User{teacher, students[1]} {
+		if _, err := ags.CreateRegrade(ctx(user), request); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("CreateRegrade() by user %d: got error %v, want %v", user.ID, err, codes.PermissionDenied)
+		}
+	}
+	if _, err := ags.CreateRegrade(ctx(students[0]), &pb.Regrade{SubmissionID: submission.ID, Reason: "wrong", TestName: "TestB"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateRegrade() of unknown test: got error %v, want %v", err, codes.InvalidArgument)
+	}
+	regrade, err := ags.CreateRegrade(ctx(students[0]), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regrade.GetState() != pb.Regrade_OPEN || regrade.GetUserID() != students[0].ID {
+		t.Errorf("CreateRegrade() = %+v, want open regrade of user %d", regrade, students[0].ID)
+	}
+	if _, err := ags.CreateRegrade(ctx(students[0]), request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateRegrade() with open regrade: got error %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	// once a reviewer is assigned, only the reviewer sees and resolves the regrade
+	if err := db.CreateReview(&pb.Review{SubmissionID: submission.ID, ReviewerID: reviewer.ID}); err != nil {
+		t.Fatal(err)
+	}
+	regradesRequest := &pb.RegradesRequest{CourseID: course.ID}
+	for _, test := range []struct {
+		user *pb.User
+		want int
+	}{{teacher, 0}, {reviewer, 1}, {students[0], 1}, {students[1], 0}} {
+		regrades, err := ags.GetRegrades(ctx(test.user), regradesRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(regrades.GetRegrades()); got != test.want {
+			t.Errorf("GetRegrades() by user %d = %d regrades, want %d", test.user.ID, got, test.want)
+		}
+	}
+	update := &pb.UpdateRegradeRequest{CourseID: course.ID, RegradeID: regrade.ID, State: pb.Regrade_ACCEPTED, Response: "test fixed"}
+	if _, err := ags.UpdateRegrade(ctx(teacher), update); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateRegrade() by other teacher: got error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	// score changes made through UpdateSubmission are recorded for the regrade
+	if _, err := ags.UpdateSubmission(ctx(reviewer), &pb.UpdateSubmissionRequest{SubmissionID: submission.ID, CourseID: course.ID, Score: 70}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateRegrade(ctx(reviewer), update); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateRegrade(ctx(reviewer), update); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateRegrade() of resolved regrade: got error %v, want %v", err, codes.FailedPrecondition)
+	}
+	// score changes made after the regrade was resolved are not linked to it
+	if _, err := ags.UpdateSubmission(ctx(reviewer), &pb.UpdateSubmissionRequest{SubmissionID: submission.ID, CourseID: course.ID, Score: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	regrades, err := ags.GetRegrades(ctx(students[0]), &pb.RegradesRequest{CourseID: course.ID, SubmissionID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regrades.GetRegrades()) != 1 {
+		t.Fatalf("GetRegrades() = %d regrades, want 1", len(regrades.GetRegrades()))
+	}
+	got := regrades.GetRegrades()[0]
+	if got.GetState() != pb.Regrade_ACCEPTED || got.GetResolverID() != reviewer.ID || got.GetResponse() != "test fixed" {
+		t.Errorf("GetRegrades() = %+v, want regrade accepted by user %d", got, reviewer.ID)
+	}
+	if len(got.GetScoreUpdates()) != 1 {
+		t.Fatalf("GetRegrades() = %d score updates, want 1", len(got.GetScoreUpdates()))
+	}
+	scoreUpdate := got.GetScoreUpdates()[0]
+	if scoreUpdate.GetOldScore() != 50 || scoreUpdate.GetNewScore() != 70 || scoreUpdate.GetUpdatedByID() != reviewer.ID {
+		t.Errorf("score update = %+v, want 50 -> 70 by user %d", scoreUpdate, reviewer.ID)
+	}
+
+	// a regrade accepted with a new score records the score change for the regrade
+	regrade, err = ags.CreateRegrade(ctx(students[0]), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reject := &pb.UpdateRegradeRequest{CourseID: course.ID, RegradeID: regrade.ID, State: pb.Regrade_REJECTED, Score: 90}
+	if _, err := ags.UpdateRegrade(ctx(reviewer), reject); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateRegrade() rejecting with a score: got error %v, want %v", err, codes.InvalidArgument)
+	}
+	accept := &pb.UpdateRegradeRequest{CourseID: course.ID, RegradeID: regrade.ID, State: pb.Regrade_ACCEPTED, Score: 90}
+	if _, err := ags.UpdateRegrade(ctx(reviewer), accept); err != nil {
+		t.Fatal(err)
+	}
+	regrades, err = ags.GetRegrades(ctx(students[0]), &pb.RegradesRequest{CourseID: course.ID, SubmissionID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regrades.GetRegrades()) != 2 {
+		t.Fatalf("GetRegrades() = %d regrades, want 2", len(regrades.GetRegrades()))
+	}
+	got = regrades.GetRegrades()[1]
+	if got.GetState() != pb.Regrade_ACCEPTED || len(got.GetScoreUpdates()) != 1 {
+		t.Fatalf("GetRegrades() = %+v, want accepted regrade with one score update", got)
+	}
+	scoreUpdate = got.GetScoreUpdates()[0]
+	if scoreUpdate.GetOldScore() != 60 || scoreUpdate.GetNewScore() != 90 || scoreUpdate.GetUpdatedByID() != reviewer.ID {
+		t.Errorf("score update = %+v, want 60 -> 90 by user %d", scoreUpdate, reviewer.ID)
+	}
+	updated, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetScore() != 90 {
+		t.Errorf("submission score = %d, want 90", updated.GetScore())
+	}
+}
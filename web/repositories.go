@@ -1,9 +1,12 @@
 package web
 
 import (
+	"context"
 	"fmt"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/scm"
 )
 
 func (s *AutograderService) getUserRepo(course *pb.Course, userID uint64) (*pb.Repository, error) {
@@ -31,3 +34,18 @@ func (s *AutograderService) getGroupRepo(course *pb.Course, groupID uint64) (*pb
 	}
 	return repos[0], nil
 }
+
+// seedRepository pushes the course's starter code of the released assignments from the
+// 'assignments' repository to the newly created student or group repository. Failing to seed the repository
+// is not fatal; students can still pull the starter code manually.
+// The repository is seeded in the background, so that the request that created
+// the repository does not wait for the clone and push.
+func (s *AutograderService) seedRepository(sc scm.SCM, course *pb.Course, repoPath string) {
+	go func() {
+		if err := assignments.SeedRepository(context.Background(), sc, s.db, course, repoPath); err != nil {
+			s.logger.Errorf("Failed to seed repository %s with starter code: %s", repoPath, err)
+			return
+		}
+		s.logger.Debugf("Seeded repository %s with starter code from '%s' repository", repoPath, pb.AssignmentRepo)
+	}()
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -253,6 +255,55 @@ func TestSubmissionsAccess(t *testing.T) {
 	}
 }
 
+func TestGetSubmissionsInvalidScores(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, &course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	for order := uint32(1); order <= 2; order++ {
+		if err := db.CreateAssignment(&pb.Assignment{CourseID: course.ID, Name: "lab", Order: order}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.CreateSubmission(&pb.Submission{
+		AssignmentID: 1,
+		UserID:       student.ID,
+		ScoreObjects: `[{"TestName":"TestA","Score":5,"MaxScore":10,"Weight":1}]`,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{
+		AssignmentID: 2,
+		UserID:       student.ID,
+		ScoreObjects: "not json",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), student)
+	submissions, err := ags.GetSubmissions(ctx, &pb.SubmissionRequest{UserID: student.ID, CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a submission with invalid score objects is listed without its test results
+	if got := submissions.GetSubmissions(); len(got) != 2 || len(got[0].GetTests()) != 1 || len(got[1].GetTests()) != 0 {
+		t.Errorf("GetSubmissions() = %v, want both submissions, with the test results of the first", got)
+	}
+}
+
 func TestApproveSubmission(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -342,6 +393,74 @@ func TestApproveSubmission(t *testing.T) {
 	}
 }
 
+func TestApproveSubmissionWithPrerequisites(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	admin := createFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   student.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", ScriptFile: "go.sh", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", ScriptFile: "go.sh", Order: 2, Prerequisites: "lab1"}
+	for _, lab := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submission1 := &pb.Submission{AssignmentID: lab1.ID, UserID: student.ID}
+	submission2 := &pb.Submission{AssignmentID: lab2.ID, UserID: student.ID}
+	for _, submission := range []*pb.Submission{submission1, submission2} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), admin)
+
+	// lab2 cannot be approved before lab1
+	_, err := ags.UpdateSubmission(ctx, &pb.UpdateSubmissionRequest{
+		SubmissionID: submission2.ID,
+		CourseID:     course.ID,
+		Status:       pb.Submission_APPROVED,
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateSubmission() = %v, want code %v", err, codes.FailedPrecondition)
+	}
+
+	for _, submission := range []*pb.Submission{submission1, submission2} {
+		if _, err := ags.UpdateSubmission(ctx, &pb.UpdateSubmissionRequest{
+			SubmissionID: submission.ID,
+			CourseID:     course.ID,
+			Status:       pb.Submission_APPROVED,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	updatedSubmission, err := db.GetSubmission(&pb.Submission{ID: submission2.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updatedSubmission.GetStatus() != pb.Submission_APPROVED {
+		t.Errorf("Expected submission approval to be %s, got: %s", pb.Submission_APPROVED, updatedSubmission.GetStatus())
+	}
+}
+
 func TestGetCourseLabSubmissions(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -114,7 +114,7 @@ func enableProviders(l *zap.SugaredLogger, baseURL string, fake bool) map[string
 
 func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]bool, scriptPath string) {
 	if enabled["github"] {
-		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.artifacts, ags.builds, ags.bh.Secret)
 		e.POST("/hook/github/events", func(c echo.Context) error {
 			ghHook.Handle(c.Response(), c.Request())
 			return nil
@@ -122,7 +122,7 @@ func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]b
 	}
 	if enabled["gitlab"] {
 		//TODO(meling) fix gitlab
-		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.artifacts, ags.builds, ags.bh.Secret)
 		e.POST("/hook/gitlab/events", func(c echo.Context) error {
 			glHook.Handle(c.Response(), c.Request())
 			return nil
@@ -151,6 +151,7 @@ func registerAuth(ags *AutograderService, e *echo.Echo) {
 	api := e.Group("/api/v1")
 	api.Use(auth.AccessControl(logger, ags.db, ags.scms))
 	api.GET("/user", GetSelf(ags.db))
+	api.GET("/submissions/:sid/artifacts/*", GetArtifact(ags))
 }
 
 func registerFrontend(e *echo.Echo, entryPoint, public string) {